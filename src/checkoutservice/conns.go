@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+const (
+	// keepaliveTime is how long a connection may stay idle during a call
+	// before it is pinged. Servers reject pings more frequent than every five
+	// minutes by default.
+	keepaliveTime = 5 * time.Minute
+	// keepaliveTimeout is how long a ping may go unanswered before the
+	// connection is closed and dialed again.
+	keepaliveTimeout = 20 * time.Second
+	// roundRobin balances calls over every address of a service.
+	roundRobin = `{"loadBalancingPolicy":"round_robin"}`
+)
+
+// idempotentCalls are retried when their service is unavailable. Other
+// calls, such as Charge, may have taken effect and are attempted once.
+var idempotentCalls = []string{
+	"/hipstershop.CartService/GetCart",
+	"/hipstershop.ProductCatalogService/GetProduct",
+	"/hipstershop.CurrencyService/Convert",
+	"/hipstershop.ShippingService/GetQuote",
+}
+
+// dialService creates the connection shared by every call to a service. It
+// does not wait for the service to be up: calls fail until it is, and the
+// connection is dialed again with exponential backoff whenever it is lost.
+//
+// addrs is a comma-separated list of host:port addresses, over which calls
+// are balanced round-robin. A single address is resolved through DNS, so a
+// name with several records, like a Kubernetes headless service, is
+// balanced as well. extra options, such as interceptors, are added to the
+// defaults.
+func dialService(name, addrs string, extra ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithDefaultServiceConfig(roundRobin),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    keepaliveTime,
+			Timeout: keepaliveTimeout,
+		}),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: backoff.Config{
+				BaseDelay:  time.Second,
+				Multiplier: 1.6,
+				Jitter:     0.2,
+				MaxDelay:   30 * time.Second,
+			},
+			MinConnectTimeout: 5 * time.Second,
+		}),
+	}
+	opts = append(opts, extra...)
+
+	var list []string
+	for _, a := range strings.Split(addrs, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			list = append(list, a)
+		}
+	}
+	switch len(list) {
+	case 0:
+		return nil, fmt.Errorf("no address for the %s service", name)
+	case 1:
+		target := list[0]
+		if !strings.Contains(target, "://") {
+			target = "dns:///" + target
+		}
+		return grpc.Dial(target, opts...)
+	}
+
+	// Several addresses are served by a resolver of their own.
+	r := manual.NewBuilderWithScheme(name)
+	state := resolver.State{}
+	for _, a := range list {
+		state.Addresses = append(state.Addresses, resolver.Address{Addr: a})
+	}
+	r.InitialState(state)
+	return grpc.Dial(r.Scheme()+":///"+name, append(opts, grpc.WithResolvers(r))...)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// countingHealth counts the health checks it answers.
+type countingHealth struct {
+	healthpb.HealthServer
+	calls int32
+}
+
+func (h *countingHealth) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	atomic.AddInt32(&h.calls, 1)
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+// serveHealth starts a health server on a local port.
+func serveHealth(t *testing.T) (string, *countingHealth) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	h := &countingHealth{}
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, h)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String(), h
+}
+
+func check(t *testing.T, conn *grpc.ClientConn, n int) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := healthpb.NewHealthClient(conn)
+	for i := 0; i < n; i++ {
+		if _, err := client.Check(ctx, &healthpb.HealthCheckRequest{}, grpc.WaitForReady(true)); err != nil {
+			t.Fatalf("Check failed: %v", err)
+		}
+	}
+}
+
+func TestDialServiceSingleAddress(t *testing.T) {
+	addr, h := serveHealth(t)
+	conn, err := dialService("cart", addr)
+	if err != nil {
+		t.Fatalf("dialService failed: %v", err)
+	}
+	defer conn.Close()
+	if got, want := conn.Target(), "dns:///"+addr; got != want {
+		t.Errorf("Target() = %q, expected %q", got, want)
+	}
+	check(t, conn, 3)
+	if h.calls != 3 {
+		t.Errorf("server got %d calls, expected 3", h.calls)
+	}
+}
+
+func TestDialServiceAddressList(t *testing.T) {
+	addr1, h1 := serveHealth(t)
+	addr2, h2 := serveHealth(t)
+	conn, err := dialService("cart", " "+addr1+", "+addr2+",")
+	if err != nil {
+		t.Fatalf("dialService failed: %v", err)
+	}
+	defer conn.Close()
+	if got, want := conn.Target(), "cart:///cart"; got != want {
+		t.Errorf("Target() = %q, expected the manual resolver %q", got, want)
+	}
+
+	// Round-robin balancing only starts once both addresses are connected.
+	deadline := time.Now().Add(5 * time.Second)
+	for atomic.LoadInt32(&h1.calls) == 0 || atomic.LoadInt32(&h2.calls) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("calls were not balanced: %d and %d", h1.calls, h2.calls)
+		}
+		check(t, conn, 1)
+	}
+	c1, c2 := atomic.LoadInt32(&h1.calls), atomic.LoadInt32(&h2.calls)
+	check(t, conn, 10)
+	if d1, d2 := atomic.LoadInt32(&h1.calls)-c1, atomic.LoadInt32(&h2.calls)-c2; d1 != 5 || d2 != 5 {
+		t.Errorf("calls were split %d/%d, expected 5/5", d1, d2)
+	}
+}
+
+func TestDialServiceNoAddress(t *testing.T) {
+	if _, err := dialService("cart", " , "); err == nil {
+		t.Errorf("dialService with no address: got no error")
+	}
+}
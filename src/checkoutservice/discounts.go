@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+	"github.com/abruneau/hipstershop/src/checkoutservice/promotions"
+	"github.com/abruneau/hipstershop/src/checkoutservice/store"
+)
+
+// defaultPromotionsConfig is the promotions file used when
+// PROMOTIONS_CONFIG is not set.
+const defaultPromotionsConfig = "promotions.json"
+
+// applyPromotions returns the discounts of the promotions that apply to the
+// priced items, with the promo code if any.
+func (cs *checkoutService) applyPromotions(ctx context.Context, items []*pb.OrderItem, products map[string]*pb.Product, currency, code string) ([]promotions.Discount, error) {
+	order := promotions.Order{Currency: currency, Code: code, Now: time.Now()}
+	for _, it := range items {
+		order.Items = append(order.Items, promotions.Item{
+			ProductID:  it.GetItem().GetProductId(),
+			Categories: products[it.GetItem().GetProductId()].GetCategories(),
+			Quantity:   it.GetItem().GetQuantity(),
+			UnitPrice:  *it.GetCost(),
+		})
+	}
+	convert := func(ctx context.Context, usd *pb.Money) (*pb.Money, error) {
+		if usd.GetCurrencyCode() == currency {
+			return usd, nil
+		}
+		return cs.convertCurrency(ctx, usd, currency)
+	}
+	discounts, err := cs.promotions.Apply(ctx, order, convert)
+	switch err {
+	case nil:
+		return discounts, nil
+	case promotions.ErrUnknownCode, promotions.ErrCodeNotApplicable:
+		return nil, fieldError("promo_code", "promo code %q: %v", code, err)
+	default:
+		// Failures to convert amounts keep their code.
+		return nil, err
+	}
+}
+
+// redeemPromotions counts the use of the promotions of an order, all or
+// none. Promotions used up fail the order with ResourceExhausted.
+func (cs *checkoutService) redeemPromotions(userID string, discounts []promotions.Discount) error {
+	for i, d := range discounts {
+		p := d.Promotion
+		err := cs.orderStore.RedeemPromotion(p.ID, userID, p.UsageLimit, p.PerUserLimit)
+		if err == nil {
+			continue
+		}
+		cs.releasePromotions(userID, discounts[:i])
+		if err == store.ErrLimitReached {
+			return status.Errorf(codes.ResourceExhausted, "promotion %q is no longer available", p.Description)
+		}
+		return status.Errorf(codes.Internal, "failed to redeem promotion %s: %+v", p.ID, err)
+	}
+	return nil
+}
+
+// releasePromotions takes back the use of the promotions of an order.
+func (cs *checkoutService) releasePromotions(userID string, discounts []promotions.Discount) error {
+	var failed error
+	for _, d := range discounts {
+		if err := cs.orderStore.ReleasePromotion(d.Promotion.ID, userID); err != nil {
+			failed = err
+		}
+	}
+	return failed
+}
+
+// discountProtos lists the discounts of an order in its result.
+func discountProtos(discounts []promotions.Discount) []*pb.Discount {
+	var out []*pb.Discount
+	for _, d := range discounts {
+		amount := d.Amount
+		out = append(out, &pb.Discount{
+			PromotionId: d.Promotion.ID,
+			Description: d.Promotion.Description,
+			Amount:      &amount,
+		})
+	}
+	return out
+}
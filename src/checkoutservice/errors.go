@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain is the domain of the ErrorInfo details of checkout errors.
+const errorDomain = "checkoutservice.hipstershop"
+
+// Reasons of the ErrorInfo details of checkout errors, which clients may
+// react to. Other failures of a service are reported as <SERVICE>_FAILED.
+const (
+	reasonPaymentDeclined = "PAYMENT_DECLINED"
+	reasonProductNotFound = "PRODUCT_NOT_FOUND"
+)
+
+// Services called by checkout, as named in the ErrorInfo details.
+const (
+	serviceCart           = "cart"
+	serviceProductCatalog = "productcatalog"
+	serviceCurrency       = "currency"
+	serviceShipping       = "shipping"
+	servicePayment        = "payment"
+)
+
+// downstreamError reports the failure of a call to another service. Codes
+// that mean the same to the client of checkout, such as NotFound or
+// InvalidArgument, are kept, and the others are reported as Internal. The
+// error is detailed with an ErrorInfo naming the service, followed by the
+// details of the failed call.
+func downstreamError(service string, err error, format string, a ...interface{}) error {
+	return serviceError(downstreamCode(status.Code(err)), service, "", err, format, a...)
+}
+
+// serviceError reports the failure of a call to a service with a code and a
+// reason, <SERVICE>_FAILED if empty.
+func serviceError(code codes.Code, service, reason string, err error, format string, a ...interface{}) error {
+	down := status.Convert(err)
+	if reason == "" {
+		reason = strings.ToUpper(service) + "_FAILED"
+	}
+	st := status.Newf(code, "%s: %s", fmt.Sprintf(format, a...), down.Message())
+	details := []proto.Message{&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"service": service,
+			"code":    down.Code().String(),
+		},
+	}}
+	for _, d := range down.Details() {
+		if m, ok := d.(proto.Message); ok {
+			details = append(details, m)
+		}
+	}
+	detailed, derr := st.WithDetails(details...)
+	if derr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// withDetails adds details to a status error.
+func withDetails(err error, details ...proto.Message) error {
+	detailed, derr := status.Convert(err).WithDetails(details...)
+	if derr != nil {
+		return err
+	}
+	return detailed.Err()
+}
+
+// downstreamCode returns the code a failed call is reported with.
+func downstreamCode(c codes.Code) codes.Code {
+	switch c {
+	case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition, codes.OutOfRange,
+		codes.ResourceExhausted, codes.Canceled, codes.DeadlineExceeded, codes.Unavailable:
+		return c
+	default:
+		return codes.Internal
+	}
+}
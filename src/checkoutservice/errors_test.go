@@ -0,0 +1,120 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDownstreamCode(t *testing.T) {
+	for code, want := range map[codes.Code]codes.Code{
+		codes.InvalidArgument:    codes.InvalidArgument,
+		codes.NotFound:           codes.NotFound,
+		codes.FailedPrecondition: codes.FailedPrecondition,
+		codes.OutOfRange:         codes.OutOfRange,
+		codes.ResourceExhausted:  codes.ResourceExhausted,
+		codes.Canceled:           codes.Canceled,
+		codes.DeadlineExceeded:   codes.DeadlineExceeded,
+		codes.Unavailable:        codes.Unavailable,
+		codes.Unknown:            codes.Internal,
+		codes.AlreadyExists:      codes.Internal,
+		codes.PermissionDenied:   codes.Internal,
+		codes.Aborted:            codes.Internal,
+		codes.Unimplemented:      codes.Internal,
+		codes.Internal:           codes.Internal,
+		codes.DataLoss:           codes.Internal,
+		codes.Unauthenticated:    codes.Internal,
+	} {
+		if got := downstreamCode(code); got != want {
+			t.Errorf("downstreamCode(%v) = %v, expected %v", code, got, want)
+		}
+	}
+}
+
+func TestServiceError(t *testing.T) {
+	badAddress := &errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+		{Field: "address.zip_code", Description: "invalid zip code"},
+	}}
+	invalid, _ := status.New(codes.InvalidArgument, "bad address").WithDetails(badAddress)
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+		wantMsg  string
+		want     []proto.Message
+	}{
+		{"downstream details", serviceError(codes.InvalidArgument, serviceShipping, "", invalid.Err(), "failed to quote shipping"),
+			codes.InvalidArgument, "failed to quote shipping: bad address",
+			[]proto.Message{
+				&errdetails.ErrorInfo{Reason: "SHIPPING_FAILED", Domain: errorDomain, Metadata: map[string]string{"service": "shipping", "code": "InvalidArgument"}},
+				badAddress,
+			}},
+		{"reason", serviceError(codes.FailedPrecondition, servicePayment, reasonPaymentDeclined, status.Error(codes.InvalidArgument, "card expired"), "payment declined"),
+			codes.FailedPrecondition, "payment declined: card expired",
+			[]proto.Message{
+				&errdetails.ErrorInfo{Reason: "PAYMENT_DECLINED", Domain: errorDomain, Metadata: map[string]string{"service": "payment", "code": "InvalidArgument"}},
+			}},
+		{"not a status", serviceError(codes.Internal, serviceCart, "", errors.New("connection reset"), "failed to get cart of user %q", "u1"),
+			codes.Internal, `failed to get cart of user "u1": connection reset`,
+			[]proto.Message{
+				&errdetails.ErrorInfo{Reason: "CART_FAILED", Domain: errorDomain, Metadata: map[string]string{"service": "cart", "code": "Unknown"}},
+			}},
+	}
+	for _, tt := range tests {
+		st := status.Convert(tt.err)
+		if st.Code() != tt.wantCode {
+			t.Errorf("%s: code %v, expected %v", tt.name, st.Code(), tt.wantCode)
+		}
+		if st.Message() != tt.wantMsg {
+			t.Errorf("%s: message %q, expected %q", tt.name, st.Message(), tt.wantMsg)
+		}
+		got := st.Details()
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: details %v, expected %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if m, ok := got[i].(proto.Message); !ok || !proto.Equal(m, tt.want[i]) {
+				t.Errorf("%s: detail %d is %v, expected %v", tt.name, i, got[i], tt.want[i])
+			}
+		}
+	}
+}
+
+func TestDownstreamError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{status.Error(codes.NotFound, "no such product"), codes.NotFound},
+		{status.Error(codes.Unavailable, "connection refused"), codes.Unavailable},
+		{status.Error(codes.PermissionDenied, "denied"), codes.Internal},
+		{errors.New("connection reset"), codes.Internal},
+	}
+	for _, tt := range tests {
+		err := downstreamError(serviceProductCatalog, tt.err, "failed to get product")
+		if got := status.Code(err); got != tt.want {
+			t.Errorf("downstreamError(%v) code = %v, expected %v", tt.err, got, tt.want)
+		}
+		info, _ := status.Convert(err).Details()[0].(*errdetails.ErrorInfo)
+		if got, want := info.GetMetadata()["code"], status.Code(tt.err).String(); got != want {
+			t.Errorf("downstreamError(%v) reports the code %q of the call, expected %q", tt.err, got, want)
+		}
+	}
+}
+
+func TestWithDetails(t *testing.T) {
+	info := &errdetails.ErrorInfo{Reason: reasonProductNotFound, Domain: errorDomain}
+	err := withDetails(status.Error(codes.NotFound, "no such product"), info)
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("withDetails changed the code to %v", status.Code(err))
+	}
+	if got := status.Convert(err).Details(); len(got) != 1 || !proto.Equal(got[0].(proto.Message), info) {
+		t.Errorf("withDetails details = %v, expected %v", got, info)
+	}
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+)
+
+// maxFanOut is the maximum number of cart items prepared at once.
+const maxFanOut = 8
+
+// fanOut calls fn for every index below n, at most limit at once. When a call
+// fails, the context of the others is canceled and the first error is
+// returned, once every call started has returned.
+func fanOut(ctx context.Context, n, limit int, fn func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg    sync.WaitGroup
+		once  sync.Once
+		first error
+	)
+	sem := make(chan struct{}, limit)
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() { <-sem; wg.Done() }()
+			if err := fn(ctx, i); err != nil {
+				once.Do(func() {
+					first = err
+					cancel()
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+	if first == nil {
+		// The request itself was canceled.
+		first = ctx.Err()
+	}
+	return first
+}
+
+// fanOutTrace times the calls made to prepare the items of an order.
+type fanOutTrace struct {
+	orderID string
+	start   time.Time
+
+	mu    sync.Mutex
+	calls int
+	busy  int
+	peak  int
+}
+
+func newFanOutTrace(orderID string) *fanOutTrace {
+	return &fanOutTrace{orderID: orderID, start: time.Now()}
+}
+
+// call runs and logs a call of the fan-out.
+func (t *fanOutTrace) call(name string, fn func() error) error {
+	t.mu.Lock()
+	t.calls++
+	t.busy++
+	if t.busy > t.peak {
+		t.peak = t.busy
+	}
+	t.mu.Unlock()
+
+	begin := time.Now()
+	err := fn()
+	fields := logrus.Fields{
+		"order_id": t.orderID,
+		"call":     name,
+		"offset":   begin.Sub(t.start).String(),
+		"duration": time.Since(begin).String(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+	log.WithFields(fields).Debug("prepOrderItems call")
+
+	t.mu.Lock()
+	t.busy--
+	t.mu.Unlock()
+	return err
+}
+
+// done logs the outcome of the fan-out.
+func (t *fanOutTrace) done(items int, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fields := logrus.Fields{
+		"order_id": t.orderID,
+		"items":    items,
+		"calls":    t.calls,
+		"peak":     t.peak,
+		"duration": time.Since(t.start).String(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+	log.WithFields(fields).Info("prepared order items")
+}
+
+// conversions converts prices to a currency once per price, however many
+// items cost the same.
+type conversions struct {
+	cs       *checkoutService
+	currency string
+	trace    *fanOutTrace
+
+	mu    sync.Mutex
+	calls map[string]*conversion
+}
+
+// conversion is a conversion in progress, shared by every item at its price.
+type conversion struct {
+	done   chan struct{}
+	result *pb.Money
+	err    error
+}
+
+func newConversions(cs *checkoutService, currency string, trace *fanOutTrace) *conversions {
+	return &conversions{cs: cs, currency: currency, trace: trace, calls: make(map[string]*conversion)}
+}
+
+// convert converts a price, or waits for the conversion of the same price
+// started by another item.
+func (c *conversions) convert(ctx context.Context, from *pb.Money) (*pb.Money, error) {
+	if from.GetCurrencyCode() == c.currency {
+		return from, nil
+	}
+	key := fmt.Sprintf("%s %d.%09d", from.GetCurrencyCode(), from.GetUnits(), from.GetNanos())
+	c.mu.Lock()
+	call, ok := c.calls[key]
+	if !ok {
+		call = &conversion{done: make(chan struct{})}
+		c.calls[key] = call
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		call.err = c.trace.call("Convert "+key, func() error {
+			var err error
+			call.result, err = c.cs.convertCurrency(ctx, from, c.currency)
+			return err
+		})
+		close(call.done)
+	}
+	select {
+	case <-call.done:
+		return call.result, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
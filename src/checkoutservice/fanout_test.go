@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+)
+
+func TestFanOutLimit(t *testing.T) {
+	var calls, busy, peak int32
+	err := fanOut(context.Background(), 20, 3, func(ctx context.Context, i int) error {
+		atomic.AddInt32(&calls, 1)
+		n := atomic.AddInt32(&busy, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&busy, -1)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("fanOut failed: %v", err)
+	}
+	if calls != 20 {
+		t.Errorf("fanOut made %d calls, expected 20", calls)
+	}
+	if peak > 3 {
+		t.Errorf("fanOut ran %d calls at once, expected at most 3", peak)
+	}
+}
+
+func TestFanOutFirstError(t *testing.T) {
+	failed := errors.New("no such product")
+	var mu sync.Mutex
+	var started []int
+	canceled := make(chan int, 10)
+	err := fanOut(context.Background(), 10, 2, func(ctx context.Context, i int) error {
+		mu.Lock()
+		started = append(started, i)
+		mu.Unlock()
+		if i == 0 {
+			return failed
+		}
+		<-ctx.Done()
+		canceled <- i
+		return ctx.Err()
+	})
+	if err != failed {
+		t.Errorf("fanOut = %v, expected the error of the failed call", err)
+	}
+	// The call running with the failed one is canceled, and no other call
+	// starts.
+	if len(started) != 2 {
+		t.Errorf("fanOut started calls %v, expected only the first 2", started)
+	}
+	if len(canceled) != 1 {
+		t.Errorf("%d calls were canceled, expected 1", len(canceled))
+	}
+}
+
+func TestFanOutCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var calls int32
+	err := fanOut(ctx, 5, 1, func(ctx context.Context, i int) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("fanOut = %v, expected context.Canceled", err)
+	}
+	if calls != 0 {
+		t.Errorf("fanOut made %d calls after the request was canceled", calls)
+	}
+}
+
+// fakeCurrency doubles the prices it converts, and counts them.
+type fakeCurrency struct {
+	pb.CurrencyServiceClient
+	calls int32
+	err   error
+}
+
+func (c *fakeCurrency) Convert(ctx context.Context, in *pb.CurrencyConversionRequest, opts ...grpc.CallOption) (*pb.Money, error) {
+	atomic.AddInt32(&c.calls, 1)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &pb.Money{CurrencyCode: in.ToCode, Units: 2 * in.From.Units, Nanos: 2 * in.From.Nanos}, nil
+}
+
+func TestConversions(t *testing.T) {
+	currency := &fakeCurrency{}
+	c := newConversions(&checkoutService{currencySvc: currency}, "EUR", newFanOutTrace("o1"))
+	prices := []*pb.Money{
+		{CurrencyCode: "USD", Units: 10},
+		{CurrencyCode: "USD", Units: 5, Nanos: 250000000},
+		{CurrencyCode: "USD", Units: 10},
+		{CurrencyCode: "EUR", Units: 3},
+		{CurrencyCode: "USD", Units: 5, Nanos: 250000000},
+		{CurrencyCode: "USD", Units: 10},
+	}
+	got := make([]*pb.Money, len(prices))
+	err := fanOut(context.Background(), len(prices), len(prices), func(ctx context.Context, i int) error {
+		var err error
+		got[i], err = c.convert(ctx, prices[i])
+		return err
+	})
+	if err != nil {
+		t.Fatalf("convert failed: %v", err)
+	}
+	// Each price is converted once, and prices in the currency not at all.
+	if currency.calls != 2 {
+		t.Errorf("Convert was called %d times, expected 2", currency.calls)
+	}
+	want := []pb.Money{
+		{CurrencyCode: "EUR", Units: 20},
+		{CurrencyCode: "EUR", Units: 10, Nanos: 500000000},
+		{CurrencyCode: "EUR", Units: 20},
+		{CurrencyCode: "EUR", Units: 3},
+		{CurrencyCode: "EUR", Units: 10, Nanos: 500000000},
+		{CurrencyCode: "EUR", Units: 20},
+	}
+	for i := range want {
+		if got[i].GetCurrencyCode() != want[i].CurrencyCode || got[i].GetUnits() != want[i].Units || got[i].GetNanos() != want[i].Nanos {
+			t.Errorf("convert(%v) = %v, expected %v", prices[i], got[i], &want[i])
+		}
+	}
+}
+
+func TestConversionsError(t *testing.T) {
+	currency := &fakeCurrency{err: status.Error(codes.Unavailable, "no currency service")}
+	c := newConversions(&checkoutService{currencySvc: currency}, "EUR", newFanOutTrace("o1"))
+	price := &pb.Money{CurrencyCode: "USD", Units: 10}
+	for i := 0; i < 2; i++ {
+		if _, err := c.convert(context.Background(), price); status.Code(err) != codes.Unavailable {
+			t.Errorf("convert %d: got %v, expected Unavailable", i, err)
+		}
+	}
+	if currency.calls != 1 {
+		t.Errorf("Convert was called %d times, expected the failure to be shared", currency.calls)
+	}
+}
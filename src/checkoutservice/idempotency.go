@@ -0,0 +1,117 @@
+package main
+
+import (
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+)
+
+// defaultIdempotencyTTL is how long the order placed with an idempotency key
+// is returned again for the same key.
+const defaultIdempotencyTTL = 24 * time.Hour
+
+// idempotencyStore remembers the orders placed with an idempotency key, so
+// that retried requests get the same order instead of being charged again.
+// Keys are scoped to the user who sent them.
+type idempotencyStore struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*idempotentOrder
+}
+
+// idempotentOrder is the outcome of a request with an idempotency key: the
+// order placed, or the failure of an order that could not be undone. It is
+// empty while the order is being placed.
+type idempotentOrder struct {
+	fingerprint [sha256.Size]byte
+	resp        *pb.PlaceOrderResponse
+	err         error
+	expires     time.Time
+}
+
+func (e *idempotentOrder) done() bool { return e.resp != nil || e.err != nil }
+
+func newIdempotencyStore(ttl time.Duration) *idempotencyStore {
+	return &idempotencyStore{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]*idempotentOrder),
+	}
+}
+
+// begin claims the key of a request. It returns the order already placed with
+// the key instead, if any, or the failure recorded for it. Requests with the
+// key of an order still being placed are Aborted, and requests reusing a key
+// for a different order are rejected.
+func (s *idempotencyStore) begin(req *pb.PlaceOrderRequest) (*pb.PlaceOrderResponse, error) {
+	fingerprint := fingerprintOrder(req)
+	key := idempotencyKey(req)
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, e := range s.entries {
+		if e.done() && now.After(e.expires) {
+			delete(s.entries, k)
+		}
+	}
+	e, ok := s.entries[key]
+	if !ok {
+		s.entries[key] = &idempotentOrder{fingerprint: fingerprint}
+		return nil, nil
+	}
+	if e.fingerprint != fingerprint {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency key %q was used for a different order", req.IdempotencyKey)
+	}
+	if !e.done() {
+		return nil, status.Errorf(codes.Aborted, "an order with idempotency key %q is already being placed", req.IdempotencyKey)
+	}
+	return e.resp, e.err
+}
+
+// finish records the order placed for the key of a request.
+func (s *idempotencyStore) finish(req *pb.PlaceOrderRequest, resp *pb.PlaceOrderResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.entries[idempotencyKey(req)]; ok {
+		e.resp, e.expires = resp, s.now().Add(s.ttl)
+	}
+}
+
+// fail records the failure of an order that could not be undone, so that
+// retries get it back instead of being charged again.
+func (s *idempotencyStore) fail(req *pb.PlaceOrderRequest, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.entries[idempotencyKey(req)]; ok {
+		e.err, e.expires = err, s.now().Add(s.ttl)
+	}
+}
+
+// release frees the key of a request whose order failed, and was undone, so
+// that it can be retried.
+func (s *idempotencyStore) release(req *pb.PlaceOrderRequest) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, idempotencyKey(req))
+}
+
+func idempotencyKey(req *pb.PlaceOrderRequest) string {
+	return req.GetUserId() + "/" + req.GetIdempotencyKey()
+}
+
+// fingerprintOrder hashes a request without its idempotency key.
+func fingerprintOrder(req *pb.PlaceOrderRequest) [sha256.Size]byte {
+	clone := proto.Clone(req).(*pb.PlaceOrderRequest)
+	clone.IdempotencyKey = ""
+	b, _ := proto.Marshal(clone)
+	return sha256.Sum256(b)
+}
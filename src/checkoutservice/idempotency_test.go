@@ -0,0 +1,111 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+)
+
+func newTestIdempotencyStore(now *time.Time) *idempotencyStore {
+	s := newIdempotencyStore(time.Hour)
+	s.now = func() time.Time { return *now }
+	return s
+}
+
+func idempotentRequest(user, key string) *pb.PlaceOrderRequest {
+	return &pb.PlaceOrderRequest{UserId: user, UserCurrency: "USD", Email: "someone@example.com", IdempotencyKey: key}
+}
+
+func TestIdempotencyBegin(t *testing.T) {
+	now := time.Unix(0, 0)
+	s := newTestIdempotencyStore(&now)
+	req := idempotentRequest("u1", "k1")
+
+	if resp, err := s.begin(req); resp != nil || err != nil {
+		t.Fatalf("begin = %v, %v; expected the key to be claimed", resp, err)
+	}
+	if _, err := s.begin(req); status.Code(err) != codes.Aborted {
+		t.Errorf("begin while placing: got %v, expected Aborted", err)
+	}
+	other := idempotentRequest("u1", "k1")
+	other.Email = "other@example.com"
+	if _, err := s.begin(other); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("begin with a different order: got %v, expected InvalidArgument", err)
+	}
+	if resp, err := s.begin(idempotentRequest("u2", "k1")); resp != nil || err != nil {
+		t.Errorf("begin with the key of another user = %v, %v; expected the key to be claimed", resp, err)
+	}
+}
+
+func TestIdempotencyFinish(t *testing.T) {
+	now := time.Unix(0, 0)
+	s := newTestIdempotencyStore(&now)
+	req := idempotentRequest("u1", "k1")
+	placed := &pb.PlaceOrderResponse{Order: &pb.OrderResult{OrderId: "o1"}}
+
+	s.begin(req)
+	s.finish(req, placed)
+	if resp, err := s.begin(req); resp != placed || err != nil {
+		t.Errorf("begin after finish = %v, %v; expected the placed order", resp, err)
+	}
+
+	now = now.Add(time.Hour + time.Second)
+	if resp, err := s.begin(req); resp != nil || err != nil {
+		t.Errorf("begin after expiry = %v, %v; expected the key to be claimed again", resp, err)
+	}
+}
+
+func TestIdempotencyRelease(t *testing.T) {
+	now := time.Unix(0, 0)
+	s := newTestIdempotencyStore(&now)
+	req := idempotentRequest("u1", "k1")
+
+	s.begin(req)
+	s.release(req)
+	if resp, err := s.begin(req); resp != nil || err != nil {
+		t.Errorf("begin after release = %v, %v; expected the key to be claimed again", resp, err)
+	}
+}
+
+func TestIdempotencyFail(t *testing.T) {
+	now := time.Unix(0, 0)
+	s := newTestIdempotencyStore(&now)
+	req := idempotentRequest("u1", "k1")
+	failed := status.Error(codes.DeadlineExceeded, "charge timed out")
+
+	s.begin(req)
+	s.fail(req, failed)
+	if resp, err := s.begin(req); resp != nil || err != failed {
+		t.Errorf("begin after fail = %v, %v; expected the recorded failure", resp, err)
+	}
+
+	now = now.Add(time.Hour + time.Second)
+	if resp, err := s.begin(req); resp != nil || err != nil {
+		t.Errorf("begin after expiry = %v, %v; expected the key to be claimed again", resp, err)
+	}
+}
+
+func TestIdempotencyPendingDoesNotExpire(t *testing.T) {
+	now := time.Unix(0, 0)
+	s := newTestIdempotencyStore(&now)
+	req := idempotentRequest("u1", "k1")
+
+	s.begin(req)
+	now = now.Add(2 * time.Hour)
+	if _, err := s.begin(req); status.Code(err) != codes.Aborted {
+		t.Errorf("begin while placing, after the TTL: got %v, expected Aborted", err)
+	}
+}
+
+func TestFingerprintOrderIgnoresKey(t *testing.T) {
+	if fingerprintOrder(idempotentRequest("u1", "k1")) != fingerprintOrder(idempotentRequest("u1", "k2")) {
+		t.Errorf("fingerprintOrder depends on the idempotency key")
+	}
+	if fingerprintOrder(idempotentRequest("u1", "k1")) == fingerprintOrder(idempotentRequest("u2", "k1")) {
+		t.Errorf("fingerprintOrder ignores the user")
+	}
+}
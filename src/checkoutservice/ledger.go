@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+	money "github.com/abruneau/hipstershop/src/checkoutservice/money"
+	"github.com/abruneau/hipstershop/src/checkoutservice/promotions"
+	"github.com/abruneau/hipstershop/src/checkoutservice/store"
+	"github.com/abruneau/hipstershop/src/checkoutservice/tax"
+)
+
+// ledger refunds returned items from the charges of orders, which are saved
+// with them in the order store. The charge of an order is loaded the first
+// time one of its returns is refunded, and kept along with the refunds in
+// progress, so that each return is refunded once.
+type ledger struct {
+	store store.Store
+
+	mu     sync.Mutex
+	orders map[string]*chargedOrder
+}
+
+// chargedOrder is the charge of an order and the returns refunded so far.
+type chargedOrder struct {
+	transactionID string
+	// items is what was charged for each product.
+	items    map[string]store.ChargedItem
+	charged  pb.Money
+	refunded pb.Money
+	// returned counts the items refunded, or being refunded, by product.
+	returned map[string]int32
+	// refunds holds the refunds issued by return ID, which are empty while
+	// the payment service is being called.
+	refunds map[string]*pb.RefundReturnResponse
+}
+
+// pendingRefund is a refund reserved in the ledger until the payment service
+// confirms it.
+type pendingRefund struct {
+	returnID      string
+	orderID       string
+	transactionID string
+	items         []*pb.CartItem
+	amount        pb.Money
+}
+
+func newLedger(s store.Store) *ledger {
+	return &ledger{store: s, orders: make(map[string]*chargedOrder)}
+}
+
+// chargedItems lists what was charged for the items of an order, after the
+// discounts taken off each of them, and the taxes on each of them.
+func chargedItems(items []*pb.OrderItem, discounts []promotions.Discount, taxes []tax.Line) []store.ChargedItem {
+	out := make([]store.ChargedItem, len(items))
+	for i, it := range items {
+		amount := money.MultiplySlow(*it.GetCost(), uint32(it.GetItem().GetQuantity()))
+		for _, d := range discounts {
+			amount = money.Must(money.Sum(amount, money.Negate(d.Items[i])))
+		}
+		out[i] = store.ChargedItem{
+			ProductID: it.GetItem().GetProductId(),
+			Quantity:  it.GetItem().GetQuantity(),
+			Amount:    &amount,
+		}
+		for _, l := range taxes {
+			if t := l.Items[i]; money.IsPositive(t) {
+				out[i].Taxes = append(out[i].Taxes, store.ItemTax{
+					JurisdictionID: l.Jurisdiction.ID,
+					Amount:         &t,
+					Included:       l.Jurisdiction.Inclusive,
+				})
+			}
+		}
+	}
+	return out
+}
+
+// record saves the charge of an order.
+func (l *ledger) record(orderID, transactionID string, items []store.ChargedItem, charged pb.Money) error {
+	return l.store.SetCharge(orderID, &store.Charge{
+		TransactionID: transactionID,
+		Amount:        &charged,
+		Items:         items,
+	})
+}
+
+// forget removes the charge of an order that was refunded in full.
+func (l *ledger) forget(orderID string) error {
+	l.mu.Lock()
+	delete(l.orders, orderID)
+	l.mu.Unlock()
+	return l.store.SetCharge(orderID, nil)
+}
+
+// load returns the charge of an order, from the store the first time.
+func (l *ledger) load(orderID string) (*chargedOrder, error) {
+	if o, ok := l.orders[orderID]; ok {
+		return o, nil
+	}
+	rec, err := l.store.GetOrder(orderID)
+	if err == store.ErrNotFound || (err == nil && rec.Charge == nil) {
+		return nil, status.Errorf(codes.NotFound, "no charge for order %s", orderID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load the charge of order %s: %+v", orderID, err)
+	}
+	ch := rec.Charge
+	o := &chargedOrder{
+		transactionID: ch.TransactionID,
+		items:         make(map[string]store.ChargedItem),
+		charged:       *ch.Amount,
+		refunded:      pb.Money{CurrencyCode: ch.Amount.GetCurrencyCode()},
+		returned:      make(map[string]int32),
+		refunds:       make(map[string]*pb.RefundReturnResponse),
+	}
+	for _, it := range ch.Items {
+		if prev, ok := o.items[it.ProductID]; ok {
+			it.Quantity += prev.Quantity
+			amount := money.Must(money.Sum(*prev.Amount, *it.Amount))
+			it.Amount = &amount
+			it.Taxes = append(append([]store.ItemTax(nil), prev.Taxes...), it.Taxes...)
+		}
+		o.items[it.ProductID] = it
+	}
+	for _, r := range ch.Refunds {
+		o.refunded = money.Must(money.Sum(o.refunded, *r.Amount))
+		for _, it := range r.Items {
+			o.returned[it.ProductID] += it.Quantity
+		}
+		o.refunds[r.ReturnID] = &pb.RefundReturnResponse{RefundId: r.RefundID, Amount: r.Amount}
+	}
+	l.orders[orderID] = o
+	return o, nil
+}
+
+// reserve prices the refund of returned items at what was charged for them,
+// up to what is left of the charge, and counts them as returned. It returns
+// the refund already issued for the return instead, if any.
+func (l *ledger) reserve(returnID, orderID string, items []*pb.CartItem) (*pendingRefund, *pb.RefundReturnResponse, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	o, err := l.load(orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if res, ok := o.refunds[returnID]; ok {
+		if res == nil {
+			return nil, nil, status.Errorf(codes.Aborted, "return %s is being refunded", returnID)
+		}
+		return nil, res, nil
+	}
+
+	returned := make(map[string]int32)
+	for _, item := range items {
+		id := item.GetProductId()
+		returned[id] += item.GetQuantity()
+		if left := o.items[id].Quantity - o.returned[id]; returned[id] > left {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "only %d of product %q can be refunded", left, id)
+		}
+	}
+	amount := pb.Money{CurrencyCode: o.charged.GetCurrencyCode()}
+	for id, qty := range returned {
+		amount = money.Must(money.Sum(amount, o.refundOf(id, qty)))
+	}
+	left := money.Must(money.Sum(o.charged, money.Negate(o.refunded)))
+	if money.IsNegative(money.Must(money.Sum(left, money.Negate(amount)))) {
+		amount = left
+	}
+
+	for id, qty := range returned {
+		o.returned[id] += qty
+	}
+	o.refunded = money.Must(money.Sum(o.refunded, amount))
+	o.refunds[returnID] = nil
+	return &pendingRefund{
+		returnID:      returnID,
+		orderID:       orderID,
+		transactionID: o.transactionID,
+		items:         items,
+		amount:        amount,
+	}, nil, nil
+}
+
+// complete records the refund issued for a reserved refund.
+func (l *ledger) complete(p *pendingRefund, res *pb.RefundReturnResponse) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.orders[p.orderID].refunds[p.returnID] = res
+
+	refund := store.Refund{ReturnID: p.returnID, RefundID: res.GetRefundId(), Amount: res.GetAmount()}
+	for _, item := range p.items {
+		refund.Items = append(refund.Items, store.ReturnedItem{ProductID: item.GetProductId(), Quantity: item.GetQuantity()})
+	}
+	if err := l.store.AddRefund(p.orderID, refund); err != nil {
+		log.Errorf("failed to save refund %s of return %s: %+v", res.GetRefundId(), p.returnID, err)
+	}
+}
+
+// release cancels a reserved refund that could not be issued.
+func (l *ledger) release(p *pendingRefund) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	o := l.orders[p.orderID]
+	for _, item := range p.items {
+		o.returned[item.GetProductId()] -= item.GetQuantity()
+	}
+	o.refunded = money.Must(money.Sum(o.refunded, money.Negate(p.amount)))
+	delete(o.refunds, p.returnID)
+}
+
+// refundOf returns the share of what was paid for a product that is refunded
+// for qty more returned units. Shares are computed on the units returned so
+// far, so that returning every unit refunds exactly what was paid.
+func (o *chargedOrder) refundOf(productID string, qty int32) pb.Money {
+	it := o.items[productID]
+	before := o.returned[productID]
+	paid := paidFor(it)
+	upTo := func(n int32) pb.Money {
+		return money.MultiplyRatio(paid, int64(n), int64(it.Quantity))
+	}
+	return money.Must(money.Sum(upTo(before+qty), money.Negate(upTo(before))))
+}
+
+// paidFor returns what was paid for the units of a product: their amount and
+// the taxes added to it.
+func paidFor(it store.ChargedItem) pb.Money {
+	paid := *it.Amount
+	for _, t := range it.Taxes {
+		if !t.Included {
+			paid = money.Must(money.Sum(paid, *t.Amount))
+		}
+	}
+	return paid
+}
+
+// RefundReturn refunds the items of a return received by the shipping
+// service, at the price they were charged, after discounts, and the taxes
+// added to it. A return is refunded only once: asking again returns the same
+// refund.
+func (cs *checkoutService) RefundReturn(ctx context.Context, req *pb.RefundReturnRequest) (*pb.RefundReturnResponse, error) {
+	log.Infof("[RefundReturn] return_id=%q order_id=%q", req.ReturnId, req.OrderId)
+
+	if req.ReturnId == "" || req.OrderId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "a return ID and an order ID are required")
+	}
+	if len(req.Items) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "return %s has no items", req.ReturnId)
+	}
+	for _, item := range req.Items {
+		if item.GetQuantity() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid quantity %d of product %q", item.GetQuantity(), item.GetProductId())
+		}
+	}
+
+	pending, done, err := cs.orders.reserve(req.ReturnId, req.OrderId, req.Items)
+	if err != nil {
+		return nil, err
+	}
+	if done != nil {
+		return done, nil
+	}
+	refundID, err := cs.refundCard(ctx, pending.transactionID, &pending.amount)
+	if err != nil {
+		cs.orders.release(pending)
+		return nil, err
+	}
+	res := &pb.RefundReturnResponse{RefundId: refundID, Amount: &pending.amount}
+	cs.orders.complete(pending, res)
+	log.Infof("refunded %d.%09d %s for return %s (refund_id: %s)",
+		pending.amount.GetUnits(), pending.amount.GetNanos(), pending.amount.GetCurrencyCode(), req.ReturnId, refundID)
+	return res, nil
+}
+
+func (cs *checkoutService) refundCard(ctx context.Context, transactionID string, amount *pb.Money) (string, error) {
+	resp, err := cs.paymentSvc.Refund(ctx, &pb.RefundRequest{
+		TransactionId: transactionID,
+		Amount:        amount})
+	if err != nil {
+		return "", downstreamError(servicePayment, err, "could not refund the card")
+	}
+	return resp.GetRefundId(), nil
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+	"github.com/abruneau/hipstershop/src/checkoutservice/promotions"
+	"github.com/abruneau/hipstershop/src/checkoutservice/store"
+	"github.com/abruneau/hipstershop/src/checkoutservice/tax"
+)
+
+func usd(u int64, n int32) pb.Money { return pb.Money{Units: u, Nanos: n, CurrencyCode: "USD"} }
+
+func orderItem(id string, qty int32, cost pb.Money) *pb.OrderItem {
+	return &pb.OrderItem{Item: &pb.CartItem{ProductId: id, Quantity: qty}, Cost: &cost}
+}
+
+func returned(id string, qty int32) *pb.CartItem { return &pb.CartItem{ProductId: id, Quantity: qty} }
+
+// newTestLedger returns a ledger over a store holding order o1.
+func newTestLedger(t *testing.T) (*ledger, store.Store) {
+	s := store.NewMemoryStore()
+	if err := s.PutOrder(&store.Order{OrderID: "o1"}); err != nil {
+		t.Fatalf("PutOrder failed: %v", err)
+	}
+	return newLedger(s), s
+}
+
+// refund reserves and completes the refund of a return.
+func refund(t *testing.T, l *ledger, returnID string, items ...*pb.CartItem) pb.Money {
+	p, done, err := l.reserve(returnID, "o1", items)
+	if err != nil {
+		t.Fatalf("reserve %s failed: %v", returnID, err)
+	}
+	if done != nil {
+		return *done.Amount
+	}
+	l.complete(p, &pb.RefundReturnResponse{RefundId: "refund-" + returnID, Amount: &p.amount})
+	return p.amount
+}
+
+func TestLedgerRefund(t *testing.T) {
+	l, _ := newTestLedger(t)
+	items := []*pb.OrderItem{orderItem("mug", 3, usd(10, 0)), orderItem("lamp", 1, usd(25, 500000000))}
+	if err := l.record("o1", "tx1", chargedItems(items, nil, nil), usd(60, 0)); err != nil {
+		t.Fatalf("record failed: %v", err)
+	}
+
+	if got, want := refund(t, l, "r1", returned("mug", 2)), usd(20, 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("refund of 2 mugs = %v, expected %v", got, want)
+	}
+	if got, want := refund(t, l, "r1", returned("mug", 2)), usd(20, 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("refund of the same return = %v, expected %v", got, want)
+	}
+	if _, _, err := l.reserve("r2", "o1", []*pb.CartItem{returned("mug", 2)}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("reserve of more mugs than left: got %v, expected FailedPrecondition", err)
+	}
+	if _, _, err := l.reserve("r2", "o1", []*pb.CartItem{returned("vase", 1)}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("reserve of a product not ordered: got %v, expected FailedPrecondition", err)
+	}
+	if _, _, err := l.reserve("r1", "o2", []*pb.CartItem{returned("mug", 1)}); status.Code(err) != codes.NotFound {
+		t.Errorf("reserve for an unknown order: got %v, expected NotFound", err)
+	}
+}
+
+func TestLedgerRelease(t *testing.T) {
+	l, _ := newTestLedger(t)
+	l.record("o1", "tx1", chargedItems([]*pb.OrderItem{orderItem("mug", 1, usd(10, 0))}, nil, nil), usd(10, 0))
+
+	p, _, err := l.reserve("r1", "o1", []*pb.CartItem{returned("mug", 1)})
+	if err != nil {
+		t.Fatalf("reserve failed: %v", err)
+	}
+	if _, _, err := l.reserve("r1", "o1", []*pb.CartItem{returned("mug", 1)}); status.Code(err) != codes.Aborted {
+		t.Errorf("reserve while refunding: got %v, expected Aborted", err)
+	}
+	l.release(p)
+	if got, want := refund(t, l, "r1", returned("mug", 1)), usd(10, 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("refund after release = %v, expected %v", got, want)
+	}
+}
+
+func TestLedgerRestart(t *testing.T) {
+	l, s := newTestLedger(t)
+	l.record("o1", "tx1", chargedItems([]*pb.OrderItem{orderItem("mug", 3, usd(10, 0))}, nil, nil), usd(30, 0))
+	refund(t, l, "r1", returned("mug", 1))
+
+	// A new ledger over the same store knows the charge and its refunds.
+	l = newLedger(s)
+	p, done, err := l.reserve("r1", "o1", []*pb.CartItem{returned("mug", 1)})
+	if err != nil || p != nil || done.GetRefundId() != "refund-r1" {
+		t.Errorf("reserve of a refunded return after a restart = %v, %v, %v; expected refund-r1", p, done, err)
+	}
+	if _, _, err := l.reserve("r2", "o1", []*pb.CartItem{returned("mug", 3)}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("reserve of returned mugs after a restart: got %v, expected FailedPrecondition", err)
+	}
+	if got, want := refund(t, l, "r2", returned("mug", 2)), usd(20, 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("refund after a restart = %v, expected %v", got, want)
+	}
+	if got := refund(t, newLedger(s), "r2", returned("mug", 2)); !reflect.DeepEqual(got, usd(20, 0)) {
+		t.Errorf("refund of r2 after another restart = %v, expected it again", got)
+	}
+}
+
+func TestLedgerForget(t *testing.T) {
+	l, s := newTestLedger(t)
+	l.record("o1", "tx1", chargedItems([]*pb.OrderItem{orderItem("mug", 1, usd(10, 0))}, nil, nil), usd(10, 0))
+	if err := l.forget("o1"); err != nil {
+		t.Fatalf("forget failed: %v", err)
+	}
+	if _, _, err := newLedger(s).reserve("r1", "o1", []*pb.CartItem{returned("mug", 1)}); status.Code(err) != codes.NotFound {
+		t.Errorf("reserve for a forgotten charge: got %v, expected NotFound", err)
+	}
+}
+
+func TestLedgerRefundDiscounted(t *testing.T) {
+	l, _ := newTestLedger(t)
+	items := []*pb.OrderItem{orderItem("mug", 2, usd(10, 0)), orderItem("lamp", 1, usd(40, 0))}
+	// 25% off the mugs, then 50% off the lamp.
+	discounts := []promotions.Discount{
+		{Amount: usd(5, 0), Items: []pb.Money{usd(5, 0), usd(0, 0)}},
+		{Amount: usd(20, 0), Items: []pb.Money{usd(0, 0), usd(20, 0)}},
+	}
+	l.record("o1", "tx1", chargedItems(items, discounts, nil), usd(35, 0))
+
+	if got, want := refund(t, l, "r1", returned("lamp", 1)), usd(20, 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("refund of a discounted lamp = %v, expected what was paid for it, %v", got, want)
+	}
+	if got, want := refund(t, l, "r2", returned("mug", 1)), usd(7, 500000000); !reflect.DeepEqual(got, want) {
+		t.Errorf("refund of a discounted mug = %v, expected %v", got, want)
+	}
+	if got, want := refund(t, l, "r3", returned("mug", 1)), usd(7, 500000000); !reflect.DeepEqual(got, want) {
+		t.Errorf("refund of the other mug = %v, expected %v", got, want)
+	}
+}
+
+func TestLedgerRefundRounding(t *testing.T) {
+	l, _ := newTestLedger(t)
+	// $10 off 3 mugs leaves $20 to refund, which is not a whole number of
+	// nanos per mug.
+	items := []*pb.OrderItem{orderItem("mug", 3, usd(10, 0))}
+	discounts := []promotions.Discount{{Amount: usd(10, 0), Items: []pb.Money{usd(10, 0)}}}
+	l.record("o1", "tx1", chargedItems(items, discounts, nil), usd(20, 0))
+
+	got := []pb.Money{
+		refund(t, l, "r1", returned("mug", 1)),
+		refund(t, l, "r2", returned("mug", 1)),
+		refund(t, l, "r3", returned("mug", 1)),
+	}
+	want := []pb.Money{usd(6, 666666666), usd(6, 666666667), usd(6, 666666667)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("refunds = %v, expected %v, adding up to what was paid", got, want)
+	}
+}
+
+func TestLedgerRefundTaxes(t *testing.T) {
+	taxes, err := tax.Load("taxes.json")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	items := []*pb.OrderItem{orderItem("mug", 3, usd(10, 0)), orderItem("lamp", 1, usd(25, 0))}
+	order := func(country, state string) []tax.Line {
+		return taxes.Compute(tax.Order{
+			Items:    []tax.Item{{Amount: usd(30, 0)}, {Amount: usd(25, 0)}},
+			Shipping: usd(5, 0),
+			Country:  country,
+			State:    state,
+			Currency: "USD",
+		})
+	}
+
+	tests := []struct {
+		name    string
+		taxes   []tax.Line
+		charged pb.Money
+		want    []pb.Money
+	}{
+		// 4% of $55 and of $5 of shipping, which is not refunded.
+		{"added", order("US", "NY"), usd(62, 400000000), []pb.Money{usd(20, 800000000), usd(26, 0)}},
+		// 10% included in the prices.
+		{"included", order("JP", ""), usd(60, 0), []pb.Money{usd(20, 0), usd(25, 0)}},
+	}
+	for _, tt := range tests {
+		l, s := newTestLedger(t)
+		l.record("o1", "tx1", chargedItems(items, nil, tt.taxes), tt.charged)
+		// Taxes are refunded from what was stored with the charge.
+		l = newLedger(s)
+		got := []pb.Money{
+			refund(t, l, "r1", returned("mug", 2)),
+			refund(t, l, "r2", returned("lamp", 1)),
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: refunds = %v, expected %v", tt.name, got, tt.want)
+		}
+	}
+}
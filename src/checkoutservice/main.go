@@ -4,16 +4,23 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/abruneau/hipstershop/src/checkoutservice/logwrapper"
 	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
 	money "github.com/abruneau/hipstershop/src/checkoutservice/money"
+	"github.com/abruneau/hipstershop/src/checkoutservice/promotions"
+	"github.com/abruneau/hipstershop/src/checkoutservice/resilience"
+	"github.com/abruneau/hipstershop/src/checkoutservice/store"
+	"github.com/abruneau/hipstershop/src/checkoutservice/tax"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
@@ -37,6 +44,20 @@ type checkoutService struct {
 	shippingSvcAddr       string
 	emailSvcAddr          string
 	paymentSvcAddr        string
+
+	// Clients share one long-lived connection per service.
+	productCatalogSvc pb.ProductCatalogServiceClient
+	cartSvc           pb.CartServiceClient
+	currencySvc       pb.CurrencyServiceClient
+	shippingSvc       pb.ShippingServiceClient
+	emailSvc          pb.EmailServiceClient
+	paymentSvc        pb.PaymentServiceClient
+
+	orders      *ledger
+	orderStore  store.Store
+	idempotency *idempotencyStore
+	promotions  *promotions.Engine
+	taxes       *tax.Engine
 }
 
 func main() {
@@ -45,7 +66,7 @@ func main() {
 		port = os.Getenv("PORT")
 	}
 
-	svc := new(checkoutService)
+	svc := &checkoutService{}
 	mustMapEnv(&svc.shippingSvcAddr, "SHIPPING_SERVICE_ADDR")
 	mustMapEnv(&svc.productCatalogSvcAddr, "PRODUCT_CATALOG_SERVICE_ADDR")
 	mustMapEnv(&svc.cartSvcAddr, "CART_SERVICE_ADDR")
@@ -53,8 +74,69 @@ func main() {
 	mustMapEnv(&svc.emailSvcAddr, "EMAIL_SERVICE_ADDR")
 	mustMapEnv(&svc.paymentSvcAddr, "PAYMENT_SERVICE_ADDR")
 
+	ttl := defaultIdempotencyTTL
+	if v := os.Getenv("IDEMPOTENCY_KEY_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			log.Fatalf("invalid IDEMPOTENCY_KEY_TTL %q", v)
+		}
+		ttl = d
+	}
+	svc.idempotency = newIdempotencyStore(ttl)
+
+	promotionsConfig := defaultPromotionsConfig
+	if v, ok := os.LookupEnv("PROMOTIONS_CONFIG"); ok {
+		promotionsConfig = v
+	}
+	engine, err := promotions.Load(promotionsConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+	svc.promotions = engine
+
+	taxConfig := defaultTaxConfig
+	if v, ok := os.LookupEnv("TAX_CONFIG"); ok {
+		taxConfig = v
+	}
+	taxes, err := tax.Load(taxConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+	svc.taxes = taxes
+
+	if v, ok := os.LookupEnv("METRICS_PORT"); ok {
+		go serveMetrics(v)
+	}
+
+	orderStore, err := store.New(log.Logger)
+	if err != nil {
+		log.Fatalf("failed to connect to the order store: %+v", err)
+	}
+	defer orderStore.Disconnect()
+	svc.orderStore = orderStore
+	svc.orders = newLedger(orderStore)
+
 	log.Infof("service config: %+v", svc)
 
+	productCatalogConn := mustDial("productcatalog", svc.productCatalogSvcAddr)
+	defer productCatalogConn.Close()
+	svc.productCatalogSvc = pb.NewProductCatalogServiceClient(productCatalogConn)
+	cartConn := mustDial("cart", svc.cartSvcAddr)
+	defer cartConn.Close()
+	svc.cartSvc = pb.NewCartServiceClient(cartConn)
+	currencyConn := mustDial("currency", svc.currencySvcAddr)
+	defer currencyConn.Close()
+	svc.currencySvc = pb.NewCurrencyServiceClient(currencyConn)
+	shippingConn := mustDial("shipping", svc.shippingSvcAddr)
+	defer shippingConn.Close()
+	svc.shippingSvc = pb.NewShippingServiceClient(shippingConn)
+	emailConn := mustDial("email", svc.emailSvcAddr)
+	defer emailConn.Close()
+	svc.emailSvc = pb.NewEmailServiceClient(emailConn)
+	paymentConn := mustDial("payment", svc.paymentSvcAddr)
+	defer paymentConn.Close()
+	svc.paymentSvc = pb.NewPaymentServiceClient(paymentConn)
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", port))
 	if err != nil {
 		log.Fatal(err)
@@ -69,6 +151,23 @@ func main() {
 	log.Fatal(err)
 }
 
+func mustDial(name, addrs string) *grpc.ClientConn {
+	dep := resilience.NewDependency(name, idempotentCalls, log.Logger)
+	conn, err := dialService(name, addrs, grpc.WithUnaryInterceptor(dep.Intercept))
+	if err != nil {
+		log.Fatalf("failed to connect %s service: %+v", name, err)
+	}
+	return conn
+}
+
+// serveMetrics serves the state of the circuit breakers of the services,
+// published by mustDial under "dependencies", with the other expvar
+// variables, as JSON under /debug/vars.
+func serveMetrics(port string) {
+	log.Infof("serving metrics on tcp: %q", ":"+port)
+	log.Error(http.ListenAndServe(":"+port, nil))
+}
+
 func mustMapEnv(target *string, envKey string) {
 	v := os.Getenv(envKey)
 	if v == "" {
@@ -85,47 +184,178 @@ func (cs *checkoutService) Watch(req *healthpb.HealthCheckRequest, ws healthpb.H
 	return status.Errorf(codes.Unimplemented, "health check via Watch not implemented")
 }
 
+// PlaceOrder places the order in the cart of the user. Requests with an
+// idempotency key place the order once: retries get the same order back.
 func (cs *checkoutService) PlaceOrder(ctx context.Context, req *pb.PlaceOrderRequest) (*pb.PlaceOrderResponse, error) {
-	log.Infof("[PlaceOrder] user_id=%q user_currency=%q", req.UserId, req.UserCurrency)
+	log.Infof("[PlaceOrder] user_id=%q user_currency=%q idempotency_key=%q", req.UserId, req.UserCurrency, req.IdempotencyKey)
 
+	if err := validatePlaceOrder(req, time.Now()); err != nil {
+		return nil, err
+	}
+	if req.IdempotencyKey == "" {
+		return cs.placeOrder(ctx, req)
+	}
+	placed, err := cs.idempotency.begin(req)
+	if err != nil {
+		return nil, err
+	}
+	if placed != nil {
+		log.Infof("order %s was already placed with idempotency key %q", placed.GetOrder().GetOrderId(), req.IdempotencyKey)
+		return placed, nil
+	}
+	resp, err := cs.placeOrder(ctx, req)
+	if _, ok := err.(incompleteRollback); ok {
+		// The card may have been charged, so retries get the failure back
+		// instead of placing the order again.
+		cs.idempotency.fail(req, err)
+		return nil, err
+	}
+	if err != nil {
+		// The steps done were undone, so the order can be placed again.
+		cs.idempotency.release(req)
+		return nil, err
+	}
+	cs.idempotency.finish(req, resp)
+	return resp, nil
+}
+
+func (cs *checkoutService) placeOrder(ctx context.Context, req *pb.PlaceOrderRequest) (*pb.PlaceOrderResponse, error) {
 	orderID, err := uuid.NewUUID()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate order uuid")
 	}
 
-	prep, err := cs.prepareOrderItemsAndShippingQuoteFromCart(ctx, req.UserId, req.UserCurrency, req.Address)
+	// Orders to a pickup point are quoted and shipped to its address.
+	var pickup *pb.PickupPoint
+	var address *pb.Address
+	if req.PickupPointId != "" {
+		pickup, err = cs.getPickupPoint(ctx, req.PickupPointId)
+		address = pickup.GetAddress()
+	} else {
+		address, err = cs.validateAddress(ctx, req.Address)
+	}
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, err
 	}
 
-	total := pb.Money{CurrencyCode: req.UserCurrency,
-		Units: 0,
-		Nanos: 0}
-	total = money.Must(money.Sum(total, *prep.shippingCostLocalized))
-	for _, it := range prep.orderItems {
-		total = money.Must(money.Sum(total, *it.Cost))
+	// Failures keep the code and details of the call that failed, such as
+	// the items that cannot be shipped to the address.
+	prep, err := cs.prepareOrderItemsAndShippingQuoteFromCart(ctx, orderID.String(), req.UserId, req.UserCurrency, address, req.ShippingOptionId, req.ShippingPromoCode, req.PromoCode)
+	if err != nil {
+		return nil, err
 	}
 
-	txID, err := cs.chargeCard(ctx, &total, req.CreditCard)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to charge card: %+v", err)
+	// Discounts are taken off the items, not off shipping. Taxes included in
+	// the prices are already in the subtotal.
+	total := money.Must(money.Sum(prep.subtotal, *prep.shippingCostLocalized))
+	total = money.Must(money.Sum(total, tax.Added(prep.taxes, req.UserCurrency)))
+	// Duties and taxes are charged now when prepaid (DDP), and are otherwise
+	// due on delivery (DDU).
+	dutiesPrepaid := req.PrepayDuties && prep.duties != nil
+	if dutiesPrepaid {
+		total = money.Must(money.Sum(total, *prep.duties.GetTotal()))
+	}
+
+	// The order is saved before anything is charged, so that its step log
+	// can be recorded.
+	if err := cs.orderStore.PutOrder(&store.Order{
+		OrderID:   orderID.String(),
+		UserID:    req.UserId,
+		Email:     req.Email,
+		Status:    pb.OrderStatus_ORDER_PENDING,
+		TotalPaid: &total,
+		CreatedAt: time.Now(),
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save order: %+v", err)
 	}
-	log.Infof("payment went through (transaction_id: %s)", txID)
 
-	shippingTrackingID, err := cs.shipOrder(ctx, req.Address, prep.cartItems)
+	// Once the card is charged, a failed step undoes the ones before it, so
+	// that no order is left charged but not shipped.
+	var txID string
+	var shipment *pb.ShipOrderResponse
+	err = cs.runSaga(ctx, orderID.String(), []sagaStep{{
+		name: "redeem_promotions",
+		do: func(ctx context.Context) error {
+			return cs.redeemPromotions(req.UserId, prep.discounts)
+		},
+		compensate: func(ctx context.Context) error {
+			return cs.releasePromotions(req.UserId, prep.discounts)
+		},
+	}, {
+		name: "charge_payment",
+		do: func(ctx context.Context) error {
+			id, err := cs.chargeCard(ctx, &total, req.CreditCard)
+			if err != nil {
+				return err
+			}
+			txID = id
+			log.Infof("payment went through (transaction_id: %s)", txID)
+			return nil
+		},
+		// A charge that timed out may have gone through, but cannot be
+		// refunded without its transaction ID.
+		undoFailed: mayHaveTakenEffect,
+		compensate: func(ctx context.Context) error {
+			if txID == "" {
+				return status.Errorf(codes.Unknown, "the card may have been charged %d.%09d %s, but the transaction is unknown",
+					total.GetUnits(), total.GetNanos(), total.GetCurrencyCode())
+			}
+			refundID, err := cs.refundCard(ctx, txID, &total)
+			if err != nil {
+				return err
+			}
+			log.Infof("refunded transaction %s of order %s (refund_id: %s)", txID, orderID, refundID)
+			return nil
+		},
+	}, {
+		name: "record_charge",
+		do: func(ctx context.Context) error {
+			if err := cs.orders.record(orderID.String(), txID, chargedItems(prep.orderItems, prep.discounts, prep.taxes), total); err != nil {
+				return status.Errorf(codes.Internal, "failed to record the charge: %+v", err)
+			}
+			return nil
+		},
+		compensate: func(ctx context.Context) error {
+			return cs.orders.forget(orderID.String())
+		},
+	}, {
+		name: "ship_order",
+		do: func(ctx context.Context) error {
+			resp, err := cs.shipOrder(ctx, orderID.String(), address, req.PickupPointId, prep.cartItems, req.ShippingOptionId, prep.carrierID)
+			if err != nil {
+				return err
+			}
+			shipment = resp
+			return nil
+		},
+		// A shipment may be created even though the call timed out.
+		undoFailed: mayHaveTakenEffect,
+		compensate: func(ctx context.Context) error {
+			return cs.cancelShipments(ctx, orderID.String())
+		},
+	}})
 	if err != nil {
-		return nil, status.Errorf(codes.Unavailable, "shipping error: %+v", err)
+		cs.setOrderResult(orderID.String(), pb.OrderStatus_ORDER_FAILED, nil)
+		return nil, err
 	}
 
 	_ = cs.emptyUserCart(ctx, req.UserId)
 
 	orderResult := &pb.OrderResult{
 		OrderId:            orderID.String(),
-		ShippingTrackingId: shippingTrackingID,
+		ShippingTrackingId: shipment.GetTrackingId(),
 		ShippingCost:       prep.shippingCostLocalized,
-		ShippingAddress:    req.Address,
+		ShippingAddress:    address,
 		Items:              prep.orderItems,
+		ShippingPromotion:  prep.shippingPromotion,
+		Parcels:            shipment.GetParcels(),
+		Duties:             prep.duties,
+		DutiesPrepaid:      dutiesPrepaid,
+		PickupPoint:        shipment.GetPickupPoint(),
+		Discounts:          discountProtos(prep.discounts),
+		Taxes:              taxProtos(prep.taxes),
 	}
+	cs.setOrderResult(orderID.String(), pb.OrderStatus_ORDER_PLACED, orderResult)
 
 	if err := cs.sendOrderConfirmation(ctx, req.Email, orderResult); err != nil {
 		log.Warnf("failed to send order confirmation to %q: %+v", req.Email, err)
@@ -137,162 +367,244 @@ func (cs *checkoutService) PlaceOrder(ctx context.Context, req *pb.PlaceOrderReq
 }
 
 type orderPrep struct {
-	orderItems            []*pb.OrderItem
-	cartItems             []*pb.CartItem
+	orderItems []*pb.OrderItem
+	cartItems  []*pb.CartItem
+	discounts  []promotions.Discount
+	// subtotal is the cost of the items, less the discounts.
+	subtotal              pb.Money
 	shippingCostLocalized *pb.Money
+	shippingPromotion     *pb.ShippingPromotion
+	carrierID             string
+	duties                *pb.DutiesEstimate
+	taxes                 []tax.Line
 }
 
-func (cs *checkoutService) prepareOrderItemsAndShippingQuoteFromCart(ctx context.Context, userID, userCurrency string, address *pb.Address) (orderPrep, error) {
+func (cs *checkoutService) prepareOrderItemsAndShippingQuoteFromCart(ctx context.Context, orderID, userID, userCurrency string, address *pb.Address, shippingOptionID, shippingPromoCode, promoCode string) (orderPrep, error) {
 	var out orderPrep
 	cartItems, err := cs.getUserCart(ctx, userID)
 	if err != nil {
-		return out, fmt.Errorf("cart failure: %+v", err)
+		return out, err
 	}
-	orderItems, err := cs.prepOrderItems(ctx, cartItems, userCurrency)
+	orderItems, products, err := cs.prepOrderItems(ctx, orderID, cartItems, userCurrency)
 	if err != nil {
-		return out, fmt.Errorf("failed to prepare order: %+v", err)
+		return out, err
 	}
-	shippingUSD, err := cs.quoteShipping(ctx, address, cartItems)
+	discounts, err := cs.applyPromotions(ctx, orderItems, products, userCurrency, promoCode)
 	if err != nil {
-		return out, fmt.Errorf("shipping quote failure: %+v", err)
+		return out, err
+	}
+	subtotal := pb.Money{CurrencyCode: userCurrency}
+	for _, it := range orderItems {
+		cost := money.MultiplySlow(*it.Cost, uint32(it.GetItem().GetQuantity()))
+		subtotal = money.Must(money.Sum(subtotal, cost))
+	}
+	for _, d := range discounts {
+		subtotal = money.Must(money.Sum(subtotal, money.Negate(d.Amount)))
 	}
-	shippingPrice, err := cs.convertCurrency(ctx, shippingUSD, userCurrency)
+	// Shipping promotions apply to the discounted subtotal.
+	shippingQuote, err := cs.quoteShipping(ctx, address, cartItems, shippingOptionID, &subtotal, shippingPromoCode)
 	if err != nil {
-		return out, fmt.Errorf("failed to convert shipping cost to currency: %+v", err)
+		return out, err
 	}
 
-	out.shippingCostLocalized = shippingPrice
+	// Taxes apply to the discounted items and to the quoted shipping cost.
+	out.taxes = cs.computeTaxes(address, orderItems, products, discounts, *shippingQuote.GetCost(), shippingQuote.GetDuties(), userCurrency)
+
+	out.shippingCostLocalized = shippingQuote.GetCost()
+	out.shippingPromotion = shippingQuote.GetPromotion()
+	out.carrierID = shippingQuote.GetCarrierId()
+	out.duties = shippingQuote.GetDuties()
 	out.cartItems = cartItems
 	out.orderItems = orderItems
+	out.discounts = discounts
+	out.subtotal = subtotal
 	return out, nil
 }
 
-func (cs *checkoutService) quoteShipping(ctx context.Context, address *pb.Address, items []*pb.CartItem) (*pb.Money, error) {
-	conn, err := grpc.DialContext(ctx, cs.shippingSvcAddr, grpc.WithInsecure())
+// validateAddress checks the shipping address with the shipping service and
+// returns it normalized. Invalid addresses are rejected before anything is
+// charged.
+func (cs *checkoutService) validateAddress(ctx context.Context, address *pb.Address) (*pb.Address, error) {
+	resp, err := cs.shippingSvc.ValidateAddress(ctx, &pb.ValidateAddressRequest{Address: address})
 	if err != nil {
-		return nil, fmt.Errorf("could not connect shipping service: %+v", err)
+		return nil, downstreamError(serviceShipping, err, "failed to validate address")
+	}
+	if !resp.GetValid() {
+		violations := make([]*errdetails.BadRequest_FieldViolation, len(resp.GetErrors()))
+		for i, e := range resp.GetErrors() {
+			violations[i] = &errdetails.BadRequest_FieldViolation{
+				Field:       "address." + e.GetField(),
+				Description: e.GetDescription(),
+			}
+		}
+		return nil, badRequest(violations)
 	}
-	defer conn.Close()
+	return resp.GetNormalizedAddress(), nil
+}
 
-	shippingQuote, err := pb.NewShippingServiceClient(conn).
-		GetQuote(ctx, &pb.GetQuoteRequest{
-			Address: address,
-			Items:   items})
+// getPickupPoint looks up the pickup point chosen by the user.
+func (cs *checkoutService) getPickupPoint(ctx context.Context, id string) (*pb.PickupPoint, error) {
+	point, err := cs.shippingSvc.GetPickupPoint(ctx, &pb.GetPickupPointRequest{Id: id})
+	if status.Code(err) == codes.NotFound {
+		return nil, fieldError("pickup_point_id", "unknown pickup point %q", id)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get shipping quote: %+v", err)
+		return nil, downstreamError(serviceShipping, err, "failed to get pickup point")
 	}
-	return shippingQuote.GetCostUsd(), nil
+	return point, nil
 }
 
-func (cs *checkoutService) getUserCart(ctx context.Context, userID string) ([]*pb.CartItem, error) {
-	conn, err := grpc.DialContext(ctx, cs.cartSvcAddr, grpc.WithInsecure())
+// quoteShipping quotes the shipping cost in the currency of the subtotal,
+// including any shipping promotion the order qualifies for. The shipping
+// service converts and rounds the quote itself. Items that cannot be shipped
+// to the address fail with FailedPrecondition, detailing each item.
+func (cs *checkoutService) quoteShipping(ctx context.Context, address *pb.Address, items []*pb.CartItem, shippingOptionID string, subtotal *pb.Money, promoCode string) (*pb.GetQuoteResponse, error) {
+	shippingQuote, err := cs.shippingSvc.GetQuote(ctx, &pb.GetQuoteRequest{
+		Address:          address,
+		Items:            items,
+		ShippingOptionId: shippingOptionID,
+		CurrencyCode:     subtotal.GetCurrencyCode(),
+		Subtotal:         subtotal,
+		PromoCode:        promoCode})
 	if err != nil {
-		return nil, fmt.Errorf("could not connect cart service: %+v", err)
+		return nil, downstreamError(serviceShipping, err, "failed to get shipping quote")
 	}
-	defer conn.Close()
+	return shippingQuote, nil
+}
 
-	cart, err := pb.NewCartServiceClient(conn).GetCart(ctx, &pb.GetCartRequest{UserId: userID})
+func (cs *checkoutService) getUserCart(ctx context.Context, userID string) ([]*pb.CartItem, error) {
+	cart, err := cs.cartSvc.GetCart(ctx, &pb.GetCartRequest{UserId: userID})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user cart during checkout: %+v", err)
+		return nil, downstreamError(serviceCart, err, "failed to get user cart during checkout")
 	}
 	return cart.GetItems(), nil
 }
 
 func (cs *checkoutService) emptyUserCart(ctx context.Context, userID string) error {
-	conn, err := grpc.DialContext(ctx, cs.cartSvcAddr, grpc.WithInsecure())
-	if err != nil {
-		return fmt.Errorf("could not connect cart service: %+v", err)
-	}
-	defer conn.Close()
-
-	if _, err = pb.NewCartServiceClient(conn).EmptyCart(ctx, &pb.EmptyCartRequest{UserId: userID}); err != nil {
+	if _, err := cs.cartSvc.EmptyCart(ctx, &pb.EmptyCartRequest{UserId: userID}); err != nil {
 		return fmt.Errorf("failed to empty user cart during checkout: %+v", err)
 	}
 	return nil
 }
 
-func (cs *checkoutService) prepOrderItems(ctx context.Context, items []*pb.CartItem, userCurrency string) ([]*pb.OrderItem, error) {
-	out := make([]*pb.OrderItem, len(items))
-
-	conn, err := grpc.DialContext(ctx, cs.productCatalogSvcAddr, grpc.WithInsecure())
-	if err != nil {
-		return nil, fmt.Errorf("could not connect product catalog service: %+v", err)
+// prepOrderItems prices the cart items in the currency of the user, and
+// returns their products by ID. Products are looked up and their prices
+// converted concurrently, each product and each distinct price once. The
+// first failure cancels the other calls.
+func (cs *checkoutService) prepOrderItems(ctx context.Context, orderID string, items []*pb.CartItem, userCurrency string) ([]*pb.OrderItem, map[string]*pb.Product, error) {
+	var ids []string
+	index := make(map[string]int)
+	for _, item := range items {
+		if _, ok := index[item.GetProductId()]; !ok {
+			index[item.GetProductId()] = len(ids)
+			ids = append(ids, item.GetProductId())
+		}
 	}
-	defer conn.Close()
-	cl := pb.NewProductCatalogServiceClient(conn)
 
-	for i, item := range items {
-		product, err := cl.GetProduct(ctx, &pb.GetProductRequest{Id: item.GetProductId()})
+	trace := newFanOutTrace(orderID)
+	conv := newConversions(cs, userCurrency, trace)
+	prices := make([]*pb.Money, len(ids))
+	products := make([]*pb.Product, len(ids))
+	err := fanOut(ctx, len(ids), maxFanOut, func(ctx context.Context, i int) error {
+		var product *pb.Product
+		err := trace.call("GetProduct "+ids[i], func() error {
+			var err error
+			product, err = cs.productCatalogSvc.GetProduct(ctx, &pb.GetProductRequest{Id: ids[i]})
+			return err
+		})
+		if status.Code(err) == codes.NotFound {
+			return withDetails(serviceError(codes.NotFound, serviceProductCatalog, reasonProductNotFound, err, "product %q is no longer available", ids[i]),
+				&errdetails.ResourceInfo{ResourceType: "product", ResourceName: ids[i]})
+		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to get product #%q", item.GetProductId())
+			return downstreamError(serviceProductCatalog, err, "failed to get product %q", ids[i])
 		}
-		price, err := cs.convertCurrency(ctx, product.GetPriceUsd(), userCurrency)
+		products[i] = product
+		prices[i], err = conv.convert(ctx, product.GetPriceUsd())
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert price of %q to %s", item.GetProductId(), userCurrency)
+			return err
 		}
+		return nil
+	})
+	trace.done(len(items), err)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := make([]*pb.OrderItem, len(items))
+	for i, item := range items {
 		out[i] = &pb.OrderItem{
 			Item: item,
-			Cost: price}
+			Cost: prices[index[item.GetProductId()]]}
 	}
-	return out, nil
+	byID := make(map[string]*pb.Product, len(ids))
+	for i, id := range ids {
+		byID[id] = products[i]
+	}
+	return out, byID, nil
 }
 
 func (cs *checkoutService) convertCurrency(ctx context.Context, from *pb.Money, toCurrency string) (*pb.Money, error) {
-	conn, err := grpc.DialContext(ctx, cs.currencySvcAddr, grpc.WithInsecure())
-	if err != nil {
-		return nil, fmt.Errorf("could not connect currency service: %+v", err)
-	}
-	defer conn.Close()
-	result, err := pb.NewCurrencyServiceClient(conn).Convert(ctx, &pb.CurrencyConversionRequest{
+	result, err := cs.currencySvc.Convert(ctx, &pb.CurrencyConversionRequest{
 		From:   from,
 		ToCode: toCurrency})
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert currency: %+v", err)
+		return nil, downstreamError(serviceCurrency, err, "failed to convert currency to %s", toCurrency)
 	}
 	return result, err
 }
 
+// chargeCard charges the card. Cards the payment service rejects fail with
+// FailedPrecondition, and the reason it gave.
 func (cs *checkoutService) chargeCard(ctx context.Context, amount *pb.Money, paymentInfo *pb.CreditCardInfo) (string, error) {
-	conn, err := grpc.DialContext(ctx, cs.paymentSvcAddr, grpc.WithInsecure())
-	if err != nil {
-		return "", fmt.Errorf("failed to connect payment service: %+v", err)
-	}
-	defer conn.Close()
-
-	paymentResp, err := pb.NewPaymentServiceClient(conn).Charge(ctx, &pb.ChargeRequest{
+	paymentResp, err := cs.paymentSvc.Charge(ctx, &pb.ChargeRequest{
 		Amount:     amount,
 		CreditCard: paymentInfo})
-	if err != nil {
-		return "", fmt.Errorf("could not charge the card: %+v", err)
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		return "", serviceError(codes.FailedPrecondition, servicePayment, reasonPaymentDeclined, err, "payment declined")
+	default:
+		return "", downstreamError(servicePayment, err, "could not charge the card")
 	}
 	return paymentResp.GetTransactionId(), nil
 }
 
 func (cs *checkoutService) sendOrderConfirmation(ctx context.Context, email string, order *pb.OrderResult) error {
-	conn, err := grpc.DialContext(ctx, cs.emailSvcAddr, grpc.WithInsecure())
-	if err != nil {
-		return fmt.Errorf("failed to connect email service: %+v", err)
-	}
-	defer conn.Close()
-	_, err = pb.NewEmailServiceClient(conn).SendOrderConfirmation(ctx, &pb.SendOrderConfirmationRequest{
+	_, err := cs.emailSvc.SendOrderConfirmation(ctx, &pb.SendOrderConfirmationRequest{
 		Email: email,
 		Order: order})
 	return err
 }
 
-func (cs *checkoutService) shipOrder(ctx context.Context, address *pb.Address, items []*pb.CartItem) (string, error) {
-	conn, err := grpc.DialContext(ctx, cs.shippingSvcAddr, grpc.WithInsecure())
-	if err != nil {
-		return "", fmt.Errorf("failed to connect email service: %+v", err)
+// shipOrder ships the items to the pickup point if set, or else to the address.
+func (cs *checkoutService) shipOrder(ctx context.Context, orderID string, address *pb.Address, pickupPointID string, items []*pb.CartItem, shippingOptionID, carrierID string) (*pb.ShipOrderResponse, error) {
+	req := &pb.ShipOrderRequest{
+		Address:          address,
+		Items:            items,
+		ShippingOptionId: shippingOptionID,
+		CarrierId:        carrierID,
+		OrderId:          orderID,
+		PickupPointId:    pickupPointID}
+	if pickupPointID != "" {
+		req.Address = nil
 	}
-	defer conn.Close()
-	resp, err := pb.NewShippingServiceClient(conn).ShipOrder(ctx, &pb.ShipOrderRequest{
-		Address: address,
-		Items:   items})
+	resp, err := cs.shippingSvc.ShipOrder(ctx, req)
 	if err != nil {
-		return "", fmt.Errorf("shipment failed: %+v", err)
+		return nil, downstreamError(serviceShipping, err, "shipment failed")
 	}
-	return resp.GetTrackingId(), nil
+	return resp, nil
 }
 
-// TODO: Dial and create client once, reuse.
+// cancelShipments cancels the shipments of an order, if any.
+func (cs *checkoutService) cancelShipments(ctx context.Context, orderID string) error {
+	resp, err := cs.shippingSvc.CancelShipment(ctx, &pb.CancelShipmentRequest{OrderId: orderID})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not cancel the shipments: %+v", err)
+	}
+	log.Infof("canceled shipments %v of order %s", resp.GetTrackingIds(), orderID)
+	return nil
+}
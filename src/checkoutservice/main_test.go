@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+	"github.com/abruneau/hipstershop/src/checkoutservice/promotions"
+	"github.com/abruneau/hipstershop/src/checkoutservice/resilience"
+	"github.com/abruneau/hipstershop/src/checkoutservice/store"
+	"github.com/abruneau/hipstershop/src/checkoutservice/tax"
+)
+
+// closedAddr returns a local address nothing listens on.
+func closedAddr(t *testing.T) string {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	lis.Close()
+	return lis.Addr().String()
+}
+
+func TestMetricsBreakerState(t *testing.T) {
+	conn := mustDial("metrics-test", closedAddr(t))
+	defer conn.Close()
+	// serveMetrics serves the default mux.
+	srv := httptest.NewServer(http.DefaultServeMux)
+	defer srv.Close()
+
+	state := func() interface{} {
+		resp, err := http.Get(srv.URL + "/debug/vars")
+		if err != nil {
+			t.Fatalf("GET /debug/vars failed: %v", err)
+		}
+		defer resp.Body.Close()
+		var vars struct {
+			Dependencies map[string]map[string]interface{} `json:"dependencies"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&vars); err != nil {
+			t.Fatalf("failed to decode /debug/vars: %v", err)
+		}
+		return vars.Dependencies["metrics-test"]["state"]
+	}
+
+	if got := state(); got != "closed" {
+		t.Errorf("state before any call = %v, expected closed", got)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := healthpb.NewHealthClient(conn)
+	for i := 0; i < resilience.Threshold; i++ {
+		if _, err := client.Check(ctx, &healthpb.HealthCheckRequest{}); err == nil {
+			t.Fatalf("Check of a service that is down succeeded")
+		}
+	}
+	if got := state(); got != "open" {
+		t.Errorf("state after %d failed calls = %v, expected open", resilience.Threshold, got)
+	}
+}
+
+// Fake services of an order of one $10 product, shipped for $5.
+type fakeCart struct{ pb.CartServiceClient }
+
+func (fakeCart) GetCart(ctx context.Context, in *pb.GetCartRequest, opts ...grpc.CallOption) (*pb.Cart, error) {
+	return &pb.Cart{UserId: in.UserId, Items: []*pb.CartItem{{ProductId: "p1", Quantity: 1}}}, nil
+}
+
+func (fakeCart) EmptyCart(ctx context.Context, in *pb.EmptyCartRequest, opts ...grpc.CallOption) (*pb.Empty, error) {
+	return &pb.Empty{}, nil
+}
+
+type fakeCatalog struct{ pb.ProductCatalogServiceClient }
+
+func (fakeCatalog) GetProduct(ctx context.Context, in *pb.GetProductRequest, opts ...grpc.CallOption) (*pb.Product, error) {
+	return &pb.Product{Id: in.Id, PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 10}}, nil
+}
+
+type fakeShipping struct {
+	pb.ShippingServiceClient
+	shipped int
+}
+
+func (s *fakeShipping) ValidateAddress(ctx context.Context, in *pb.ValidateAddressRequest, opts ...grpc.CallOption) (*pb.ValidateAddressResponse, error) {
+	return &pb.ValidateAddressResponse{Valid: true, NormalizedAddress: in.Address}, nil
+}
+
+func (s *fakeShipping) GetQuote(ctx context.Context, in *pb.GetQuoteRequest, opts ...grpc.CallOption) (*pb.GetQuoteResponse, error) {
+	return &pb.GetQuoteResponse{Cost: &pb.Money{CurrencyCode: in.CurrencyCode, Units: 5}, CarrierId: "c1"}, nil
+}
+
+func (s *fakeShipping) ShipOrder(ctx context.Context, in *pb.ShipOrderRequest, opts ...grpc.CallOption) (*pb.ShipOrderResponse, error) {
+	s.shipped++
+	return &pb.ShipOrderResponse{TrackingId: "t1"}, nil
+}
+
+// fakePayment charges cards through the breaker of a dependency, with a
+// payment service that is down.
+type fakePayment struct {
+	pb.PaymentServiceClient
+	dep     *resilience.Dependency
+	charges int
+}
+
+func (p *fakePayment) Charge(ctx context.Context, in *pb.ChargeRequest, opts ...grpc.CallOption) (*pb.ChargeResponse, error) {
+	err := p.dep.Intercept(ctx, "/hipstershop.PaymentService/Charge", in, nil, nil,
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			p.charges++
+			return status.Error(codes.Unavailable, "connection refused")
+		})
+	return nil, err
+}
+
+// orderIDs records the IDs of the orders saved to a store.
+type orderIDs struct {
+	store.Store
+	ids []string
+}
+
+func (s *orderIDs) PutOrder(o *store.Order) error {
+	s.ids = append(s.ids, o.OrderID)
+	return s.Store.PutOrder(o)
+}
+
+func TestPlaceOrderPaymentBreakerOpen(t *testing.T) {
+	payment := &fakePayment{dep: resilience.NewDependency("payment-test", idempotentCalls, log.Logger)}
+	shipping := &fakeShipping{}
+	orderStore := &orderIDs{Store: store.NewMemoryStore()}
+	cs := &checkoutService{
+		productCatalogSvc: fakeCatalog{},
+		cartSvc:           fakeCart{},
+		shippingSvc:       shipping,
+		paymentSvc:        payment,
+		orders:            newLedger(orderStore),
+		orderStore:        orderStore,
+		idempotency:       newIdempotencyStore(time.Hour),
+		promotions:        &promotions.Engine{},
+		taxes:             &tax.Engine{},
+	}
+	for i := 0; i < resilience.Threshold; i++ {
+		payment.Charge(context.Background(), &pb.ChargeRequest{})
+	}
+
+	req := validOrder()
+	req.IdempotencyKey = "k1"
+	_, err := cs.PlaceOrder(context.Background(), req)
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("PlaceOrder = %v, expected Unavailable", err)
+	}
+	if _, ok := err.(incompleteRollback); ok {
+		t.Errorf("PlaceOrder = %v, expected a clean rollback of a charge that was never sent", err)
+	}
+	if payment.charges != resilience.Threshold || shipping.shipped != 0 {
+		t.Errorf("%d charges and %d shipments, expected only the %d charges that opened the breaker",
+			payment.charges, shipping.shipped, resilience.Threshold)
+	}
+
+	if len(orderStore.ids) != 1 {
+		t.Fatalf("%d orders were saved, expected 1", len(orderStore.ids))
+	}
+	order, err := orderStore.GetOrder(orderStore.ids[0])
+	if err != nil {
+		t.Fatalf("GetOrder failed: %v", err)
+	}
+	var steps []string
+	for _, s := range order.Steps {
+		steps = append(steps, s.Name+" "+s.Status)
+	}
+	want := []string{"redeem_promotions done", "charge_payment failed", "redeem_promotions compensated"}
+	if !reflect.DeepEqual(steps, want) {
+		t.Errorf("step log = %v, expected %v", steps, want)
+	}
+	if order.Status != pb.OrderStatus_ORDER_FAILED {
+		t.Errorf("order status = %v, expected ORDER_FAILED", order.Status)
+	}
+
+	// The key is released, so the order can be placed again.
+	if resp, err := cs.idempotency.begin(req); resp != nil || err != nil {
+		t.Errorf("begin after the rejected charge = %v, %v; expected the key to be claimed again", resp, err)
+	}
+}
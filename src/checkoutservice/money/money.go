@@ -2,6 +2,7 @@ package money
 
 import (
 	"errors"
+	"math/big"
 
 	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
 )
@@ -85,7 +86,7 @@ func Sum(l, r pb.Money) (pb.Money, error) {
 	units := l.GetUnits() + r.GetUnits()
 	nanos := l.GetNanos() + r.GetNanos()
 
-	if (units == 0 && nanos == 0) || (units > 0 && nanos >= 0) || (units < 0 && nanos <= 0) {
+	if (units >= 0 && nanos >= 0) || (units <= 0 && nanos <= 0) {
 		// same sign <units, nanos>
 		units += int64(nanos / nanosMod)
 		nanos = nanos % nanosMod
@@ -116,3 +117,18 @@ func MultiplySlow(m pb.Money, n uint32) pb.Money {
 	}
 	return out
 }
+
+// MultiplyRatio returns m*num/den, rounded toward zero to the nano. It panics
+// if den is zero.
+func MultiplyRatio(m pb.Money, num, den int64) pb.Money {
+	mod := big.NewInt(nanosMod)
+	n := new(big.Int).Mul(big.NewInt(m.GetUnits()), mod)
+	n.Add(n, big.NewInt(int64(m.GetNanos())))
+	n.Mul(n, big.NewInt(num))
+	n.Quo(n, big.NewInt(den))
+	units, nanos := new(big.Int).QuoRem(n, mod, new(big.Int))
+	return pb.Money{
+		Units:        units.Int64(),
+		Nanos:        int32(nanos.Int64()),
+		CurrencyCode: m.GetCurrencyCode()}
+}
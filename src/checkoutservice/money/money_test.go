@@ -206,6 +206,11 @@ func TestSum(t *testing.T) {
 		{"both positive (no carry)", args{mm(2, 200000000), mm(2, 200000000)}, mm(4, 400000000), nil},
 		{"both positive (nanos=max)", args{mm(2, 111111111), mm(2, 888888888)}, mm(4, 999999999), nil},
 		{"both positive (carry)", args{mm(2, 200000000), mm(2, 900000000)}, mm(5, 100000000), nil},
+		{"both positive under a unit (no carry)", args{mm(0, 330000000), mm(0, 330000000)}, mm(0, 660000000), nil},
+		{"both positive under a unit (carry)", args{mm(0, 600000000), mm(0, 600000000)}, mm(1, 200000000), nil},
+		{"both negative under a unit (carry)", args{mm(0, -600000000), mm(0, -600000000)}, mm(-1, -200000000), nil},
+		{"mixed (positive under a unit)", args{mm(1, 0), mm(0, -500000000)}, mm(0, 500000000), nil},
+		{"mixed (negative under a unit)", args{mm(-1, 0), mm(0, 300000000)}, mm(0, -700000000), nil},
 		{"both negative (no carry)", args{mm(-2, -200000000), mm(-2, -200000000)}, mm(-4, -400000000), nil},
 		{"both negative (carry)", args{mm(-2, -200000000), mm(-2, -900000000)}, mm(-5, -100000000), nil},
 		{"mixed (larger positive, just decimals)", args{mm(11, 0), mm(-2, 0)}, mm(9, 0), nil},
@@ -229,3 +234,28 @@ func TestSum(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiplyRatio(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        pb.Money
+		num, den int64
+		want     pb.Money
+	}{
+		{"zero", mm(0, 0), 1, 2, mm(0, 0)},
+		{"half", mm(3, 0), 1, 2, mm(1, 500000000)},
+		{"percent", mm(19, 990000000), 15, 100, mm(2, 998500000)},
+		{"truncated", mm(10, 0), 1, 3, mm(3, 333333333)},
+		{"negative", mm(-10, 0), 1, 3, mm(-3, -333333333)},
+		{"more than one", mm(2, 500000000), 3, 2, mm(3, 750000000)},
+		{"large", mm(9000000000, 0), 3, 4, mm(6750000000, 0)},
+		{"currency kept", mmc(4, 0, "EUR"), 1, 4, mmc(1, 0, "EUR")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MultiplyRatio(tt.m, tt.num, tt.den); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MultiplyRatio(%v, %d, %d) = %v, want %v", tt.m, tt.num, tt.den, got, tt.want)
+			}
+		})
+	}
+}
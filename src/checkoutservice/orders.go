@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+	"github.com/abruneau/hipstershop/src/checkoutservice/store"
+)
+
+const (
+	defaultOrdersPageSize = 10
+	maxOrdersPageSize     = 100
+)
+
+// GetOrder returns an order, whether it was placed or not, with its step log.
+func (cs *checkoutService) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.Order, error) {
+	log.Infof("[GetOrder] order_id=%q", req.OrderId)
+
+	if req.OrderId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "an order ID is required")
+	}
+	o, err := cs.orderStore.GetOrder(req.OrderId)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "no order %s", req.OrderId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get order: %+v", err)
+	}
+	return orderProto(o), nil
+}
+
+// ListOrders returns the orders placed by a user, newest first, one page at
+// a time.
+func (cs *checkoutService) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
+	log.Infof("[ListOrders] user_id=%q page_token=%q", req.UserId, req.PageToken)
+
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "a user ID is required")
+	}
+	size := int(req.PageSize)
+	switch {
+	case size < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page size %d", req.PageSize)
+	case size == 0:
+		size = defaultOrdersPageSize
+	case size > maxOrdersPageSize:
+		size = maxOrdersPageSize
+	}
+	// Page tokens are the number of orders listed so far.
+	offset := 0
+	if req.PageToken != "" {
+		n, err := strconv.Atoi(req.PageToken)
+		if err != nil || n < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token %q", req.PageToken)
+		}
+		offset = n
+	}
+
+	// One more order is asked for to tell whether there is a next page.
+	orders, err := cs.orderStore.ListOrders(req.UserId, offset, size+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list orders: %+v", err)
+	}
+	res := &pb.ListOrdersResponse{}
+	if len(orders) > size {
+		orders = orders[:size]
+		res.NextPageToken = strconv.Itoa(offset + size)
+	}
+	for _, o := range orders {
+		res.Orders = append(res.Orders, orderProto(o))
+	}
+	return res, nil
+}
+
+// setOrderResult records how an order ended. The order is not undone when it
+// cannot be saved, so failures are only logged.
+func (cs *checkoutService) setOrderResult(orderID string, s pb.OrderStatus, result *pb.OrderResult) {
+	if err := cs.orderStore.SetOrderResult(orderID, s, result); err != nil {
+		log.Errorf("failed to save order %s as %s: %+v", orderID, s, err)
+	}
+}
+
+func orderProto(o *store.Order) *pb.Order {
+	res := &pb.Order{
+		OrderId:   o.OrderID,
+		UserId:    o.UserID,
+		Email:     o.Email,
+		Status:    o.Status,
+		Result:    o.Result,
+		TotalPaid: o.TotalPaid,
+		CreatedAt: o.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	for _, step := range o.Steps {
+		res.Steps = append(res.Steps, &pb.OrderStep{
+			Step:   step.Name,
+			Status: step.Status,
+			Error:  step.Error,
+			Time:   step.Time.UTC().Format(time.RFC3339),
+		})
+	}
+	return res
+}
@@ -0,0 +1,362 @@
+// Package promotions computes the discounts taken off the items of an order.
+package promotions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+	"github.com/abruneau/hipstershop/src/checkoutservice/money"
+)
+
+const (
+	// PercentOff takes Percent percent off the items.
+	PercentOff = "percent_off"
+	// AmountOff takes AmountUSD off the items.
+	AmountOff = "amount_off"
+	// BuyXGetY makes Get of every Buy+Get items free, the cheapest first.
+	BuyXGetY = "buy_x_get_y"
+
+	dateLayout = "2006-01-02"
+)
+
+var (
+	// ErrUnknownCode is returned for promo codes of no promotion.
+	ErrUnknownCode = errors.New("unknown promo code")
+	// ErrCodeNotApplicable is returned for promo codes whose promotions do
+	// not apply to the order.
+	ErrCodeNotApplicable = errors.New("promo code does not apply to the order")
+)
+
+// Promotion discounts the items of the orders it applies to. An order must
+// meet every condition that is set: the promo code, the minimum subtotal in
+// USD and the validity window. Starts and Ends are YYYY-MM-DD dates in UTC
+// and are both included. Only the items of Categories or ProductIDs are
+// discounted, when either is set.
+//
+// UsageLimit and PerUserLimit bound how many orders may use the promotion,
+// in all and per user. They are enforced by the caller, which records the
+// orders.
+type Promotion struct {
+	ID             string      `json:"id"`
+	Description    string      `json:"description"`
+	Type           string      `json:"type"`
+	Code           string      `json:"code"`
+	Categories     []string    `json:"categories"`
+	ProductIDs     []string    `json:"product_ids"`
+	MinSubtotalUSD json.Number `json:"min_subtotal_usd"`
+	Percent        int64       `json:"percent"`
+	AmountUSD      json.Number `json:"amount_usd"`
+	Buy            int32       `json:"buy"`
+	Get            int32       `json:"get"`
+	Starts         string      `json:"starts"`
+	Ends           string      `json:"ends"`
+	UsageLimit     int         `json:"usage_limit"`
+	PerUserLimit   int         `json:"per_user_limit"`
+
+	minSubtotal, amount pb.Money
+	starts, ends        time.Time
+}
+
+// Engine applies promotions to orders.
+type Engine struct {
+	Promotions []*Promotion `json:"promotions"`
+}
+
+// Item is a line of an order, at the price of one unit.
+type Item struct {
+	ProductID  string
+	Categories []string
+	Quantity   int32
+	UnitPrice  pb.Money
+}
+
+// Order is what promotions know about an order being placed.
+type Order struct {
+	Items    []Item
+	Currency string
+	Code     string
+	Now      time.Time
+}
+
+// Discount is the amount a promotion takes off an order. Items is the amount
+// taken off each item of the order, in order.
+type Discount struct {
+	Promotion *Promotion
+	Amount    pb.Money
+	Items     []pb.Money
+}
+
+// Converter converts an amount in USD to the currency of an order.
+type Converter func(ctx context.Context, usd *pb.Money) (*pb.Money, error)
+
+// Load reads the promotions file at path.
+func Load(path string) (*Engine, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open promotions file: %v", err)
+	}
+	var e Engine
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("failed to parse promotions: %v", err)
+	}
+	if err := e.validate(); err != nil {
+		return nil, fmt.Errorf("invalid promotions: %v", err)
+	}
+	return &e, nil
+}
+
+func (e *Engine) validate() error {
+	ids := make(map[string]bool, len(e.Promotions))
+	for _, p := range e.Promotions {
+		if p.ID == "" || ids[p.ID] {
+			return fmt.Errorf("promotion %q must have a unique id", p.ID)
+		}
+		ids[p.ID] = true
+		p.Code = normalizeCode(p.Code)
+
+		var err error
+		if p.minSubtotal, err = parseUSD(p.MinSubtotalUSD); err != nil || money.IsNegative(p.minSubtotal) {
+			return fmt.Errorf("promotion %q has an invalid minimum subtotal %q", p.ID, p.MinSubtotalUSD)
+		}
+		switch p.Type {
+		case PercentOff:
+			if p.Percent <= 0 || p.Percent > 100 {
+				return fmt.Errorf("promotion %q must take off between 0 and 100 percent", p.ID)
+			}
+		case AmountOff:
+			if p.amount, err = parseUSD(p.AmountUSD); err != nil || !money.IsPositive(p.amount) {
+				return fmt.Errorf("promotion %q must take off a positive amount", p.ID)
+			}
+		case BuyXGetY:
+			if p.Buy <= 0 || p.Get <= 0 {
+				return fmt.Errorf("promotion %q must have positive buy and get quantities", p.ID)
+			}
+		default:
+			return fmt.Errorf("promotion %q has unknown type %q", p.ID, p.Type)
+		}
+		if p.UsageLimit < 0 || p.PerUserLimit < 0 {
+			return fmt.Errorf("promotion %q has a negative usage limit", p.ID)
+		}
+
+		if p.Starts != "" {
+			if p.starts, err = time.Parse(dateLayout, p.Starts); err != nil {
+				return fmt.Errorf("promotion %q has an invalid start date: %v", p.ID, err)
+			}
+		}
+		if p.Ends != "" {
+			if p.ends, err = time.Parse(dateLayout, p.Ends); err != nil {
+				return fmt.Errorf("promotion %q has an invalid end date: %v", p.ID, err)
+			}
+			// The end date is included.
+			p.ends = p.ends.AddDate(0, 0, 1)
+			if !p.starts.IsZero() && !p.ends.After(p.starts) {
+				return fmt.Errorf("promotion %q ends before it starts", p.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// Apply returns the discounts of every promotion that applies to the order,
+// in the order of the configuration. Each promotion discounts what is left
+// of the price of its items after the previous ones, so that no item costs
+// less than nothing. A promo code that unlocks no promotion, or none that
+// applies, is an error.
+func (e *Engine) Apply(ctx context.Context, o Order, convert Converter) ([]Discount, error) {
+	code := normalizeCode(o.Code)
+	known := false
+	for _, p := range e.Promotions {
+		known = known || (code != "" && p.Code == code)
+	}
+	if code != "" && !known {
+		return nil, ErrUnknownCode
+	}
+
+	subtotal := pb.Money{CurrencyCode: o.Currency}
+	left := make([]pb.Money, len(o.Items))
+	for i, it := range o.Items {
+		left[i] = pb.Money{CurrencyCode: o.Currency}
+		if it.Quantity > 0 {
+			left[i] = money.MultiplySlow(it.UnitPrice, uint32(it.Quantity))
+		}
+		subtotal = money.Must(money.Sum(subtotal, left[i]))
+	}
+
+	var out []Discount
+	usedCode := false
+	for _, p := range e.Promotions {
+		if !p.active(code, o.Now) {
+			continue
+		}
+		if money.IsPositive(p.minSubtotal) {
+			min, err := convert(ctx, &p.minSubtotal)
+			if err != nil {
+				return nil, err
+			}
+			if less(subtotal, *min) {
+				continue
+			}
+		}
+		d, err := p.discount(ctx, o, left, convert)
+		if err != nil {
+			return nil, err
+		}
+		if !money.IsPositive(d.Amount) {
+			continue
+		}
+		out = append(out, d)
+		usedCode = usedCode || p.Code != ""
+	}
+	if code != "" && !usedCode {
+		return nil, ErrCodeNotApplicable
+	}
+	return out, nil
+}
+
+func (p *Promotion) active(code string, now time.Time) bool {
+	if p.Code != "" && p.Code != code {
+		return false
+	}
+	if !p.starts.IsZero() && now.Before(p.starts) {
+		return false
+	}
+	if !p.ends.IsZero() && !now.Before(p.ends) {
+		return false
+	}
+	return true
+}
+
+// covers reports whether the promotion discounts the item.
+func (p *Promotion) covers(it Item) bool {
+	if len(p.Categories) == 0 && len(p.ProductIDs) == 0 {
+		return true
+	}
+	for _, id := range p.ProductIDs {
+		if id == it.ProductID {
+			return true
+		}
+	}
+	for _, c := range p.Categories {
+		for _, ic := range it.Categories {
+			if strings.EqualFold(c, ic) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// discount takes the promotion off what is left of the price of the items it
+// covers, and returns the amounts taken off.
+func (p *Promotion) discount(ctx context.Context, o Order, left []pb.Money, convert Converter) (Discount, error) {
+	d := Discount{Promotion: p, Amount: pb.Money{CurrencyCode: o.Currency}, Items: make([]pb.Money, len(o.Items))}
+	for i := range d.Items {
+		d.Items[i] = pb.Money{CurrencyCode: o.Currency}
+	}
+	takeOff := func(i int, amount pb.Money) pb.Money {
+		if less(left[i], amount) {
+			amount = left[i]
+		}
+		left[i] = money.Must(money.Sum(left[i], money.Negate(amount)))
+		d.Items[i] = money.Must(money.Sum(d.Items[i], amount))
+		d.Amount = money.Must(money.Sum(d.Amount, amount))
+		return amount
+	}
+
+	switch p.Type {
+	case PercentOff:
+		for i, it := range o.Items {
+			if p.covers(it) {
+				takeOff(i, money.MultiplyRatio(left[i], p.Percent, 100))
+			}
+		}
+	case AmountOff:
+		amount, err := convert(ctx, &p.amount)
+		if err != nil {
+			return Discount{}, err
+		}
+		// The amount is taken off the items in turn until none is left.
+		rest := *amount
+		for i, it := range o.Items {
+			if p.covers(it) && money.IsPositive(rest) {
+				rest = money.Must(money.Sum(rest, money.Negate(takeOff(i, rest))))
+			}
+		}
+	case BuyXGetY:
+		// Every unit covered, cheapest first.
+		var units []int
+		count := int32(0)
+		for i, it := range o.Items {
+			if p.covers(it) {
+				units = append(units, i)
+				count += it.Quantity
+			}
+		}
+		sort.SliceStable(units, func(a, b int) bool {
+			return less(o.Items[units[a]].UnitPrice, o.Items[units[b]].UnitPrice)
+		})
+		free := count / (p.Buy + p.Get) * p.Get
+		for _, i := range units {
+			if free == 0 {
+				break
+			}
+			n := o.Items[i].Quantity
+			if n > free {
+				n = free
+			}
+			takeOff(i, money.MultiplySlow(o.Items[i].UnitPrice, uint32(n)))
+			free -= n
+		}
+	}
+	return d, nil
+}
+
+// less reports whether l is less than r, both in the same currency.
+func less(l, r pb.Money) bool {
+	return money.IsNegative(money.Must(money.Sum(l, money.Negate(r))))
+}
+
+// parseUSD parses a decimal amount of USD. An empty amount is zero.
+func parseUSD(n json.Number) (pb.Money, error) {
+	m := pb.Money{CurrencyCode: "USD"}
+	s := string(n)
+	if s == "" {
+		return m, nil
+	}
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+	if len(frac) > 9 {
+		return m, fmt.Errorf("too many decimals in %q", n)
+	}
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return m, err
+	}
+	var nanos int64
+	if frac != "" {
+		if nanos, err = strconv.ParseInt(frac+strings.Repeat("0", 9-len(frac)), 10, 32); err != nil {
+			return m, err
+		}
+	}
+	m.Units, m.Nanos = units, int32(nanos)
+	if neg {
+		m = money.Negate(m)
+	}
+	return m, nil
+}
+
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,136 @@
+package promotions
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+	"github.com/abruneau/hipstershop/src/checkoutservice/money"
+)
+
+func usd(u int64, n int32) pb.Money { return pb.Money{Units: u, Nanos: n, CurrencyCode: "USD"} }
+
+// toUSD converts USD to USD.
+func toUSD(_ context.Context, m *pb.Money) (*pb.Money, error) { return m, nil }
+
+func TestLoad(t *testing.T) {
+	e, err := Load("../promotions.json")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(e.Promotions) == 0 {
+		t.Errorf("Load: no promotions")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		p    Promotion
+	}{
+		{"no id", Promotion{Type: PercentOff, Percent: 10}},
+		{"unknown type", Promotion{ID: "a", Type: "free_lunch"}},
+		{"percent over 100", Promotion{ID: "a", Type: PercentOff, Percent: 101}},
+		{"no amount", Promotion{ID: "a", Type: AmountOff}},
+		{"invalid amount", Promotion{ID: "a", Type: AmountOff, AmountUSD: "1.2.3"}},
+		{"no get", Promotion{ID: "a", Type: BuyXGetY, Buy: 2}},
+		{"negative limit", Promotion{ID: "a", Type: PercentOff, Percent: 10, UsageLimit: -1}},
+		{"ends before start", Promotion{ID: "a", Type: PercentOff, Percent: 10, Starts: "2026-12-02", Ends: "2026-12-01"}},
+	}
+	for _, tt := range tests {
+		p := tt.p
+		e := &Engine{Promotions: []*Promotion{&p}}
+		if err := e.validate(); err == nil {
+			t.Errorf("validate %s: got no error", tt.name)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	e := &Engine{Promotions: []*Promotion{
+		{ID: "vintage", Type: PercentOff, Percent: 10, Categories: []string{"vintage"}},
+		{ID: "three-for-two", Type: BuyXGetY, Buy: 2, Get: 1, Categories: []string{"cookware"}},
+		{ID: "five-off", Type: AmountOff, AmountUSD: json.Number("5"), Code: "five", MinSubtotalUSD: json.Number("50")},
+		{ID: "winter", Type: PercentOff, Percent: 50, Starts: "2026-12-01", Ends: "2026-12-31"},
+		{ID: "everything", Type: PercentOff, Percent: 100, Code: "ALL"},
+	}}
+	if err := e.validate(); err != nil {
+		t.Fatalf("validate failed: %v", err)
+	}
+	typewriter := Item{ProductID: "typewriter", Categories: []string{"Vintage"}, Quantity: 1, UnitPrice: usd(60, 0)}
+	pan := Item{ProductID: "pan", Categories: []string{"cookware"}, Quantity: 2, UnitPrice: usd(20, 0)}
+	mug := Item{ProductID: "mug", Categories: []string{"cookware"}, Quantity: 2, UnitPrice: usd(8, 500000000)}
+	now := time.Date(2026, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		order Order
+		want  map[string]pb.Money
+		err   error
+	}{
+		{"category", Order{Items: []Item{typewriter, pan}, Now: now},
+			map[string]pb.Money{"vintage": usd(6, 0)}, nil},
+		{"cheapest free", Order{Items: []Item{pan, mug}, Now: now},
+			map[string]pb.Money{"three-for-two": usd(8, 500000000)}, nil},
+		{"code", Order{Items: []Item{typewriter}, Code: " Five ", Now: now},
+			map[string]pb.Money{"vintage": usd(6, 0), "five-off": usd(5, 0)}, nil},
+		{"under minimum", Order{Items: []Item{pan}, Code: "FIVE", Now: now},
+			nil, ErrCodeNotApplicable},
+		{"unknown code", Order{Items: []Item{pan}, Code: "NOPE", Now: now},
+			nil, ErrUnknownCode},
+		{"date window", Order{Items: []Item{pan}, Now: now.AddDate(0, 0, 20)},
+			map[string]pb.Money{"winter": usd(20, 0)}, nil},
+		{"never below zero", Order{Items: []Item{typewriter}, Code: "ALL", Now: now},
+			map[string]pb.Money{"vintage": usd(6, 0), "everything": usd(54, 0)}, nil},
+	}
+	for _, tt := range tests {
+		tt.order.Currency = "USD"
+		discounts, err := e.Apply(context.Background(), tt.order, toUSD)
+		if err != tt.err {
+			t.Errorf("Apply %s: got error %v, expected %v", tt.name, err, tt.err)
+			continue
+		}
+		var got map[string]pb.Money
+		for _, d := range discounts {
+			if got == nil {
+				got = make(map[string]pb.Money)
+			}
+			got[d.Promotion.ID] = d.Amount
+			sum := pb.Money{CurrencyCode: "USD"}
+			for _, it := range d.Items {
+				sum = money.Must(money.Sum(sum, it))
+			}
+			if len(d.Items) != len(tt.order.Items) || !money.AreEquals(sum, d.Amount) {
+				t.Errorf("Apply %s: %s takes %v off the items, expected %v in all", tt.name, d.Promotion.ID, d.Items, d.Amount)
+			}
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Apply %s: got discounts %v, expected %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseUSD(t *testing.T) {
+	tests := []struct {
+		in   json.Number
+		want pb.Money
+	}{
+		{"", usd(0, 0)},
+		{"10", usd(10, 0)},
+		{"12.99", usd(12, 990000000)},
+		{"-0.5", usd(0, -500000000)},
+		{"0.000000001", usd(0, 1)},
+	}
+	for _, tt := range tests {
+		got, err := parseUSD(tt.in)
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseUSD(%q) = %v, %v, expected %v", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := parseUSD("1.0000000001"); err == nil {
+		t.Errorf("parseUSD: got no error for too many decimals")
+	}
+}
@@ -0,0 +1,322 @@
+// Package resilience guards the calls to a service with a circuit breaker,
+// and retries the calls that are safe to repeat.
+package resilience
+
+import (
+	"context"
+	"expvar"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// MaxAttempts is how many times an idempotent call is attempted.
+	MaxAttempts = 3
+	// BaseDelay is the longest wait before the first retry. It doubles for
+	// every retry, up to MaxDelay, and the actual wait is drawn at random
+	// below it.
+	BaseDelay = 50 * time.Millisecond
+	// MaxDelay is the longest wait before a retry.
+	MaxDelay = time.Second
+	// Threshold is how many calls in a row must fail to open a breaker.
+	Threshold = 5
+	// Cooldown is how long an open breaker fails calls before it lets one
+	// through to probe the service.
+	Cooldown = 10 * time.Second
+)
+
+// ReasonCircuitOpen is the reason of the ErrorInfo detail of the calls an
+// open breaker fails, which never reached the service.
+const ReasonCircuitOpen = "CIRCUIT_OPEN"
+
+// errorDomain is the domain of the ErrorInfo details of rejected calls.
+const errorDomain = "resilience.checkoutservice.hipstershop"
+
+// stats publishes the state of every dependency, under /debug/vars.
+var stats = expvar.NewMap("dependencies")
+
+// State is the state of a circuit breaker.
+type State int
+
+const (
+	// Closed lets every call through.
+	Closed State = iota
+	// Open fails every call, until its cooldown is over.
+	Open
+	// HalfOpen lets a single call through, to probe the service.
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// Breaker is a circuit breaker. It opens after Threshold calls in a row
+// fail, and fails calls until Cooldown is over. It is then half-open: a
+// single call probes the service, and closes the breaker if it succeeds or
+// opens it again if it fails.
+type Breaker struct {
+	Threshold int
+	Cooldown  time.Duration
+	// OnChange, if set, is called with the lock held whenever the state
+	// changes.
+	OnChange func(from, to State)
+
+	now func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+	opened   int64
+}
+
+// NewBreaker returns a closed breaker.
+func NewBreaker(threshold int, cooldown time.Duration) *Breaker {
+	return &Breaker{Threshold: threshold, Cooldown: cooldown, now: time.Now}
+}
+
+// State returns the state of the breaker.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Allow reports whether a call may go through. Every allowed call must be
+// followed by Record, or by Release if its outcome says nothing of the
+// service.
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case Open:
+		if b.now().Sub(b.openedAt) < b.Cooldown {
+			return false
+		}
+		b.setState(HalfOpen)
+		fallthrough
+	case HalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+	}
+	return true
+}
+
+// Record records whether an allowed call failed.
+func (b *Breaker) Record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		if b.state != Closed {
+			b.setState(Closed)
+		}
+		return
+	}
+	b.failures++
+	if b.state == HalfOpen || (b.state == Closed && b.failures >= b.Threshold) {
+		b.openedAt = b.now()
+		b.opened++
+		b.setState(Open)
+	}
+}
+
+// Release ends an allowed call without recording its outcome, such as a
+// call canceled by its caller.
+func (b *Breaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *Breaker) setState(s State) {
+	from := b.state
+	b.state = s
+	if b.OnChange != nil {
+		b.OnChange(from, s)
+	}
+}
+
+// Dependency guards the calls to a service with a breaker, and retries its
+// idempotent calls with jittered exponential backoff when the service is
+// unavailable. Other calls, which might have taken effect, are attempted
+// once.
+type Dependency struct {
+	name       string
+	idempotent map[string]bool
+	breaker    *Breaker
+	log        *logrus.Logger
+	// sleep waits for d, or until ctx is done.
+	sleep func(ctx context.Context, d time.Duration) error
+
+	calls    int64
+	retries  int64
+	rejected int64
+}
+
+// NewDependency guards the calls to the named service. idempotent lists the
+// full names of the methods that may be retried, such as
+// /hipstershop.CartService/GetCart. Its state is logged whenever its breaker
+// changes state, and published under /debug/vars.
+func NewDependency(name string, idempotent []string, log *logrus.Logger) *Dependency {
+	d := &Dependency{
+		name:       name,
+		idempotent: make(map[string]bool),
+		breaker:    NewBreaker(Threshold, Cooldown),
+		log:        log,
+		sleep:      sleep,
+	}
+	for _, m := range idempotent {
+		d.idempotent[m] = true
+	}
+	d.breaker.OnChange = d.logChange
+	stats.Set(name, expvar.Func(d.Stats))
+	return d
+}
+
+// Stats returns the state of the breaker and the counts of calls, retries
+// and calls rejected by the breaker.
+func (d *Dependency) Stats() interface{} {
+	d.breaker.mu.Lock()
+	state, failures, opened := d.breaker.state, d.breaker.failures, d.breaker.opened
+	d.breaker.mu.Unlock()
+	return map[string]interface{}{
+		"state":    state.String(),
+		"failures": failures,
+		"opened":   opened,
+		"calls":    atomic.LoadInt64(&d.calls),
+		"retries":  atomic.LoadInt64(&d.retries),
+		"rejected": atomic.LoadInt64(&d.rejected),
+	}
+}
+
+func (d *Dependency) logChange(from, to State) {
+	l := d.log.WithFields(logrus.Fields{"service": d.name, "from": from.String(), "to": to.String()})
+	switch to {
+	case Open:
+		l.Warnf("circuit breaker of the %s service opened after %d failed calls", d.name, d.breaker.failures)
+	case HalfOpen:
+		l.Infof("circuit breaker of the %s service is half-open, probing", d.name)
+	case Closed:
+		l.Infof("circuit breaker of the %s service closed", d.name)
+	}
+}
+
+// Intercept is a grpc.UnaryClientInterceptor.
+func (d *Dependency) Intercept(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	attempts := 1
+	if d.idempotent[method] {
+		attempts = MaxAttempts
+	}
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			if serr := d.sleep(ctx, Backoff(attempt)); serr != nil {
+				return err
+			}
+			atomic.AddInt64(&d.retries, 1)
+			d.log.Debugf("retrying %s (attempt %d/%d): %v", method, attempt+1, attempts, err)
+		}
+		if !d.breaker.Allow() {
+			atomic.AddInt64(&d.rejected, 1)
+			if attempt > 0 {
+				// The earlier attempts reached the service.
+				return err
+			}
+			return d.rejection()
+		}
+		atomic.AddInt64(&d.calls, 1)
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		switch status.Code(err) {
+		case codes.Canceled:
+			d.breaker.Release()
+		default:
+			d.breaker.Record(failed(err))
+		}
+		if status.Code(err) != codes.Unavailable {
+			return err
+		}
+	}
+	return err
+}
+
+// rejection is the error of a call failed by the open breaker. It is
+// Unavailable, and detailed as never attempted.
+func (d *Dependency) rejection() error {
+	st := status.Newf(codes.Unavailable, "circuit breaker of the %s service is open", d.name)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   ReasonCircuitOpen,
+		Domain:   errorDomain,
+		Metadata: map[string]string{"service": d.name},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// Rejected reports whether a call failed with err because a breaker was
+// open, so that it never reached the service. The details of err may have
+// been kept by the errors that wrap it.
+func Rejected(err error) bool {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetReason() == ReasonCircuitOpen && info.GetDomain() == errorDomain {
+			return true
+		}
+	}
+	return false
+}
+
+// failed reports whether an error means the service is failing, rather
+// than the call being rejected on its merits.
+func failed(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown:
+		return true
+	}
+	return false
+}
+
+// Backoff returns how long to wait before the nth retry: a random duration
+// below BaseDelay doubled n-1 times, up to MaxDelay.
+func Backoff(n int) time.Duration {
+	max := MaxDelay
+	if n < 32 {
+		if d := BaseDelay << uint(n-1); d > 0 && d < max {
+			max = d
+		}
+	}
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,189 @@
+package resilience
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	getCart = "/hipstershop.CartService/GetCart"
+	charge  = "/hipstershop.PaymentService/Charge"
+)
+
+type clock struct{ t time.Time }
+
+func (c *clock) now() time.Time { return c.t }
+
+func TestBreaker(t *testing.T) {
+	c := &clock{t: time.Unix(0, 0)}
+	b := NewBreaker(3, 10*time.Second)
+	b.now = c.now
+	var changes []State
+	b.OnChange = func(from, to State) { changes = append(changes, to) }
+
+	for i := 0; i < 2; i++ {
+		if !b.Allow() {
+			t.Fatalf("Allow: closed breaker rejected call %d", i)
+		}
+		b.Record(true)
+	}
+	b.Allow()
+	b.Record(false)
+	for i := 0; i < 3; i++ {
+		b.Allow()
+		b.Record(true)
+	}
+	if b.State() != Open {
+		t.Fatalf("State = %v after 3 failures, expected open", b.State())
+	}
+	if b.Allow() {
+		t.Errorf("Allow: open breaker let a call through")
+	}
+
+	c.t = c.t.Add(10 * time.Second)
+	if !b.Allow() {
+		t.Fatalf("Allow: breaker rejected the probe after its cooldown")
+	}
+	if b.State() != HalfOpen {
+		t.Errorf("State = %v while probing, expected half-open", b.State())
+	}
+	if b.Allow() {
+		t.Errorf("Allow: half-open breaker let a second call through")
+	}
+	b.Record(true)
+	if b.State() != Open || b.Allow() {
+		t.Errorf("State = %v after a failed probe, expected open", b.State())
+	}
+
+	c.t = c.t.Add(10 * time.Second)
+	b.Allow()
+	b.Release()
+	if !b.Allow() {
+		t.Fatalf("Allow: breaker rejected the probe after a released one")
+	}
+	b.Record(false)
+	if b.State() != Closed {
+		t.Errorf("State = %v after a successful probe, expected closed", b.State())
+	}
+
+	want := []State{Open, HalfOpen, Open, HalfOpen, Closed}
+	if len(changes) != len(want) {
+		t.Fatalf("OnChange: got %v, expected %v", changes, want)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("OnChange: got %v, expected %v", changes, want)
+			break
+		}
+	}
+}
+
+func newTestDependency() *Dependency {
+	log := logrus.New()
+	log.Out = ioutil.Discard
+	d := NewDependency("test", []string{getCart}, log)
+	d.sleep = func(ctx context.Context, _ time.Duration) error { return ctx.Err() }
+	return d
+}
+
+// invoker fails with the given codes, then succeeds.
+func invoker(calls *int, fail ...codes.Code) grpc.UnaryInvoker {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		*calls++
+		if *calls <= len(fail) {
+			return status.Error(fail[*calls-1], "failed")
+		}
+		return nil
+	}
+}
+
+func TestIntercept(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		fail      []codes.Code
+		wantCalls int
+		wantCode  codes.Code
+	}{
+		{"success", getCart, nil, 1, codes.OK},
+		{"retried", getCart, []codes.Code{codes.Unavailable, codes.Unavailable}, 3, codes.OK},
+		{"attempts exhausted", getCart, []codes.Code{codes.Unavailable, codes.Unavailable, codes.Unavailable}, 3, codes.Unavailable},
+		{"not retryable", getCart, []codes.Code{codes.NotFound}, 1, codes.NotFound},
+		{"deadline", getCart, []codes.Code{codes.DeadlineExceeded}, 1, codes.DeadlineExceeded},
+		{"not idempotent", charge, []codes.Code{codes.Unavailable}, 1, codes.Unavailable},
+	}
+	for _, tt := range tests {
+		d := newTestDependency()
+		calls := 0
+		err := d.Intercept(context.Background(), tt.method, nil, nil, nil, invoker(&calls, tt.fail...))
+		if calls != tt.wantCalls || status.Code(err) != tt.wantCode {
+			t.Errorf("Intercept %s: %d calls, %v; expected %d calls, %v", tt.name, calls, err, tt.wantCalls, tt.wantCode)
+		}
+	}
+}
+
+func TestInterceptOpen(t *testing.T) {
+	d := newTestDependency()
+	calls := 0
+	fail := make([]codes.Code, Threshold)
+	for i := range fail {
+		fail[i] = codes.Internal
+	}
+	for i := 0; i < Threshold; i++ {
+		d.Intercept(context.Background(), charge, nil, nil, nil, invoker(&calls, fail...))
+	}
+	err := d.Intercept(context.Background(), charge, nil, nil, nil, invoker(&calls))
+	if calls != Threshold || status.Code(err) != codes.Unavailable {
+		t.Errorf("Intercept: %d calls, %v; expected %d calls, Unavailable", calls, err, Threshold)
+	}
+	if !Rejected(err) {
+		t.Errorf("Intercept: %v is not detailed as rejected", err)
+	}
+	stats := d.Stats().(map[string]interface{})
+	if stats["state"] != "open" || stats["rejected"] != int64(1) {
+		t.Errorf("Stats = %v, expected open with 1 rejected call", stats)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for n := 1; n < 40; n++ {
+		max := MaxDelay
+		if n < 6 {
+			max = BaseDelay << uint(n-1)
+		}
+		for i := 0; i < 20; i++ {
+			if d := Backoff(n); d < 0 || d >= max {
+				t.Fatalf("Backoff(%d) = %v, expected below %v", n, d, max)
+			}
+		}
+	}
+}
+
+func TestRejected(t *testing.T) {
+	d := newTestDependency()
+	rejected := d.rejection()
+	wrapped, _ := status.New(codes.Internal, "could not charge the card").WithDetails(status.Convert(rejected).Details()[0].(*errdetails.ErrorInfo))
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"rejection", rejected, true},
+		{"wrapped rejection", wrapped.Err(), true},
+		{"unavailable", status.Error(codes.Unavailable, "connection refused"), false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		if got := Rejected(tt.err); got != tt.want {
+			t.Errorf("Rejected(%s) = %v, expected %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/abruneau/hipstershop/src/checkoutservice/resilience"
+	"github.com/abruneau/hipstershop/src/checkoutservice/store"
+)
+
+// compensationTimeout bounds the compensations of a failed order, which run
+// even when the request that placed it is canceled.
+const compensationTimeout = 30 * time.Second
+
+// Statuses of the steps of an order in its step log.
+const (
+	stepDone               = "done"
+	stepFailed             = "failed"
+	stepCompensated        = "compensated"
+	stepCompensationFailed = "compensation_failed"
+)
+
+// sagaStep is a step of placing an order. If a later step fails, compensate
+// undoes the step once it is done; it is nil for steps with nothing to undo.
+type sagaStep struct {
+	name       string
+	do         func(ctx context.Context) error
+	compensate func(ctx context.Context) error
+	// undoFailed, if set, reports whether a step that failed with err may
+	// have taken effect anyway, in which case its compensation runs as well.
+	undoFailed func(err error) bool
+}
+
+// incompleteRollback is the error of a saga that could not be undone
+// cleanly: a compensation failed, so the order may have been charged. It
+// keeps the status of the failed step.
+type incompleteRollback struct{ error }
+
+func (e incompleteRollback) GRPCStatus() *status.Status { return status.Convert(e.error) }
+
+// mayHaveTakenEffect reports whether a call that failed with err may have
+// been carried out anyway, as when it timed out after reaching the service.
+// Unavailable calls had no connection to the service, and calls failed by
+// an open circuit breaker were never sent.
+func mayHaveTakenEffect(err error) bool {
+	if resilience.Rejected(err) {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.DeadlineExceeded, codes.Canceled, codes.Unknown, codes.Internal:
+		return true
+	}
+	return false
+}
+
+// addStep appends a step of an order to its step log. The log is only
+// informative, so failures to save it are logged but do not fail the order.
+func (cs *checkoutService) addStep(orderID, step, status string, err error) {
+	rec := store.Step{Name: step, Status: status, Time: time.Now()}
+	if err != nil {
+		rec.Error = err.Error()
+	}
+	if err := cs.orderStore.AddStep(orderID, rec); err != nil {
+		log.Warnf("failed to save step %s of order %s: %+v", step, orderID, err)
+	}
+}
+
+// runSaga runs the steps of an order in turn. When one fails, the steps
+// done so far are compensated in reverse order and the error of the failed
+// step is returned. Compensations that fail are logged as errors, to be
+// fixed by hand, and the error is then an incompleteRollback.
+func (cs *checkoutService) runSaga(ctx context.Context, orderID string, steps []sagaStep) error {
+	for i, step := range steps {
+		err := step.do(ctx)
+		if err == nil {
+			cs.addStep(orderID, step.name, stepDone, nil)
+			continue
+		}
+		cs.addStep(orderID, step.name, stepFailed, err)
+		log.Warnf("order %s failed at step %s: %+v", orderID, step.name, err)
+		done := steps[:i]
+		if step.undoFailed != nil && step.undoFailed(err) {
+			done = steps[:i+1]
+		}
+		if !cs.compensate(orderID, done) {
+			return incompleteRollback{err}
+		}
+		return err
+	}
+	return nil
+}
+
+// compensate undoes the steps done, last first. It reports whether every
+// compensation succeeded.
+func (cs *checkoutService) compensate(orderID string, done []sagaStep) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), compensationTimeout)
+	defer cancel()
+	clean := true
+	for i := len(done) - 1; i >= 0; i-- {
+		step := done[i]
+		if step.compensate == nil {
+			continue
+		}
+		if err := step.compensate(ctx); err != nil {
+			cs.addStep(orderID, step.name, stepCompensationFailed, err)
+			log.Errorf("order %s: failed to compensate step %s: %+v", orderID, step.name, err)
+			clean = false
+			continue
+		}
+		cs.addStep(orderID, step.name, stepCompensated, nil)
+		log.Infof("order %s: compensated step %s", orderID, step.name)
+	}
+	return clean
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/abruneau/hipstershop/src/checkoutservice/store"
+)
+
+// sagaRecorder builds fake steps that record what was done and undone.
+type sagaRecorder struct {
+	calls []string
+}
+
+func (r *sagaRecorder) step(name string, doErr, undoErr error) sagaStep {
+	return sagaStep{
+		name: name,
+		do: func(ctx context.Context) error {
+			r.calls = append(r.calls, "do "+name)
+			return doErr
+		},
+		compensate: func(ctx context.Context) error {
+			r.calls = append(r.calls, "undo "+name)
+			return undoErr
+		},
+	}
+}
+
+func newSagaService(t *testing.T) *checkoutService {
+	cs := &checkoutService{orderStore: store.NewMemoryStore()}
+	if err := cs.orderStore.PutOrder(&store.Order{OrderID: "o1"}); err != nil {
+		t.Fatalf("PutOrder failed: %v", err)
+	}
+	return cs
+}
+
+func stepLog(t *testing.T, cs *checkoutService) []string {
+	o, err := cs.orderStore.GetOrder("o1")
+	if err != nil {
+		t.Fatalf("GetOrder failed: %v", err)
+	}
+	var out []string
+	for _, s := range o.Steps {
+		out = append(out, s.Name+" "+s.Status)
+	}
+	return out
+}
+
+func TestRunSaga(t *testing.T) {
+	cs := newSagaService(t)
+	r := &sagaRecorder{}
+	err := cs.runSaga(context.Background(), "o1", []sagaStep{
+		r.step("a", nil, nil),
+		{name: "b", do: func(ctx context.Context) error { r.calls = append(r.calls, "do b"); return nil }},
+		r.step("c", nil, nil),
+	})
+	if err != nil {
+		t.Fatalf("runSaga failed: %v", err)
+	}
+	if want := []string{"do a", "do b", "do c"}; !reflect.DeepEqual(r.calls, want) {
+		t.Errorf("calls = %v, expected %v", r.calls, want)
+	}
+	if got, want := stepLog(t, cs), []string{"a done", "b done", "c done"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("step log = %v, expected %v", got, want)
+	}
+}
+
+func TestRunSagaStepFails(t *testing.T) {
+	cs := newSagaService(t)
+	r := &sagaRecorder{}
+	failed := status.Error(codes.FailedPrecondition, "declined")
+	err := cs.runSaga(context.Background(), "o1", []sagaStep{
+		r.step("a", nil, nil),
+		{name: "b", do: func(ctx context.Context) error { r.calls = append(r.calls, "do b"); return nil }},
+		r.step("c", nil, nil),
+		r.step("d", failed, nil),
+		r.step("e", nil, nil),
+	})
+	if err != failed {
+		t.Errorf("runSaga = %v, expected the error of the failed step", err)
+	}
+	// The failed step is not undone, and the steps after it never run.
+	if want := []string{"do a", "do b", "do c", "do d", "undo c", "undo a"}; !reflect.DeepEqual(r.calls, want) {
+		t.Errorf("calls = %v, expected %v", r.calls, want)
+	}
+	want := []string{"a done", "b done", "c done", "d failed", "c compensated", "a compensated"}
+	if got := stepLog(t, cs); !reflect.DeepEqual(got, want) {
+		t.Errorf("step log = %v, expected %v", got, want)
+	}
+}
+
+func TestRunSagaCompensationFails(t *testing.T) {
+	cs := newSagaService(t)
+	r := &sagaRecorder{}
+	failed := status.Error(codes.Unavailable, "no shipping")
+	err := cs.runSaga(context.Background(), "o1", []sagaStep{
+		r.step("a", nil, nil),
+		r.step("b", nil, errors.New("refund failed")),
+		r.step("c", failed, nil),
+	})
+	if _, ok := err.(incompleteRollback); !ok {
+		t.Fatalf("runSaga = %#v, expected an incompleteRollback", err)
+	}
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("runSaga code = %v, expected the code of the failed step", status.Code(err))
+	}
+	// The compensations after the failed one still run.
+	if want := []string{"do a", "do b", "do c", "undo b", "undo a"}; !reflect.DeepEqual(r.calls, want) {
+		t.Errorf("calls = %v, expected %v", r.calls, want)
+	}
+	want := []string{"a done", "b done", "c failed", "b compensation_failed", "a compensated"}
+	if got := stepLog(t, cs); !reflect.DeepEqual(got, want) {
+		t.Errorf("step log = %v, expected %v", got, want)
+	}
+}
+
+func TestRunSagaUndoFailed(t *testing.T) {
+	tests := []struct {
+		name      string
+		doErr     error
+		undoErr   error
+		wantCalls []string
+		wantClean bool
+	}{
+		{"definitive failure", status.Error(codes.FailedPrecondition, "declined"), nil,
+			[]string{"do a", "do b", "undo a"}, true},
+		{"timeout", status.Error(codes.DeadlineExceeded, "timed out"), nil,
+			[]string{"do a", "do b", "undo b", "undo a"}, true},
+		{"timeout not undone", status.Error(codes.DeadlineExceeded, "timed out"), errors.New("unknown transaction"),
+			[]string{"do a", "do b", "undo b", "undo a"}, false},
+		{"no connection", status.Error(codes.Unavailable, "connection refused"), nil,
+			[]string{"do a", "do b", "undo a"}, true},
+	}
+	for _, tt := range tests {
+		cs := newSagaService(t)
+		r := &sagaRecorder{}
+		b := r.step("b", tt.doErr, tt.undoErr)
+		b.undoFailed = mayHaveTakenEffect
+		err := cs.runSaga(context.Background(), "o1", []sagaStep{r.step("a", nil, nil), b})
+		if !reflect.DeepEqual(r.calls, tt.wantCalls) {
+			t.Errorf("%s: calls = %v, expected %v", tt.name, r.calls, tt.wantCalls)
+		}
+		if _, unclean := err.(incompleteRollback); unclean == tt.wantClean {
+			t.Errorf("%s: runSaga = %#v, expected a clean rollback: %v", tt.name, err, tt.wantClean)
+		}
+		if status.Code(err) != status.Code(tt.doErr) {
+			t.Errorf("%s: runSaga code = %v, expected %v", tt.name, status.Code(err), status.Code(tt.doErr))
+		}
+	}
+}
+
+func TestMayHaveTakenEffect(t *testing.T) {
+	for code, want := range map[codes.Code]bool{
+		codes.DeadlineExceeded:   true,
+		codes.Canceled:           true,
+		codes.Unavailable:        false,
+		codes.Unknown:            true,
+		codes.Internal:           true,
+		codes.InvalidArgument:    false,
+		codes.FailedPrecondition: false,
+		codes.NotFound:           false,
+		codes.ResourceExhausted:  false,
+	} {
+		if got := mayHaveTakenEffect(status.Error(code, "")); got != want {
+			t.Errorf("mayHaveTakenEffect(%v) = %v, expected %v", code, got, want)
+		}
+	}
+}
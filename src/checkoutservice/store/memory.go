@@ -0,0 +1,168 @@
+package store
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+)
+
+type memory struct {
+	mu     sync.RWMutex
+	orders map[string]*Order
+	// usage counts the uses of promotions, in all and per user.
+	usage map[string]int
+}
+
+// NewMemoryStore creates a store that keeps orders in memory. They are lost
+// when the service restarts.
+func NewMemoryStore() Store {
+	return &memory{orders: make(map[string]*Order), usage: make(map[string]int)}
+}
+
+// Disconnect does nothing for the in-memory store
+func (m *memory) Disconnect() {}
+
+// PutOrder inserts a new order
+func (m *memory) PutOrder(o *Order) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.orders[o.OrderID]; ok {
+		return fmt.Errorf("order %q already exists", o.OrderID)
+	}
+	m.orders[o.OrderID] = clone(o)
+	return nil
+}
+
+// GetOrder gets an order from its ID
+func (m *memory) GetOrder(orderID string) (*Order, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	o, ok := m.orders[orderID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return clone(o), nil
+}
+
+// ListOrders gets a page of the placed orders of a user, newest first
+func (m *memory) ListOrders(userID string, offset, limit int) ([]*Order, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var out []*Order
+	for _, o := range m.orders {
+		if o.UserID == userID && o.Status == pb.OrderStatus_ORDER_PLACED {
+			out = append(out, o)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].CreatedAt.After(out[j].CreatedAt)
+		}
+		return out[i].OrderID < out[j].OrderID
+	})
+	if offset >= len(out) {
+		return nil, nil
+	}
+	out = out[offset:]
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	for i, o := range out {
+		out[i] = clone(o)
+	}
+	return out, nil
+}
+
+// AddStep appends a step to the step log of an order
+func (m *memory) AddStep(orderID string, step Step) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	o, ok := m.orders[orderID]
+	if !ok {
+		return ErrNotFound
+	}
+	o.Steps = append(o.Steps, step)
+	return nil
+}
+
+// SetOrderResult records how an order ended
+func (m *memory) SetOrderResult(orderID string, status pb.OrderStatus, result *pb.OrderResult) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	o, ok := m.orders[orderID]
+	if !ok {
+		return ErrNotFound
+	}
+	o.Status, o.Result = status, result
+	return nil
+}
+
+// SetCharge records what was charged for an order
+func (m *memory) SetCharge(orderID string, charge *Charge) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	o, ok := m.orders[orderID]
+	if !ok {
+		return ErrNotFound
+	}
+	o.Charge = cloneCharge(charge)
+	return nil
+}
+
+// AddRefund appends a refund to the charge of an order
+func (m *memory) AddRefund(orderID string, refund Refund) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	o, ok := m.orders[orderID]
+	if !ok || o.Charge == nil {
+		return ErrNotFound
+	}
+	refund.Items = append([]ReturnedItem(nil), refund.Items...)
+	o.Charge.Refunds = append(o.Charge.Refunds, refund)
+	return nil
+}
+
+// RedeemPromotion counts a use of a promotion by a user, within its limits
+func (m *memory) RedeemPromotion(promotionID, userID string, limit, perUserLimit int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	all, mine := promotionID, usageKey(promotionID, userID)
+	if (limit > 0 && m.usage[all] >= limit) || (perUserLimit > 0 && m.usage[mine] >= perUserLimit) {
+		return ErrLimitReached
+	}
+	m.usage[all]++
+	m.usage[mine]++
+	return nil
+}
+
+// ReleasePromotion takes back a use of a promotion by a user
+func (m *memory) ReleasePromotion(promotionID, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, k := range []string{promotionID, usageKey(promotionID, userID)} {
+		if m.usage[k] > 0 {
+			m.usage[k]--
+		}
+	}
+	return nil
+}
+
+// clone copies an order, so that its step log and charge are not shared.
+func clone(o *Order) *Order {
+	c := *o
+	c.Steps = append([]Step(nil), o.Steps...)
+	c.Charge = cloneCharge(o.Charge)
+	return &c
+}
+
+func cloneCharge(ch *Charge) *Charge {
+	if ch == nil {
+		return nil
+	}
+	c := *ch
+	c.Items = append([]ChargedItem(nil), ch.Items...)
+	c.Refunds = append([]Refund(nil), ch.Refunds...)
+	return &c
+}
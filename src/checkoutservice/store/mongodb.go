@@ -0,0 +1,178 @@
+package store
+
+import (
+	"context"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongodb struct {
+	client *mongo.Client
+	orders *mongo.Collection
+	usage  *mongo.Collection
+	log    *logrus.Logger
+}
+
+// Disconnect disconnect us from the database
+func (m *mongodb) Disconnect() {
+	ctx := context.Background()
+	m.client.Disconnect(ctx)
+}
+
+// PutOrder inserts a new order
+func (m *mongodb) PutOrder(o *Order) error {
+	_, err := m.orders.InsertOne(context.Background(), orderDocument(o))
+	return err
+}
+
+// orderDocument returns the order to insert. Steps are pushed to an array,
+// which must not be null.
+func orderDocument(o *Order) *Order {
+	if o.Steps != nil {
+		return o
+	}
+	d := *o
+	d.Steps = []Step{}
+	return &d
+}
+
+// GetOrder gets an order from its ID
+func (m *mongodb) GetOrder(orderID string) (*Order, error) {
+	var o Order
+	err := m.orders.FindOne(context.Background(), bson.M{"order_id": orderID}).Decode(&o)
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// ListOrders gets a page of the placed orders of a user, newest first
+func (m *mongodb) ListOrders(userID string, offset, limit int) ([]*Order, error) {
+	ctx := context.Background()
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "order_id", Value: 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cur, err := m.orders.Find(ctx, bson.M{"user_id": userID, "status": pb.OrderStatus_ORDER_PLACED}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var out []*Order
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddStep appends a step to the step log of an order
+func (m *mongodb) AddStep(orderID string, step Step) error {
+	return m.update(orderID, bson.M{"$push": bson.M{"steps": step}})
+}
+
+// SetOrderResult records how an order ended
+func (m *mongodb) SetOrderResult(orderID string, status pb.OrderStatus, result *pb.OrderResult) error {
+	return m.update(orderID, bson.M{"$set": bson.M{"status": status, "result": result}})
+}
+
+// SetCharge records what was charged for an order
+func (m *mongodb) SetCharge(orderID string, charge *Charge) error {
+	return m.update(orderID, bson.M{"$set": bson.M{"charge": chargeDocument(charge)}})
+}
+
+// chargeDocument returns the charge to set. Refunds are pushed to an array,
+// which must not be null.
+func chargeDocument(charge *Charge) *Charge {
+	if charge == nil || charge.Refunds != nil {
+		return charge
+	}
+	c := *charge
+	c.Refunds = []Refund{}
+	return &c
+}
+
+// AddRefund appends a refund to the charge of an order
+func (m *mongodb) AddRefund(orderID string, refund Refund) error {
+	res, err := m.orders.UpdateOne(context.Background(),
+		bson.M{"order_id": orderID, "charge": bson.M{"$ne": nil}},
+		bson.M{"$push": bson.M{"charge.refunds": refund}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (m *mongodb) update(orderID string, update bson.M) error {
+	res, err := m.orders.UpdateOne(context.Background(), bson.M{"order_id": orderID}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// RedeemPromotion counts a use of a promotion by a user, within its limits
+func (m *mongodb) RedeemPromotion(promotionID, userID string, limit, perUserLimit int) error {
+	if err := m.count(promotionID, limit); err != nil {
+		return err
+	}
+	if err := m.count(usageKey(promotionID, userID), perUserLimit); err != nil {
+		m.uncount(promotionID)
+		return err
+	}
+	return nil
+}
+
+// ReleasePromotion takes back a use of a promotion by a user
+func (m *mongodb) ReleasePromotion(promotionID, userID string) error {
+	if err := m.uncount(promotionID); err != nil {
+		return err
+	}
+	return m.uncount(usageKey(promotionID, userID))
+}
+
+// count increments a usage counter if it is under the limit, atomically.
+func (m *mongodb) count(key string, limit int) error {
+	filter := bson.M{"_id": key}
+	if limit > 0 {
+		filter["count"] = bson.M{"$lt": limit}
+	}
+	// The counter is created on first use. When it is at the limit, the
+	// filter matches nothing and creating it again fails.
+	_, err := m.usage.UpdateOne(context.Background(), filter,
+		bson.M{"$inc": bson.M{"count": 1}}, options.Update().SetUpsert(true))
+	if isDuplicateKey(err) {
+		return ErrLimitReached
+	}
+	return err
+}
+
+// uncount decrements a usage counter.
+func (m *mongodb) uncount(key string) error {
+	_, err := m.usage.UpdateOne(context.Background(),
+		bson.M{"_id": key, "count": bson.M{"$gt": 0}},
+		bson.M{"$inc": bson.M{"count": -1}})
+	return err
+}
+
+func isDuplicateKey(err error) bool {
+	if e, ok := err.(mongo.WriteException); ok {
+		for _, we := range e.WriteErrors {
+			if we.Code == 11000 {
+				return true
+			}
+		}
+	}
+	return false
+}
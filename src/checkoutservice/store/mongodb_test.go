@@ -0,0 +1,50 @@
+package store
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+)
+
+// fieldType returns the BSON type of a field of a document.
+func fieldType(t *testing.T, doc interface{}, key ...string) bsontype.Type {
+	b, err := bson.Marshal(doc)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	v, err := bson.Raw(b).LookupErr(key...)
+	if err != nil {
+		t.Fatalf("field %v: %v", key, err)
+	}
+	return v.Type
+}
+
+func TestOrderDocument(t *testing.T) {
+	o := &Order{OrderID: "o1"}
+	if got := fieldType(t, orderDocument(o), "steps"); got != bsontype.Array {
+		t.Errorf("steps of an order without steps are a BSON %v, expected an array to push to", got)
+	}
+	if o.Steps != nil {
+		t.Errorf("orderDocument changed the order")
+	}
+	steps := []Step{{Name: "charge_payment", Status: "done"}}
+	if d := orderDocument(&Order{OrderID: "o1", Steps: steps}); len(d.Steps) != 1 {
+		t.Errorf("orderDocument steps = %v, expected %v", d.Steps, steps)
+	}
+}
+
+func TestChargeDocument(t *testing.T) {
+	c := &Charge{TransactionID: "tx1", Amount: &pb.Money{CurrencyCode: "USD", Units: 10}}
+	if got := fieldType(t, bson.M{"charge": chargeDocument(c)}, "charge", "refunds"); got != bsontype.Array {
+		t.Errorf("refunds of a charge without refunds are a BSON %v, expected an array to push to", got)
+	}
+	if c.Refunds != nil {
+		t.Errorf("chargeDocument changed the charge")
+	}
+	if chargeDocument(nil) != nil {
+		t.Errorf("chargeDocument(nil) is not nil")
+	}
+}
@@ -0,0 +1,162 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx"
+)
+
+var (
+	// ErrNotFound is returned when no record matches the requested ID.
+	ErrNotFound = errors.New("not found")
+	// ErrLimitReached is returned when a promotion was used as many times as
+	// it may be.
+	ErrLimitReached = errors.New("usage limit reached")
+)
+
+// Order is a persisted order, saved when it is submitted and updated as it
+// is placed.
+type Order struct {
+	OrderID   string          `bson:"order_id"`
+	UserID    string          `bson:"user_id"`
+	Email     string          `bson:"email"`
+	Status    pb.OrderStatus  `bson:"status"`
+	Result    *pb.OrderResult `bson:"result"`
+	TotalPaid *pb.Money       `bson:"total_paid"`
+	CreatedAt time.Time       `bson:"created_at"`
+	Steps     []Step          `bson:"steps"`
+	Charge    *Charge         `bson:"charge"`
+}
+
+// Charge is what was charged for an order, from which its returns are
+// refunded, and the refunds issued so far.
+type Charge struct {
+	TransactionID string        `bson:"transaction_id"`
+	Amount        *pb.Money     `bson:"amount"`
+	Items         []ChargedItem `bson:"items"`
+	Refunds       []Refund      `bson:"refunds"`
+}
+
+// ChargedItem is what was charged for the units of a product, and the taxes
+// on them.
+type ChargedItem struct {
+	ProductID string    `bson:"product_id"`
+	Quantity  int32     `bson:"quantity"`
+	Amount    *pb.Money `bson:"amount"`
+	Taxes     []ItemTax `bson:"taxes"`
+}
+
+// ItemTax is the tax of a jurisdiction on the units of a product. Taxes that
+// are Included are already in the amount charged for them.
+type ItemTax struct {
+	JurisdictionID string    `bson:"jurisdiction_id"`
+	Amount         *pb.Money `bson:"amount"`
+	Included       bool      `bson:"included"`
+}
+
+// Refund is the refund of a return.
+type Refund struct {
+	ReturnID string         `bson:"return_id"`
+	RefundID string         `bson:"refund_id"`
+	Amount   *pb.Money      `bson:"amount"`
+	Items    []ReturnedItem `bson:"items"`
+}
+
+// ReturnedItem is a number of units of a product refunded.
+type ReturnedItem struct {
+	ProductID string `bson:"product_id"`
+	Quantity  int32  `bson:"quantity"`
+}
+
+// Step is an entry of the step log of an order.
+type Step struct {
+	Name   string    `bson:"name"`
+	Status string    `bson:"status"`
+	Error  string    `bson:"error"`
+	Time   time.Time `bson:"time"`
+}
+
+// Store interface
+type Store interface {
+	PutOrder(*Order) error
+	GetOrder(orderID string) (*Order, error)
+	// ListOrders returns the placed orders of a user, newest first, skipping
+	// the first offset ones.
+	ListOrders(userID string, offset, limit int) ([]*Order, error)
+	// AddStep appends a step to the step log of an order.
+	AddStep(orderID string, step Step) error
+	// SetOrderResult records how an order ended.
+	SetOrderResult(orderID string, status pb.OrderStatus, result *pb.OrderResult) error
+	// SetCharge records what was charged for an order, or removes it when
+	// charge is nil.
+	SetCharge(orderID string, charge *Charge) error
+	// AddRefund appends a refund to the charge of an order.
+	AddRefund(orderID string, refund Refund) error
+
+	// RedeemPromotion counts a use of a promotion by a user, unless it was
+	// used limit times in all, or perUserLimit times by the user, in which
+	// case it returns ErrLimitReached. Zero limits are unlimited.
+	RedeemPromotion(promotionID, userID string, limit, perUserLimit int) error
+	// ReleasePromotion takes back a use of a promotion by a user.
+	ReleasePromotion(promotionID, userID string) error
+
+	Disconnect()
+}
+
+// New returns a Mongodb store when MONGO_URL is set, and an in-memory store
+// otherwise.
+func New(log *logrus.Logger) (Store, error) {
+	if os.Getenv("MONGO_URL") == "" {
+		log.Warn("MONGO_URL not set, orders are kept in memory")
+		return NewMemoryStore(), nil
+	}
+	return NewMongoStore(log)
+}
+
+// NewMongoStore initialize a new Mongodb connexion
+func NewMongoStore(log *logrus.Logger) (Store, error) {
+	ctx := context.Background()
+	mongoURL := os.Getenv("MONGO_URL")
+	if mongoURL == "" {
+		return nil, errors.New("failed to get MONGO_URL from env")
+	}
+
+	client, err := mongo.NewClient(options.Client().ApplyURI(mongoURL))
+	if err != nil {
+		return nil, err
+	}
+	if err = client.Connect(ctx); err != nil {
+		return nil, err
+	}
+	m := &mongodb{
+		client: client,
+		orders: client.Database("checkout").Collection("orders"),
+		usage:  client.Database("checkout").Collection("promotion_usage"),
+		log:    log,
+	}
+	log.Info("Connected to the order store")
+
+	indexes := []mongo.IndexModel{{
+		Keys:    bsonx.Doc{{Key: "order_id", Value: bsonx.Int32(1)}},
+		Options: options.Index().SetUnique(true),
+	}, {
+		Keys: bsonx.Doc{{Key: "user_id", Value: bsonx.Int32(1)}, {Key: "created_at", Value: bsonx.Int32(-1)}},
+	}}
+	if _, err = m.orders.Indexes().CreateMany(ctx, indexes); err != nil {
+		return nil, fmt.Errorf("Indexes().CreateMany() ERROR: %v", err)
+	}
+	return m, nil
+}
+
+// usageKey identifies the uses of a promotion by a user.
+func usageKey(promotionID, userID string) string {
+	return promotionID + "/" + userID
+}
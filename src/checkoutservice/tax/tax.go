@@ -0,0 +1,247 @@
+// Package tax computes the sales taxes and VAT of orders.
+package tax
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+	"github.com/abruneau/hipstershop/src/checkoutservice/money"
+)
+
+// perMillion is a rate of 100%, in parts per million.
+const perMillion = 1000000
+
+// Jurisdiction taxes the orders shipped to a country, or to a state of it
+// when State is set. Items pay Rate percent of their price, or the rate of
+// their first category in CategoryRates. Shipping pays Rate when
+// TaxShipping is set. When Inclusive is set, as for VAT, prices include the
+// tax, which is only reported; otherwise the tax is added to the total.
+type Jurisdiction struct {
+	ID            string                 `json:"id"`
+	Description   string                 `json:"description"`
+	Country       string                 `json:"country"`
+	State         string                 `json:"state"`
+	Rate          json.Number            `json:"rate"`
+	CategoryRates map[string]json.Number `json:"category_rates"`
+	Inclusive     bool                   `json:"inclusive"`
+	TaxShipping   bool                   `json:"tax_shipping"`
+
+	// rates are the parsed rates, in parts per million.
+	rates map[json.Number]int64
+}
+
+// Engine applies the taxes of jurisdictions to orders.
+type Engine struct {
+	Jurisdictions []*Jurisdiction `json:"jurisdictions"`
+}
+
+// Item is a line of an order, at its price for every unit after discounts.
+type Item struct {
+	Categories []string
+	Amount     pb.Money
+}
+
+// Order is what taxes know about an order being placed. Country and State
+// are the codes of the destination, as addresses are normalized to.
+type Order struct {
+	Items    []Item
+	Shipping pb.Money
+	Country  string
+	State    string
+	Currency string
+}
+
+// Line is the tax of a jurisdiction, at one rate, on the part of an order
+// taxed at that rate. Taxable is the amount before tax. Items is the part of
+// Amount on each item, in the order of Order.Items; the rest is on shipping.
+type Line struct {
+	Jurisdiction *Jurisdiction
+	Rate         json.Number
+	Taxable      pb.Money
+	Amount       pb.Money
+	Items        []pb.Money
+}
+
+// Load reads the taxes file at path.
+func Load(path string) (*Engine, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open taxes file: %v", err)
+	}
+	var e Engine
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("failed to parse taxes: %v", err)
+	}
+	if err := e.validate(); err != nil {
+		return nil, fmt.Errorf("invalid taxes: %v", err)
+	}
+	return &e, nil
+}
+
+func (e *Engine) validate() error {
+	ids := make(map[string]bool, len(e.Jurisdictions))
+	for _, j := range e.Jurisdictions {
+		if j.ID == "" || ids[j.ID] {
+			return fmt.Errorf("jurisdiction %q must have a unique id", j.ID)
+		}
+		ids[j.ID] = true
+		if j.Country == "" {
+			return fmt.Errorf("jurisdiction %q has no country", j.ID)
+		}
+
+		j.rates = make(map[json.Number]int64)
+		rates := []json.Number{j.Rate}
+		for _, rate := range j.CategoryRates {
+			rates = append(rates, rate)
+		}
+		for _, rate := range rates {
+			ppm, err := parseRate(rate)
+			if err != nil || ppm < 0 || ppm > perMillion {
+				return fmt.Errorf("jurisdiction %q has a rate %q outside of 0 to 100 percent", j.ID, rate)
+			}
+			j.rates[rate] = ppm
+		}
+	}
+	return nil
+}
+
+// Compute returns the taxes of the order, for every jurisdiction of its
+// destination. Each jurisdiction has a line per rate, in the order the
+// items use them. Rates of zero are left out.
+func (e *Engine) Compute(o Order) []Line {
+	var out []Line
+	for _, j := range e.Jurisdictions {
+		if !j.covers(o.Country, o.State) {
+			continue
+		}
+		// The price taxed at each rate, added up before the tax is computed
+		// so that it is rounded once, and the items it is made of.
+		var rates []json.Number
+		prices := make(map[json.Number]pb.Money)
+		items := make(map[json.Number][]int)
+		add := func(rate json.Number, i int, amount pb.Money) {
+			if j.rates[rate] == 0 || !money.IsPositive(amount) {
+				return
+			}
+			if _, ok := prices[rate]; !ok {
+				rates = append(rates, rate)
+				prices[rate] = pb.Money{CurrencyCode: o.Currency}
+			}
+			prices[rate] = money.Must(money.Sum(prices[rate], amount))
+			if i >= 0 {
+				items[rate] = append(items[rate], i)
+			}
+		}
+		for i, it := range o.Items {
+			add(j.rate(it.Categories), i, it.Amount)
+		}
+		if j.TaxShipping {
+			add(j.Rate, -1, o.Shipping)
+		}
+
+		for _, rate := range rates {
+			l := j.line(rate, prices[rate])
+			l.Items = split(l.Amount, prices[rate], o, items[rate])
+			out = append(out, l)
+		}
+	}
+	return out
+}
+
+// Added returns the part of the taxes that is added to the total, in the
+// currency of the order.
+func Added(lines []Line, currency string) pb.Money {
+	total := pb.Money{CurrencyCode: currency}
+	for _, l := range lines {
+		if !l.Jurisdiction.Inclusive {
+			total = money.Must(money.Sum(total, l.Amount))
+		}
+	}
+	return total
+}
+
+// split splits the tax on a price over the items it is made of, in
+// proportion to their amounts. Shares are computed on running totals, so
+// that they add up to the tax on the items; the rest is on shipping.
+func split(amount, price pb.Money, o Order, items []int) []pb.Money {
+	out := make([]pb.Money, len(o.Items))
+	for i := range out {
+		out[i] = pb.Money{CurrencyCode: o.Currency}
+	}
+	total, sum := nanos(price), int64(0)
+	prev := pb.Money{CurrencyCode: o.Currency}
+	for _, i := range items {
+		sum += nanos(o.Items[i].Amount)
+		upTo := money.MultiplyRatio(amount, sum, total)
+		out[i] = money.Must(money.Sum(upTo, money.Negate(prev)))
+		prev = upTo
+	}
+	return out
+}
+
+func nanos(m pb.Money) int64 { return m.GetUnits()*1000000000 + int64(m.GetNanos()) }
+
+func (j *Jurisdiction) covers(country, state string) bool {
+	if !strings.EqualFold(j.Country, strings.TrimSpace(country)) {
+		return false
+	}
+	return j.State == "" || strings.EqualFold(j.State, strings.TrimSpace(state))
+}
+
+// rate returns the rate of the first category with one, or the default.
+func (j *Jurisdiction) rate(categories []string) json.Number {
+	for _, c := range categories {
+		if rate, ok := j.CategoryRates[c]; ok {
+			return rate
+		}
+	}
+	return j.Rate
+}
+
+// line taxes a price at a rate. Prices that include the tax are split into
+// the amount before tax and the tax.
+func (j *Jurisdiction) line(rate json.Number, price pb.Money) Line {
+	ppm := j.rates[rate]
+	l := Line{Jurisdiction: j, Rate: rate, Taxable: price}
+	if j.Inclusive {
+		l.Amount = money.MultiplyRatio(price, ppm, perMillion+ppm)
+		l.Taxable = money.Must(money.Sum(price, money.Negate(l.Amount)))
+	} else {
+		l.Amount = money.MultiplyRatio(price, ppm, perMillion)
+	}
+	return l
+}
+
+// parseRate parses a decimal rate in percent, with up to four decimals, into
+// parts per million. An empty rate is zero.
+func parseRate(n json.Number) (int64, error) {
+	s := string(n)
+	if s == "" {
+		return 0, nil
+	}
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+	if len(frac) > 4 {
+		return 0, fmt.Errorf("too many decimals in %q", n)
+	}
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	var parts int64
+	if frac != "" {
+		if parts, err = strconv.ParseInt(frac+strings.Repeat("0", 4-len(frac)), 10, 64); err != nil {
+			return 0, err
+		}
+	}
+	if strings.HasPrefix(whole, "-") {
+		return units*10000 - parts, nil
+	}
+	return units*10000 + parts, nil
+}
@@ -0,0 +1,156 @@
+package tax
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+)
+
+func usd(u int64, n int32) pb.Money { return pb.Money{Units: u, Nanos: n, CurrencyCode: "USD"} }
+
+func TestLoad(t *testing.T) {
+	e, err := Load("../taxes.json")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(e.Jurisdictions) == 0 {
+		t.Errorf("Load: no jurisdictions")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		j    Jurisdiction
+	}{
+		{"no id", Jurisdiction{Country: "US", Rate: "5"}},
+		{"no country", Jurisdiction{ID: "a", Rate: "5"}},
+		{"over 100 percent", Jurisdiction{ID: "a", Country: "US", Rate: "100.5"}},
+		{"negative", Jurisdiction{ID: "a", Country: "US", Rate: "-1"}},
+		{"invalid category rate", Jurisdiction{ID: "a", Country: "US", Rate: "5", CategoryRates: map[string]json.Number{"books": "x"}}},
+		{"too many decimals", Jurisdiction{ID: "a", Country: "US", Rate: "7.12345"}},
+	}
+	for _, tt := range tests {
+		j := tt.j
+		e := &Engine{Jurisdictions: []*Jurisdiction{&j}}
+		if err := e.validate(); err == nil {
+			t.Errorf("validate %s: got no error", tt.name)
+		}
+	}
+}
+
+func TestCompute(t *testing.T) {
+	e := &Engine{Jurisdictions: []*Jurisdiction{
+		{ID: "us-ca", Country: "US", State: "CA", Rate: "7.25"},
+		{ID: "us-pa", Country: "US", State: "PA", Rate: "6", CategoryRates: map[string]json.Number{"gardening": "0"}, TaxShipping: true},
+		{ID: "ca-gst", Country: "CA", Rate: "5", TaxShipping: true},
+		{ID: "ca-qc", Country: "CA", State: "QC", Rate: "9.975", TaxShipping: true},
+		{ID: "jp", Country: "JP", Rate: "10", CategoryRates: map[string]json.Number{"food": "8"}, Inclusive: true},
+	}}
+	if err := e.validate(); err != nil {
+		t.Fatalf("validate failed: %v", err)
+	}
+	camera := Item{Categories: []string{"photography", "vintage"}, Amount: usd(100, 0)}
+	seeds := Item{Categories: []string{"gardening"}, Amount: usd(20, 0)}
+	tea := Item{Categories: []string{"food"}, Amount: usd(54, 0)}
+
+	type line struct {
+		id      string
+		rate    json.Number
+		taxable pb.Money
+		amount  pb.Money
+	}
+	tests := []struct {
+		name  string
+		order Order
+		want  []line
+	}{
+		{"state", Order{Items: []Item{camera, seeds}, Shipping: usd(10, 0), Country: "us", State: "CA"},
+			[]line{{"us-ca", "7.25", usd(120, 0), usd(8, 700000000)}}},
+		{"exempt category and shipping", Order{Items: []Item{camera, seeds}, Shipping: usd(10, 0), Country: "US", State: "PA"},
+			[]line{{"us-pa", "6", usd(110, 0), usd(6, 600000000)}}},
+		{"no tax", Order{Items: []Item{camera}, Country: "US", State: "OR"}, nil},
+		{"country and state", Order{Items: []Item{camera}, Country: "CA", State: "QC"},
+			[]line{{"ca-gst", "5", usd(100, 0), usd(5, 0)}, {"ca-qc", "9.975", usd(100, 0), usd(9, 975000000)}}},
+		{"inclusive", Order{Items: []Item{camera, tea}, Country: "JP"},
+			[]line{{"jp", "10", usd(90, 909090910), usd(9, 90909090)}, {"jp", "8", usd(50, 0), usd(4, 0)}}},
+	}
+	for _, tt := range tests {
+		tt.order.Currency = "USD"
+		var got []line
+		for _, l := range e.Compute(tt.order) {
+			got = append(got, line{l.Jurisdiction.ID, l.Rate, l.Taxable, l.Amount})
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Compute %s: got %v, expected %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestComputeItems(t *testing.T) {
+	e := &Engine{Jurisdictions: []*Jurisdiction{
+		{ID: "us-pa", Country: "US", State: "PA", Rate: "6", CategoryRates: map[string]json.Number{"gardening": "0"}, TaxShipping: true},
+		{ID: "us-ca", Country: "US", State: "CA", Rate: "7.25"},
+	}}
+	if err := e.validate(); err != nil {
+		t.Fatalf("validate failed: %v", err)
+	}
+	camera := Item{Categories: []string{"photography"}, Amount: usd(100, 0)}
+	seeds := Item{Categories: []string{"gardening"}, Amount: usd(20, 0)}
+	mug := Item{Amount: usd(3, 330000000)}
+
+	tests := []struct {
+		name  string
+		order Order
+		want  [][]pb.Money
+	}{
+		{"exempt item and shipping", Order{Items: []Item{camera, seeds}, Shipping: usd(10, 0), Country: "US", State: "PA"},
+			[][]pb.Money{{usd(6, 0), usd(0, 0)}}},
+		{"same rate", Order{Items: []Item{mug, mug, camera}, Country: "US", State: "CA"},
+			[][]pb.Money{{usd(0, 241425000), usd(0, 241425000), usd(7, 250000000)}}},
+		{"rounded", Order{Items: []Item{camera, {Amount: usd(1, 1)}, {Amount: usd(1, 1)}}, Country: "US", State: "CA"},
+			[][]pb.Money{{usd(7, 249999999), usd(0, 72500000), usd(0, 72500001)}}},
+	}
+	for _, tt := range tests {
+		tt.order.Currency = "USD"
+		var got [][]pb.Money
+		for _, l := range e.Compute(tt.order) {
+			got = append(got, l.Items)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Compute %s: items taxed %v, expected %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestAdded(t *testing.T) {
+	lines := []Line{
+		{Jurisdiction: &Jurisdiction{}, Amount: usd(5, 0)},
+		{Jurisdiction: &Jurisdiction{Inclusive: true}, Amount: usd(9, 0)},
+		{Jurisdiction: &Jurisdiction{}, Amount: usd(1, 500000000)},
+	}
+	if got, want := Added(lines, "USD"), usd(6, 500000000); !reflect.DeepEqual(got, want) {
+		t.Errorf("Added = %v, expected %v", got, want)
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		in   json.Number
+		want int64
+	}{
+		{"", 0},
+		{"5", 50000},
+		{"7.25", 72500},
+		{"9.975", 99750},
+		{"0.0001", 1},
+		{"-0.5", -5000},
+	}
+	for _, tt := range tests {
+		if got, err := parseRate(tt.in); err != nil || got != tt.want {
+			t.Errorf("parseRate(%q) = %d, %v, expected %d", tt.in, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+	"github.com/abruneau/hipstershop/src/checkoutservice/money"
+	"github.com/abruneau/hipstershop/src/checkoutservice/promotions"
+	"github.com/abruneau/hipstershop/src/checkoutservice/tax"
+)
+
+// defaultTaxConfig is the taxes file used when TAX_CONFIG is not set.
+const defaultTaxConfig = "taxes.json"
+
+// QuoteTaxes estimates the taxes of a cart, before any promo code.
+func (cs *checkoutService) QuoteTaxes(ctx context.Context, req *pb.QuoteTaxesRequest) (*pb.QuoteTaxesResponse, error) {
+	log.Infof("[QuoteTaxes] items=%d currency=%q", len(req.Items), req.UserCurrency)
+
+	address, err := cs.validateAddress(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	items, products, err := cs.prepOrderItems(ctx, "", req.Items, req.UserCurrency)
+	if err != nil {
+		return nil, err
+	}
+	shipping := pb.Money{CurrencyCode: req.UserCurrency}
+	if req.ShippingCost != nil {
+		shipping = *req.ShippingCost
+	}
+	lines := cs.computeTaxes(address, items, products, nil, shipping, req.Duties, req.UserCurrency)
+	return &pb.QuoteTaxesResponse{Taxes: taxProtos(lines)}, nil
+}
+
+// computeTaxes returns the taxes of the priced items, after discounts, and of
+// shipping. Orders that pay import taxes with their duties pay no sales tax
+// or VAT.
+func (cs *checkoutService) computeTaxes(address *pb.Address, items []*pb.OrderItem, products map[string]*pb.Product, discounts []promotions.Discount, shipping pb.Money, duties *pb.DutiesEstimate, currency string) []tax.Line {
+	if taxes := duties.GetTaxes(); taxes != nil && money.IsPositive(*taxes) {
+		return nil
+	}
+	order := tax.Order{
+		Shipping: shipping,
+		Country:  address.GetCountry(),
+		State:    address.GetState(),
+		Currency: currency,
+	}
+	for i, it := range items {
+		amount := money.MultiplySlow(*it.GetCost(), uint32(it.GetItem().GetQuantity()))
+		for _, d := range discounts {
+			amount = money.Must(money.Sum(amount, money.Negate(d.Items[i])))
+		}
+		order.Items = append(order.Items, tax.Item{
+			Categories: products[it.GetItem().GetProductId()].GetCategories(),
+			Amount:     amount,
+		})
+	}
+	return cs.taxes.Compute(order)
+}
+
+// taxProtos lists the taxes of an order in its result.
+func taxProtos(lines []tax.Line) []*pb.TaxLine {
+	var out []*pb.TaxLine
+	for _, l := range lines {
+		taxable, amount := l.Taxable, l.Amount
+		out = append(out, &pb.TaxLine{
+			JurisdictionId: l.Jurisdiction.ID,
+			Description:    l.Jurisdiction.Description,
+			Rate:           string(l.Rate),
+			TaxableAmount:  &taxable,
+			Amount:         &amount,
+			Included:       l.Jurisdiction.Inclusive,
+		})
+	}
+	return out
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+)
+
+// maxCardYears is how many years ahead a card may expire.
+const maxCardYears = 20
+
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// validatePlaceOrder checks the fields of an order before any service is
+// called. Every invalid field is reported, by its path in the request, in
+// the BadRequest detail of an InvalidArgument error. The address itself is
+// checked by the shipping service.
+func validatePlaceOrder(req *pb.PlaceOrderRequest, now time.Time) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+	fail := func(field, format string, a ...interface{}) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: fmt.Sprintf(format, a...),
+		})
+	}
+
+	if req.UserId == "" {
+		fail("user_id", "a user ID is required")
+	}
+	if !currencyCode.MatchString(req.UserCurrency) {
+		fail("user_currency", "invalid currency code %q", req.UserCurrency)
+	}
+	if req.Email == "" {
+		fail("email", "an email address is required")
+	} else if addr, err := mail.ParseAddress(req.Email); err != nil || addr.Address != req.Email {
+		fail("email", "invalid email address %q", req.Email)
+	}
+	if req.Address == nil && req.PickupPointId == "" {
+		fail("address", "an address or a pickup point is required")
+	}
+
+	card := req.CreditCard
+	if card == nil {
+		fail("credit_card", "a credit card is required")
+		return badRequest(violations)
+	}
+	number := strings.NewReplacer(" ", "", "-", "").Replace(card.CreditCardNumber)
+	switch {
+	case number == "":
+		fail("credit_card.credit_card_number", "a card number is required")
+	case len(number) < 12 || len(number) > 19 || strings.Trim(number, "0123456789") != "":
+		fail("credit_card.credit_card_number", "a card number has 12 to 19 digits")
+	case !luhnValid(number):
+		fail("credit_card.credit_card_number", "invalid card number")
+	}
+	month, year := card.CreditCardExpirationMonth, card.CreditCardExpirationYear
+	if month < 1 || month > 12 {
+		fail("credit_card.credit_card_expiration_month", "invalid expiration month %d", month)
+	}
+	// Cards expire at the end of their month.
+	switch {
+	case int(year) < now.Year():
+		fail("credit_card.credit_card_expiration_year", "the card expired in %d", year)
+	case int(year) > now.Year()+maxCardYears:
+		fail("credit_card.credit_card_expiration_year", "invalid expiration year %d", year)
+	case int(year) == now.Year() && month >= 1 && time.Month(month) < now.Month():
+		fail("credit_card.credit_card_expiration_month", "the card expired in %02d/%d", month, year)
+	}
+	switch cvv := card.CreditCardCvv; {
+	case cvv == 0:
+		fail("credit_card.credit_card_cvv", "a CVV is required")
+	case cvv < 0 || cvv > 9999:
+		fail("credit_card.credit_card_cvv", "a CVV has 3 or 4 digits")
+	}
+	return badRequest(violations)
+}
+
+// badRequest reports invalid fields as an InvalidArgument error detailing
+// every violation, or returns nil if there are none.
+func badRequest(violations []*errdetails.BadRequest_FieldViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	msgs := make([]string, len(violations))
+	for i, v := range violations {
+		msgs[i] = fmt.Sprintf("%s: %s", v.Field, v.Description)
+	}
+	st := status.Newf(codes.InvalidArgument, "invalid order: %s", strings.Join(msgs, "; "))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// fieldError reports a single invalid field.
+func fieldError(field, format string, a ...interface{}) error {
+	return badRequest([]*errdetails.BadRequest_FieldViolation{{
+		Field:       field,
+		Description: fmt.Sprintf(format, a...),
+	}})
+}
+
+// luhnValid reports whether a card number passes the Luhn checksum.
+func luhnValid(number string) bool {
+	sum := 0
+	for i := 0; i < len(number); i++ {
+		d := int(number[len(number)-1-i] - '0')
+		if i%2 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/checkoutservice/genproto"
+)
+
+func validOrder() *pb.PlaceOrderRequest {
+	return &pb.PlaceOrderRequest{
+		UserId:       "u1",
+		UserCurrency: "USD",
+		Email:        "someone@example.com",
+		Address:      &pb.Address{StreetAddress: "1600 Amphitheatre Parkway", City: "Mountain View", State: "CA", Country: "US", ZipCode: 94043},
+		CreditCard: &pb.CreditCardInfo{
+			CreditCardNumber:          "4432-8015-6152-0454",
+			CreditCardCvv:             672,
+			CreditCardExpirationYear:  2027,
+			CreditCardExpirationMonth: 1,
+		},
+	}
+}
+
+// violations returns the fields of the BadRequest detail of an error.
+func violations(t *testing.T, err error) []string {
+	if err == nil {
+		return nil
+	}
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Errorf("got %v, expected InvalidArgument", err)
+	}
+	var out []string
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				out = append(out, v.GetField())
+			}
+		}
+	}
+	return out
+}
+
+func TestValidatePlaceOrder(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		change func(req *pb.PlaceOrderRequest)
+		want   []string
+	}{
+		{"valid", func(req *pb.PlaceOrderRequest) {}, nil},
+		{"pickup point", func(req *pb.PlaceOrderRequest) { req.Address, req.PickupPointId = nil, "p1" }, nil},
+		{"nil address", func(req *pb.PlaceOrderRequest) { req.Address = nil }, []string{"address"}},
+		{"no user", func(req *pb.PlaceOrderRequest) { req.UserId = "" }, []string{"user_id"}},
+		{"bad currency", func(req *pb.PlaceOrderRequest) { req.UserCurrency = "usd" }, []string{"user_currency"}},
+		{"no email", func(req *pb.PlaceOrderRequest) { req.Email = "" }, []string{"email"}},
+		{"bad email", func(req *pb.PlaceOrderRequest) { req.Email = "someone@" }, []string{"email"}},
+		{"named email", func(req *pb.PlaceOrderRequest) { req.Email = "Someone <someone@example.com>" }, []string{"email"}},
+		{"nil card", func(req *pb.PlaceOrderRequest) { req.CreditCard = nil }, []string{"credit_card"}},
+		{"no card number", func(req *pb.PlaceOrderRequest) { req.CreditCard.CreditCardNumber = "" }, []string{"credit_card.credit_card_number"}},
+		{"short card number", func(req *pb.PlaceOrderRequest) { req.CreditCard.CreditCardNumber = "4432 8015" }, []string{"credit_card.credit_card_number"}},
+		{"letters in card number", func(req *pb.PlaceOrderRequest) { req.CreditCard.CreditCardNumber = "4432-8015-6152-045a" }, []string{"credit_card.credit_card_number"}},
+		{"bad Luhn", func(req *pb.PlaceOrderRequest) { req.CreditCard.CreditCardNumber = "4432-8015-6152-0455" }, []string{"credit_card.credit_card_number"}},
+		{"month 0", func(req *pb.PlaceOrderRequest) { req.CreditCard.CreditCardExpirationMonth = 0 }, []string{"credit_card.credit_card_expiration_month"}},
+		{"month 13", func(req *pb.PlaceOrderRequest) { req.CreditCard.CreditCardExpirationMonth = 13 }, []string{"credit_card.credit_card_expiration_month"}},
+		{"current month", func(req *pb.PlaceOrderRequest) {
+			req.CreditCard.CreditCardExpirationMonth, req.CreditCard.CreditCardExpirationYear = 10, 2026
+		}, nil},
+		{"last month", func(req *pb.PlaceOrderRequest) {
+			req.CreditCard.CreditCardExpirationMonth, req.CreditCard.CreditCardExpirationYear = 9, 2026
+		}, []string{"credit_card.credit_card_expiration_month"}},
+		{"last year", func(req *pb.PlaceOrderRequest) { req.CreditCard.CreditCardExpirationYear = 2025 }, []string{"credit_card.credit_card_expiration_year"}},
+		{"too far ahead", func(req *pb.PlaceOrderRequest) { req.CreditCard.CreditCardExpirationYear = 2047 }, []string{"credit_card.credit_card_expiration_year"}},
+		{"no CVV", func(req *pb.PlaceOrderRequest) { req.CreditCard.CreditCardCvv = 0 }, []string{"credit_card.credit_card_cvv"}},
+		{"2 digit CVV", func(req *pb.PlaceOrderRequest) { req.CreditCard.CreditCardCvv = 99 }, nil},
+		{"CVV with leading zeros", func(req *pb.PlaceOrderRequest) { req.CreditCard.CreditCardCvv = 1 }, nil},
+		{"4 digit CVV", func(req *pb.PlaceOrderRequest) { req.CreditCard.CreditCardCvv = 9999 }, nil},
+		{"5 digit CVV", func(req *pb.PlaceOrderRequest) { req.CreditCard.CreditCardCvv = 10000 }, []string{"credit_card.credit_card_cvv"}},
+		{"negative CVV", func(req *pb.PlaceOrderRequest) { req.CreditCard.CreditCardCvv = -672 }, []string{"credit_card.credit_card_cvv"}},
+		{"several fields", func(req *pb.PlaceOrderRequest) {
+			req.Email, req.Address = "", nil
+			req.CreditCard.CreditCardExpirationMonth, req.CreditCard.CreditCardCvv = 13, 10000
+		}, []string{"email", "address", "credit_card.credit_card_expiration_month", "credit_card.credit_card_cvv"}},
+		{"nil card and other fields", func(req *pb.PlaceOrderRequest) {
+			req.UserCurrency, req.CreditCard = "", nil
+		}, []string{"user_currency", "credit_card"}},
+	}
+	for _, tt := range tests {
+		req := validOrder()
+		tt.change(req)
+		got := violations(t, validatePlaceOrder(req, now))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: invalid fields %v, expected %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLuhnValid(t *testing.T) {
+	for number, want := range map[string]bool{
+		"4432801561520454": true,
+		"4432801561520455": false,
+		"4111111111111111": true,
+		"4111111111111112": false,
+		"5555555555554444": true,
+		"378282246310005":  true,
+		"378282246310006":  false,
+		"79927398713":      true,
+		"79927398710":      false,
+		"0":                true,
+	} {
+		if got := luhnValid(number); got != want {
+			t.Errorf("luhnValid(%q) = %v, expected %v", number, got, want)
+		}
+	}
+}
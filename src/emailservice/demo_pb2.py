@@ -5,6 +5,7 @@
 
 import sys
 _b=sys.version_info[0]<3 and (lambda x:x) or (lambda x:x.encode('latin1'))
+from google.protobuf.internal import enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
 from google.protobuf import reflection as _reflection
@@ -21,9 +22,154 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   name='demo.proto',
   package='hipstershop',
   syntax='proto3',
-  serialized_pb=_b('\n\ndemo.proto\x12\x0bhipstershop\"0\n\x08\x43\x61rtItem\x12\x12\n\nproduct_id\x18\x01 \x01(\t\x12\x10\n\x08quantity\x18\x02 \x01(\x05\"F\n\x0e\x41\x64\x64ItemRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12#\n\x04item\x18\x02 \x01(\x0b\x32\x15.hipstershop.CartItem\"#\n\x10\x45mptyCartRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\t\"!\n\x0eGetCartRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\t\"=\n\x04\x43\x61rt\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12$\n\x05items\x18\x02 \x03(\x0b\x32\x15.hipstershop.CartItem\"\x07\n\x05\x45mpty\"B\n\x1aListRecommendationsRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12\x13\n\x0bproduct_ids\x18\x02 \x03(\t\"2\n\x1bListRecommendationsResponse\x12\x13\n\x0bproduct_ids\x18\x01 \x03(\t\"p\n\x07Product\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12\x0f\n\x07picture\x18\x04 \x01(\t\x12%\n\tprice_usd\x18\x05 \x01(\x0b\x32\x12.hipstershop.Money\">\n\x14ListProductsResponse\x12&\n\x08products\x18\x01 \x03(\x0b\x32\x14.hipstershop.Product\"\x1f\n\x11GetProductRequest\x12\n\n\x02id\x18\x01 \x01(\t\"&\n\x15SearchProductsRequest\x12\r\n\x05query\x18\x01 \x01(\t\"?\n\x16SearchProductsResponse\x12%\n\x07results\x18\x01 \x03(\x0b\x32\x14.hipstershop.Product\"^\n\x0fGetQuoteRequest\x12%\n\x07\x61\x64\x64ress\x18\x01 \x01(\x0b\x32\x14.hipstershop.Address\x12$\n\x05items\x18\x02 \x03(\x0b\x32\x15.hipstershop.CartItem\"8\n\x10GetQuoteResponse\x12$\n\x08\x63ost_usd\x18\x01 \x01(\x0b\x32\x12.hipstershop.Money\"_\n\x10ShipOrderRequest\x12%\n\x07\x61\x64\x64ress\x18\x01 \x01(\x0b\x32\x14.hipstershop.Address\x12$\n\x05items\x18\x02 \x03(\x0b\x32\x15.hipstershop.CartItem\"(\n\x11ShipOrderResponse\x12\x13\n\x0btracking_id\x18\x01 \x01(\t\"n\n\x07\x41\x64\x64ress\x12\x18\n\x10street_address_1\x18\x01 \x01(\t\x12\x18\n\x10street_address_2\x18\x02 \x01(\t\x12\x0c\n\x04\x63ity\x18\x03 \x01(\t\x12\x0f\n\x07\x63ountry\x18\x04 \x01(\t\x12\x10\n\x08zip_code\x18\x05 \x01(\x05\"<\n\x05Money\x12\x15\n\rcurrency_code\x18\x01 \x01(\t\x12\r\n\x05units\x18\x02 \x01(\x03\x12\r\n\x05nanos\x18\x03 \x01(\x05\"8\n\x1eGetSupportedCurrenciesResponse\x12\x16\n\x0e\x63urrency_codes\x18\x01 \x03(\t\"N\n\x19\x43urrencyConversionRequest\x12 \n\x04\x66rom\x18\x01 \x01(\x0b\x32\x12.hipstershop.Money\x12\x0f\n\x07to_code\x18\x02 \x01(\t\"\x90\x01\n\x0e\x43reditCardInfo\x12\x1a\n\x12\x63redit_card_number\x18\x01 \x01(\t\x12\x17\n\x0f\x63redit_card_cvv\x18\x02 \x01(\x05\x12#\n\x1b\x63redit_card_expiration_year\x18\x03 \x01(\x05\x12$\n\x1c\x63redit_card_expiration_month\x18\x04 \x01(\x05\"e\n\rChargeRequest\x12\"\n\x06\x61mount\x18\x01 \x01(\x0b\x32\x12.hipstershop.Money\x12\x30\n\x0b\x63redit_card\x18\x02 \x01(\x0b\x32\x1b.hipstershop.CreditCardInfo\"(\n\x0e\x43hargeResponse\x12\x16\n\x0etransaction_id\x18\x01 \x01(\t\"R\n\tOrderItem\x12#\n\x04item\x18\x01 \x01(\x0b\x32\x15.hipstershop.CartItem\x12 \n\x04\x63ost\x18\x02 \x01(\x0b\x32\x12.hipstershop.Money\"\xbf\x01\n\x0bOrderResult\x12\x10\n\x08order_id\x18\x01 \x01(\t\x12\x1c\n\x14shipping_tracking_id\x18\x02 \x01(\t\x12)\n\rshipping_cost\x18\x03 \x01(\x0b\x32\x12.hipstershop.Money\x12.\n\x10shipping_address\x18\x04 \x01(\x0b\x32\x14.hipstershop.Address\x12%\n\x05items\x18\x05 \x03(\x0b\x32\x16.hipstershop.OrderItem\"V\n\x1cSendOrderConfirmationRequest\x12\r\n\x05\x65mail\x18\x01 \x01(\t\x12\'\n\x05order\x18\x02 \x01(\x0b\x32\x18.hipstershop.OrderResult\"c\n\x12\x43reateOrderRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12\x15\n\ruser_currency\x18\x02 \x01(\t\x12%\n\x07\x61\x64\x64ress\x18\x03 \x01(\x0b\x32\x14.hipstershop.Address\"g\n\x13\x43reateOrderResponse\x12%\n\x05items\x18\x01 \x03(\x0b\x32\x16.hipstershop.OrderItem\x12)\n\rshipping_cost\x18\x02 \x01(\x0b\x32\x12.hipstershop.Money\"\xa3\x01\n\x11PlaceOrderRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12\x15\n\ruser_currency\x18\x02 \x01(\t\x12%\n\x07\x61\x64\x64ress\x18\x03 \x01(\x0b\x32\x14.hipstershop.Address\x12\r\n\x05\x65mail\x18\x05 \x01(\t\x12\x30\n\x0b\x63redit_card\x18\x06 \x01(\x0b\x32\x1b.hipstershop.CreditCardInfo\"=\n\x12PlaceOrderResponse\x12\'\n\x05order\x18\x01 \x01(\x0b\x32\x18.hipstershop.OrderResult2\xca\x01\n\x0b\x43\x61rtService\x12<\n\x07\x41\x64\x64Item\x12\x1b.hipstershop.AddItemRequest\x1a\x12.hipstershop.Empty\"\x00\x12;\n\x07GetCart\x12\x1b.hipstershop.GetCartRequest\x1a\x11.hipstershop.Cart\"\x00\x12@\n\tEmptyCart\x12\x1d.hipstershop.EmptyCartRequest\x1a\x12.hipstershop.Empty\"\x00\x32\x83\x01\n\x15RecommendationService\x12j\n\x13ListRecommendations\x12\'.hipstershop.ListRecommendationsRequest\x1a(.hipstershop.ListRecommendationsResponse\"\x00\x32\x83\x02\n\x15ProductCatalogService\x12G\n\x0cListProducts\x12\x12.hipstershop.Empty\x1a!.hipstershop.ListProductsResponse\"\x00\x12\x44\n\nGetProduct\x12\x1e.hipstershop.GetProductRequest\x1a\x14.hipstershop.Product\"\x00\x12[\n\x0eSearchProducts\x12\".hipstershop.SearchProductsRequest\x1a#.hipstershop.SearchProductsResponse\"\x00\x32\xaa\x01\n\x0fShippingService\x12I\n\x08GetQuote\x12\x1c.hipstershop.GetQuoteRequest\x1a\x1d.hipstershop.GetQuoteResponse\"\x00\x12L\n\tShipOrder\x12\x1d.hipstershop.ShipOrderRequest\x1a\x1e.hipstershop.ShipOrderResponse\"\x00\x32\xb7\x01\n\x0f\x43urrencyService\x12[\n\x16GetSupportedCurrencies\x12\x12.hipstershop.Empty\x1a+.hipstershop.GetSupportedCurrenciesResponse\"\x00\x12G\n\x07\x43onvert\x12&.hipstershop.CurrencyConversionRequest\x1a\x12.hipstershop.Money\"\x00\x32U\n\x0ePaymentService\x12\x43\n\x06\x43harge\x12\x1a.hipstershop.ChargeRequest\x1a\x1b.hipstershop.ChargeResponse\"\x00\x32h\n\x0c\x45mailService\x12X\n\x15SendOrderConfirmation\x12).hipstershop.SendOrderConfirmationRequest\x1a\x12.hipstershop.Empty\"\x00\x32\xb6\x01\n\x0f\x43heckoutService\x12R\n\x0b\x43reateOrder\x12\x1f.hipstershop.CreateOrderRequest\x1a .hipstershop.CreateOrderResponse\"\x00\x12O\n\nPlaceOrder\x12\x1e.hipstershop.PlaceOrderRequest\x1a\x1f.hipstershop.PlaceOrderResponse\"\x00\x62\x06proto3')
+  serialized_pb=_b('\n\ndemo.proto\x12\x0bhipstershop\"0\n\x08\x43\x61rtItem\x12\x12\n\nproduct_id\x18\x01 \x01(\t\x12\x10\n\x08quantity\x18\x02 \x01(\x05\"F\n\x0e\x41\x64\x64ItemRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12#\n\x04item\x18\x02 \x01(\x0b\x32\x15.hipstershop.CartItem\"#\n\x10\x45mptyCartRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\t\"!\n\x0eGetCartRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\t\"=\n\x04\x43\x61rt\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12$\n\x05items\x18\x02 \x03(\x0b\x32\x15.hipstershop.CartItem\"\x07\n\x05\x45mpty\"B\n\x1aListRecommendationsRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12\x13\n\x0bproduct_ids\x18\x02 \x03(\t\"2\n\x1bListRecommendationsResponse\x12\x13\n\x0bproduct_ids\x18\x01 \x03(\t\"\xb8\x01\n\x07Product\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12\x0f\n\x07picture\x18\x04 \x01(\t\x12%\n\tprice_usd\x18\x05 \x01(\x0b\x32\x12.hipstershop.Money\x12\x12\n\ncategories\x18\x06 \x03(\t\x12\x32\n\ndimensions\x18\x07 \x01(\x0b\x32\x1e.hipstershop.PackageDimensions\"^\n\x11PackageDimensions\x12\x11\n\tweight_kg\x18\x01 \x01(\x01\x12\x11\n\tlength_cm\x18\x02 \x01(\x01\x12\x10\n\x08width_cm\x18\x03 \x01(\x01\x12\x11\n\theight_cm\x18\x04 \x01(\x01\">\n\x14ListProductsResponse\x12&\n\x08products\x18\x01 \x03(\x0b\x32\x14.hipstershop.Product\"\x1f\n\x11GetProductRequest\x12\n\n\x02id\x18\x01 \x01(\t\"&\n\x15SearchProductsRequest\x12\r\n\x05query\x18\x01 \x01(\t\"?\n\x16SearchProductsResponse\x12%\n\x07results\x18\x01 \x03(\x0b\x32\x14.hipstershop.Product\"\xfd\x01\n\x0fGetQuoteRequest\x12%\n\x07\x61\x64\x64ress\x18\x01 \x01(\x0b\x32\x14.hipstershop.Address\x12$\n\x05items\x18\x02 \x03(\x0b\x32\x15.hipstershop.CartItem\x12\x1a\n\x12shipping_option_id\x18\x03 \x01(\t\x12\x15\n\rcurrency_code\x18\x04 \x01(\t\x12$\n\x08subtotal\x18\x05 \x01(\x0b\x32\x12.hipstershop.Money\x12\x12\n\npromo_code\x18\x06 \x01(\t\x12\x30\n\rrate_shopping\x18\x07 \x01(\x0e\x32\x19.hipstershop.RateShopping\"\xe4\x01\n\x10GetQuoteResponse\x12$\n\x08\x63ost_usd\x18\x01 \x01(\x0b\x32\x12.hipstershop.Money\x12 \n\x04\x63ost\x18\x02 \x01(\x0b\x32\x12.hipstershop.Money\x12\x31\n\tpromotion\x18\x03 \x01(\x0b\x32\x1e.hipstershop.ShippingPromotion\x12\x12\n\ncarrier_id\x18\x04 \x01(\t\x12\x14\n\x0c\x63\x61rrier_name\x18\x05 \x01(\t\x12+\n\x06\x64uties\x18\x06 \x01(\x0b\x32\x1b.hipstershop.DutiesEstimate\"\xb8\x01\n\x0e\x44utiesEstimate\x12\x13\n\x0b\x64\x65stination\x18\x01 \x01(\t\x12\'\n\x0bgoods_value\x18\x02 \x01(\x0b\x32\x12.hipstershop.Money\x12\"\n\x06\x64uties\x18\x03 \x01(\x0b\x32\x12.hipstershop.Money\x12!\n\x05taxes\x18\x04 \x01(\x0b\x32\x12.hipstershop.Money\x12!\n\x05total\x18\x05 \x01(\x0b\x32\x12.hipstershop.Money\"Z\n\x11ShippingPromotion\x12\n\n\x02id\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12$\n\x08\x64iscount\x18\x03 \x01(\x0b\x32\x12.hipstershop.Money\"\xba\x01\n\x10ShipOrderRequest\x12%\n\x07\x61\x64\x64ress\x18\x01 \x01(\x0b\x32\x14.hipstershop.Address\x12$\n\x05items\x18\x02 \x03(\x0b\x32\x15.hipstershop.CartItem\x12\x1a\n\x12shipping_option_id\x18\x03 \x01(\t\x12\x12\n\ncarrier_id\x18\x04 \x01(\t\x12\x10\n\x08order_id\x18\x05 \x01(\t\x12\x17\n\x0fpickup_point_id\x18\x06 \x01(\t\"\x85\x01\n\x11ShipOrderResponse\x12\x13\n\x0btracking_id\x18\x01 \x01(\t\x12+\n\x07parcels\x18\x02 \x03(\x0b\x32\x1a.hipstershop.ShippedParcel\x12.\n\x0cpickup_point\x18\x03 \x01(\x0b\x32\x18.hipstershop.PickupPoint\"\xb6\x01\n\rShippedParcel\x12\x13\n\x0btracking_id\x18\x01 \x01(\t\x12$\n\x05items\x18\x02 \x03(\x0b\x32\x15.hipstershop.CartItem\x12\x12\n\ncarrier_id\x18\x03 \x01(\t\x12\x14\n\x0c\x63\x61rrier_name\x18\x04 \x01(\t\x12\x1f\n\x17\x63\x61rrier_tracking_number\x18\x05 \x01(\t\x12\x1f\n\x17\x65stimated_delivery_date\x18\x06 \x01(\t\"\xec\x01\n\x1aListShippingOptionsRequest\x12%\n\x07\x61\x64\x64ress\x18\x01 \x01(\x0b\x32\x14.hipstershop.Address\x12$\n\x05items\x18\x02 \x03(\x0b\x32\x15.hipstershop.CartItem\x12\x15\n\rcurrency_code\x18\x03 \x01(\t\x12$\n\x08subtotal\x18\x04 \x01(\x0b\x32\x12.hipstershop.Money\x12\x12\n\npromo_code\x18\x05 \x01(\t\x12\x30\n\rrate_shopping\x18\x06 \x01(\x0e\x32\x19.hipstershop.RateShopping\"K\n\x1bListShippingOptionsResponse\x12,\n\x07options\x18\x01 \x03(\x0b\x32\x1b.hipstershop.ShippingOption\"\x8d\x02\n\x0eShippingOption\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12$\n\x08\x63ost_usd\x18\x03 \x01(\x0b\x32\x12.hipstershop.Money\x12\x1e\n\x16\x65\x61rliest_delivery_date\x18\x04 \x01(\t\x12\x1c\n\x14latest_delivery_date\x18\x05 \x01(\t\x12 \n\x04\x63ost\x18\x06 \x01(\x0b\x32\x12.hipstershop.Money\x12\x31\n\tpromotion\x18\x07 \x01(\x0b\x32\x1e.hipstershop.ShippingPromotion\x12\x12\n\ncarrier_id\x18\x08 \x01(\t\x12\x14\n\x0c\x63\x61rrier_name\x18\t \x01(\t\")\n\x12GetShipmentRequest\x12\x13\n\x0btracking_id\x18\x01 \x01(\t\">\n\x15\x43\x61ncelShipmentRequest\x12\x13\n\x0btracking_id\x18\x01 \x01(\t\x12\x10\n\x08order_id\x18\x02 \x01(\t\".\n\x16\x43\x61ncelShipmentResponse\x12\x14\n\x0ctracking_ids\x18\x01 \x03(\t\"+\n\x14WatchShipmentRequest\x12\x13\n\x0btracking_id\x18\x01 \x01(\t\"J\n\rShipmentEvent\x12+\n\x06status\x18\x01 \x01(\x0e\x32\x1b.hipstershop.ShipmentStatus\x12\x0c\n\x04time\x18\x02 \x01(\t\"\xf8\x02\n\x08Shipment\x12\x13\n\x0btracking_id\x18\x01 \x01(\t\x12+\n\x06status\x18\x02 \x01(\x0e\x32\x1b.hipstershop.ShipmentStatus\x12%\n\x07\x61\x64\x64ress\x18\x03 \x01(\x0b\x32\x14.hipstershop.Address\x12$\n\x05items\x18\x04 \x03(\x0b\x32\x15.hipstershop.CartItem\x12\x1a\n\x12shipping_option_id\x18\x05 \x01(\t\x12\x1f\n\x17\x65stimated_delivery_date\x18\x06 \x01(\t\x12*\n\x06\x65vents\x18\x07 \x03(\x0b\x32\x1a.hipstershop.ShipmentEvent\x12\x12\n\ncarrier_id\x18\x08 \x01(\t\x12\x14\n\x0c\x63\x61rrier_name\x18\t \x01(\t\x12\x1f\n\x17\x63\x61rrier_tracking_number\x18\n \x01(\t\x12\x10\n\x08order_id\x18\x0b \x01(\t\x12\x17\n\x0fpickup_point_id\x18\x0c \x01(\t\"?\n\x16ValidateAddressRequest\x12%\n\x07\x61\x64\x64ress\x18\x01 \x01(\x0b\x32\x14.hipstershop.Address\"\x8a\x01\n\x17ValidateAddressResponse\x12\r\n\x05valid\x18\x01 \x01(\x08\x12\x30\n\x12normalized_address\x18\x02 \x01(\x0b\x32\x14.hipstershop.Address\x12.\n\x06\x65rrors\x18\x03 \x03(\x0b\x32\x1e.hipstershop.AddressFieldError\"7\n\x11\x41\x64\x64ressFieldError\x12\r\n\x05\x66ield\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\"r\n\x13\x43reateReturnRequest\x12\x13\n\x0btracking_id\x18\x01 \x01(\t\x12\x10\n\x08order_id\x18\x02 \x01(\t\x12$\n\x05items\x18\x03 \x03(\x0b\x32\x15.hipstershop.CartItem\x12\x0e\n\x06reason\x18\x04 \x01(\t\"\'\n\x10GetReturnRequest\x12\x13\n\x0btracking_id\x18\x01 \x01(\t\"F\n\x0bReturnEvent\x12)\n\x06status\x18\x01 \x01(\x0e\x32\x19.hipstershop.ReturnStatus\x12\x0c\n\x04time\x18\x02 \x01(\t\"\xb7\x02\n\x06Return\x12\x13\n\x0btracking_id\x18\x01 \x01(\t\x12\x10\n\x08order_id\x18\x02 \x01(\t\x12\x1d\n\x15shipment_tracking_ids\x18\x03 \x03(\t\x12$\n\x05items\x18\x04 \x03(\x0b\x32\x15.hipstershop.CartItem\x12\x0e\n\x06reason\x18\x05 \x01(\t\x12)\n\x06status\x18\x06 \x01(\x0e\x32\x19.hipstershop.ReturnStatus\x12(\n\x06\x65vents\x18\x07 \x03(\x0b\x32\x18.hipstershop.ReturnEvent\x12\x12\n\ncarrier_id\x18\x08 \x01(\t\x12\x14\n\x0c\x63\x61rrier_name\x18\t \x01(\t\x12\x1f\n\x17\x63\x61rrier_tracking_number\x18\n \x01(\t\x12\x11\n\trefund_id\x18\x0b \x01(\t\"S\n\x17ListPickupPointsRequest\x12%\n\x07\x61\x64\x64ress\x18\x01 \x01(\x0b\x32\x14.hipstershop.Address\x12\x11\n\tradius_km\x18\x02 \x01(\x01\"D\n\x18ListPickupPointsResponse\x12(\n\x06points\x18\x01 \x03(\x0b\x32\x18.hipstershop.PickupPoint\"#\n\x15GetPickupPointRequest\x12\n\n\x02id\x18\x01 \x01(\t\"\x9f\x01\n\x0bPickupPoint\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12%\n\x07\x61\x64\x64ress\x18\x03 \x01(\x0b\x32\x14.hipstershop.Address\x12\x10\n\x08latitude\x18\x04 \x01(\x01\x12\x11\n\tlongitude\x18\x05 \x01(\x01\x12\x13\n\x0b\x64istance_km\x18\x06 \x01(\x01\x12\x15\n\ropening_hours\x18\x07 \x01(\t\"v\n\x07\x41\x64\x64ress\x12\x16\n\x0estreet_address\x18\x01 \x01(\t\x12\x0c\n\x04\x63ity\x18\x02 \x01(\t\x12\r\n\x05state\x18\x03 \x01(\t\x12\x0f\n\x07\x63ountry\x18\x04 \x01(\t\x12\x10\n\x08zip_code\x18\x05 \x01(\x05\x12\x13\n\x0bpostal_code\x18\x06 \x01(\t\"<\n\x05Money\x12\x15\n\rcurrency_code\x18\x01 \x01(\t\x12\r\n\x05units\x18\x02 \x01(\x03\x12\r\n\x05nanos\x18\x03 \x01(\x05\"8\n\x1eGetSupportedCurrenciesResponse\x12\x16\n\x0e\x63urrency_codes\x18\x01 \x03(\t\"N\n\x19\x43urrencyConversionRequest\x12 \n\x04\x66rom\x18\x01 \x01(\x0b\x32\x12.hipstershop.Money\x12\x0f\n\x07to_code\x18\x02 \x01(\t\"\x90\x01\n\x0e\x43reditCardInfo\x12\x1a\n\x12\x63redit_card_number\x18\x01 \x01(\t\x12\x17\n\x0f\x63redit_card_cvv\x18\x02 \x01(\x05\x12#\n\x1b\x63redit_card_expiration_year\x18\x03 \x01(\x05\x12$\n\x1c\x63redit_card_expiration_month\x18\x04 \x01(\x05\"e\n\rChargeRequest\x12\"\n\x06\x61mount\x18\x01 \x01(\x0b\x32\x12.hipstershop.Money\x12\x30\n\x0b\x63redit_card\x18\x02 \x01(\x0b\x32\x1b.hipstershop.CreditCardInfo\"(\n\x0e\x43hargeResponse\x12\x16\n\x0etransaction_id\x18\x01 \x01(\t\"K\n\rRefundRequest\x12\x16\n\x0etransaction_id\x18\x01 \x01(\t\x12\"\n\x06\x61mount\x18\x02 \x01(\x0b\x32\x12.hipstershop.Money\"#\n\x0eRefundResponse\x12\x11\n\trefund_id\x18\x01 \x01(\t\"R\n\tOrderItem\x12#\n\x04item\x18\x01 \x01(\x0b\x32\x15.hipstershop.CartItem\x12 \n\x04\x63ost\x18\x02 \x01(\x0b\x32\x12.hipstershop.Money\"\xec\x03\n\x0bOrderResult\x12\x10\n\x08order_id\x18\x01 \x01(\t\x12\x1c\n\x14shipping_tracking_id\x18\x02 \x01(\t\x12)\n\rshipping_cost\x18\x03 \x01(\x0b\x32\x12.hipstershop.Money\x12.\n\x10shipping_address\x18\x04 \x01(\x0b\x32\x14.hipstershop.Address\x12%\n\x05items\x18\x05 \x03(\x0b\x32\x16.hipstershop.OrderItem\x12:\n\x12shipping_promotion\x18\x06 \x01(\x0b\x32\x1e.hipstershop.ShippingPromotion\x12+\n\x07parcels\x18\x07 \x03(\x0b\x32\x1a.hipstershop.ShippedParcel\x12+\n\x06\x64uties\x18\x08 \x01(\x0b\x32\x1b.hipstershop.DutiesEstimate\x12\x16\n\x0e\x64uties_prepaid\x18\t \x01(\x08\x12.\n\x0cpickup_point\x18\n \x01(\x0b\x32\x18.hipstershop.PickupPoint\x12(\n\tdiscounts\x18\x0b \x03(\x0b\x32\x15.hipstershop.Discount\x12#\n\x05taxes\x18\x0c \x03(\x0b\x32\x14.hipstershop.TaxLine\"Y\n\x08\x44iscount\x12\x14\n\x0cpromotion_id\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\"\n\x06\x61mount\x18\x03 \x01(\x0b\x32\x12.hipstershop.Money\"\xa7\x01\n\x07TaxLine\x12\x17\n\x0fjurisdiction_id\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x0c\n\x04rate\x18\x03 \x01(\t\x12*\n\x0etaxable_amount\x18\x04 \x01(\x0b\x32\x12.hipstershop.Money\x12\"\n\x06\x61mount\x18\x05 \x01(\x0b\x32\x12.hipstershop.Money\x12\x10\n\x08included\x18\x06 \x01(\x08\"V\n\x1cSendOrderConfirmationRequest\x12\r\n\x05\x65mail\x18\x01 \x01(\t\x12\'\n\x05order\x18\x02 \x01(\x0b\x32\x18.hipstershop.OrderResult\"\xb9\x02\n\x11PlaceOrderRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12\x15\n\ruser_currency\x18\x02 \x01(\t\x12%\n\x07\x61\x64\x64ress\x18\x03 \x01(\x0b\x32\x14.hipstershop.Address\x12\r\n\x05\x65mail\x18\x05 \x01(\t\x12\x30\n\x0b\x63redit_card\x18\x06 \x01(\x0b\x32\x1b.hipstershop.CreditCardInfo\x12\x1a\n\x12shipping_option_id\x18\x07 \x01(\t\x12\x1b\n\x13shipping_promo_code\x18\x08 \x01(\t\x12\x15\n\rprepay_duties\x18\t \x01(\x08\x12\x17\n\x0fpickup_point_id\x18\n \x01(\t\x12\x17\n\x0fidempotency_key\x18\x0b \x01(\t\x12\x12\n\npromo_code\x18\x0c \x01(\t\"=\n\x12PlaceOrderResponse\x12\'\n\x05order\x18\x01 \x01(\x0b\x32\x18.hipstershop.OrderResult\"`\n\x13RefundReturnRequest\x12\x11\n\treturn_id\x18\x01 \x01(\t\x12\x10\n\x08order_id\x18\x02 \x01(\t\x12$\n\x05items\x18\x03 \x03(\x0b\x32\x15.hipstershop.CartItem\"M\n\x14RefundReturnResponse\x12\x11\n\trefund_id\x18\x01 \x01(\t\x12\"\n\x06\x61mount\x18\x02 \x01(\x0b\x32\x12.hipstershop.Money\"F\n\tOrderStep\x12\x0c\n\x04step\x18\x01 \x01(\t\x12\x0e\n\x06status\x18\x02 \x01(\t\x12\r\n\x05\x65rror\x18\x03 \x01(\t\x12\x0c\n\x04time\x18\x04 \x01(\t\"\xf0\x01\n\x05Order\x12\x10\n\x08order_id\x18\x01 \x01(\t\x12\x0f\n\x07user_id\x18\x02 \x01(\t\x12\r\n\x05\x65mail\x18\x03 \x01(\t\x12(\n\x06status\x18\x04 \x01(\x0e\x32\x18.hipstershop.OrderStatus\x12(\n\x06result\x18\x05 \x01(\x0b\x32\x18.hipstershop.OrderResult\x12&\n\ntotal_paid\x18\x06 \x01(\x0b\x32\x12.hipstershop.Money\x12\x12\n\ncreated_at\x18\x07 \x01(\t\x12%\n\x05steps\x18\x08 \x03(\x0b\x32\x16.hipstershop.OrderStep\"#\n\x0fGetOrderRequest\x12\x10\n\x08order_id\x18\x01 \x01(\t\"K\n\x11ListOrdersRequest\x12\x0f\n\x07user_id\x18\x01 \x01(\t\x12\x11\n\tpage_size\x18\x02 \x01(\x05\x12\x12\n\npage_token\x18\x03 \x01(\t\"Q\n\x12ListOrdersResponse\x12\"\n\x06orders\x18\x01 \x03(\x0b\x32\x12.hipstershop.Order\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"\xcf\x01\n\x11QuoteTaxesRequest\x12%\n\x07\x61\x64\x64ress\x18\x01 \x01(\x0b\x32\x14.hipstershop.Address\x12\x15\n\ruser_currency\x18\x02 \x01(\t\x12$\n\x05items\x18\x03 \x03(\x0b\x32\x15.hipstershop.CartItem\x12)\n\rshipping_cost\x18\x04 \x01(\x0b\x32\x12.hipstershop.Money\x12+\n\x06\x64uties\x18\x05 \x01(\x0b\x32\x1b.hipstershop.DutiesEstimate\"9\n\x12QuoteTaxesResponse\x12#\n\x05taxes\x18\x01 \x03(\x0b\x32\x14.hipstershop.TaxLine\"!\n\tAdRequest\x12\x14\n\x0c\x63ontext_keys\x18\x01 \x03(\t\"*\n\nAdResponse\x12\x1c\n\x03\x61\x64s\x18\x01 \x03(\x0b\x32\x0f.hipstershop.Ad\"(\n\x02\x41\x64\x12\x14\n\x0credirect_url\x18\x01 \x01(\t\x12\x0c\n\x04text\x18\x02 \x01(\t*)\n\x0cRateShopping\x12\x0c\n\x08\x43HEAPEST\x10\x00\x12\x0b\n\x07\x46\x41STEST\x10\x01*\x87\x01\n\x0eShipmentStatus\x12\x1f\n\x1bSHIPMENT_STATUS_UNSPECIFIED\x10\x00\x12\x11\n\rLABEL_CREATED\x10\x01\x12\x0e\n\nIN_TRANSIT\x10\x02\x12\x14\n\x10OUT_FOR_DELIVERY\x10\x03\x12\r\n\tDELIVERED\x10\x04\x12\x0c\n\x08\x43\x41NCELED\x10\x05*\x88\x01\n\x0cReturnStatus\x12\x1d\n\x19RETURN_STATUS_UNSPECIFIED\x10\x00\x12\x18\n\x14RETURN_LABEL_CREATED\x10\x01\x12\x15\n\x11RETURN_IN_TRANSIT\x10\x02\x12\x13\n\x0fRETURN_RECEIVED\x10\x03\x12\x13\n\x0fRETURN_REFUNDED\x10\x04*b\n\x0bOrderStatus\x12\x1c\n\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x11\n\rORDER_PENDING\x10\x01\x12\x10\n\x0cORDER_PLACED\x10\x02\x12\x10\n\x0cORDER_FAILED\x10\x03\x32\xca\x01\n\x0b\x43\x61rtService\x12<\n\x07\x41\x64\x64Item\x12\x1b.hipstershop.AddItemRequest\x1a\x12.hipstershop.Empty\"\x00\x12;\n\x07GetCart\x12\x1b.hipstershop.GetCartRequest\x1a\x11.hipstershop.Cart\"\x00\x12@\n\tEmptyCart\x12\x1d.hipstershop.EmptyCartRequest\x1a\x12.hipstershop.Empty\"\x00\x32\x83\x01\n\x15RecommendationService\x12j\n\x13ListRecommendations\x12\'.hipstershop.ListRecommendationsRequest\x1a(.hipstershop.ListRecommendationsResponse\"\x00\x32\x83\x02\n\x15ProductCatalogService\x12G\n\x0cListProducts\x12\x12.hipstershop.Empty\x1a!.hipstershop.ListProductsResponse\"\x00\x12\x44\n\nGetProduct\x12\x1e.hipstershop.GetProductRequest\x1a\x14.hipstershop.Product\"\x00\x12[\n\x0eSearchProducts\x12\".hipstershop.SearchProductsRequest\x1a#.hipstershop.SearchProductsResponse\"\x00\x32\xb1\x07\n\x0fShippingService\x12I\n\x08GetQuote\x12\x1c.hipstershop.GetQuoteRequest\x1a\x1d.hipstershop.GetQuoteResponse\"\x00\x12L\n\tShipOrder\x12\x1d.hipstershop.ShipOrderRequest\x1a\x1e.hipstershop.ShipOrderResponse\"\x00\x12j\n\x13ListShippingOptions\x12\'.hipstershop.ListShippingOptionsRequest\x1a(.hipstershop.ListShippingOptionsResponse\"\x00\x12G\n\x0bGetShipment\x12\x1f.hipstershop.GetShipmentRequest\x1a\x15.hipstershop.Shipment\"\x00\x12R\n\rWatchShipment\x12!.hipstershop.WatchShipmentRequest\x1a\x1a.hipstershop.ShipmentEvent\"\x00\x30\x01\x12[\n\x0e\x43\x61ncelShipment\x12\".hipstershop.CancelShipmentRequest\x1a#.hipstershop.CancelShipmentResponse\"\x00\x12^\n\x0fValidateAddress\x12#.hipstershop.ValidateAddressRequest\x1a$.hipstershop.ValidateAddressResponse\"\x00\x12G\n\x0c\x43reateReturn\x12 .hipstershop.CreateReturnRequest\x1a\x13.hipstershop.Return\"\x00\x12\x41\n\tGetReturn\x12\x1d.hipstershop.GetReturnRequest\x1a\x13.hipstershop.Return\"\x00\x12\x61\n\x10ListPickupPoints\x12$.hipstershop.ListPickupPointsRequest\x1a%.hipstershop.ListPickupPointsResponse\"\x00\x12P\n\x0eGetPickupPoint\x12\".hipstershop.GetPickupPointRequest\x1a\x18.hipstershop.PickupPoint\"\x00\x32\xb7\x01\n\x0f\x43urrencyService\x12[\n\x16GetSupportedCurrencies\x12\x12.hipstershop.Empty\x1a+.hipstershop.GetSupportedCurrenciesResponse\"\x00\x12G\n\x07\x43onvert\x12&.hipstershop.CurrencyConversionRequest\x1a\x12.hipstershop.Money\"\x00\x32\x9a\x01\n\x0ePaymentService\x12\x43\n\x06\x43harge\x12\x1a.hipstershop.ChargeRequest\x1a\x1b.hipstershop.ChargeResponse\"\x00\x12\x43\n\x06Refund\x12\x1a.hipstershop.RefundRequest\x1a\x1b.hipstershop.RefundResponse\"\x00\x32h\n\x0c\x45mailService\x12X\n\x15SendOrderConfirmation\x12).hipstershop.SendOrderConfirmationRequest\x1a\x12.hipstershop.Empty\"\x00\x32\x9b\x03\n\x0f\x43heckoutService\x12O\n\nPlaceOrder\x12\x1e.hipstershop.PlaceOrderRequest\x1a\x1f.hipstershop.PlaceOrderResponse\"\x00\x12U\n\x0cRefundReturn\x12 .hipstershop.RefundReturnRequest\x1a!.hipstershop.RefundReturnResponse\"\x00\x12>\n\x08GetOrder\x12\x1c.hipstershop.GetOrderRequest\x1a\x12.hipstershop.Order\"\x00\x12O\n\nListOrders\x12\x1e.hipstershop.ListOrdersRequest\x1a\x1f.hipstershop.ListOrdersResponse\"\x00\x12O\n\nQuoteTaxes\x12\x1e.hipstershop.QuoteTaxesRequest\x1a\x1f.hipstershop.QuoteTaxesResponse\"\x00\x32H\n\tAdService\x12;\n\x06GetAds\x12\x16.hipstershop.AdRequest\x1a\x17.hipstershop.AdResponse\"\x00\x62\x06proto3')
 )
 
+_RATESHOPPING = _descriptor.EnumDescriptor(
+  name='RateShopping',
+  full_name='hipstershop.RateShopping',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='CHEAPEST', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='FASTEST', index=1, number=1,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=7689,
+  serialized_end=7730,
+)
+_sym_db.RegisterEnumDescriptor(_RATESHOPPING)
+
+RateShopping = enum_type_wrapper.EnumTypeWrapper(_RATESHOPPING)
+_SHIPMENTSTATUS = _descriptor.EnumDescriptor(
+  name='ShipmentStatus',
+  full_name='hipstershop.ShipmentStatus',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='SHIPMENT_STATUS_UNSPECIFIED', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='LABEL_CREATED', index=1, number=1,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='IN_TRANSIT', index=2, number=2,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='OUT_FOR_DELIVERY', index=3, number=3,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='DELIVERED', index=4, number=4,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='CANCELED', index=5, number=5,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=7733,
+  serialized_end=7868,
+)
+_sym_db.RegisterEnumDescriptor(_SHIPMENTSTATUS)
+
+ShipmentStatus = enum_type_wrapper.EnumTypeWrapper(_SHIPMENTSTATUS)
+_RETURNSTATUS = _descriptor.EnumDescriptor(
+  name='ReturnStatus',
+  full_name='hipstershop.ReturnStatus',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='RETURN_STATUS_UNSPECIFIED', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='RETURN_LABEL_CREATED', index=1, number=1,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='RETURN_IN_TRANSIT', index=2, number=2,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='RETURN_RECEIVED', index=3, number=3,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='RETURN_REFUNDED', index=4, number=4,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=7871,
+  serialized_end=8007,
+)
+_sym_db.RegisterEnumDescriptor(_RETURNSTATUS)
+
+ReturnStatus = enum_type_wrapper.EnumTypeWrapper(_RETURNSTATUS)
+_ORDERSTATUS = _descriptor.EnumDescriptor(
+  name='OrderStatus',
+  full_name='hipstershop.OrderStatus',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='ORDER_STATUS_UNSPECIFIED', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='ORDER_PENDING', index=1, number=1,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='ORDER_PLACED', index=2, number=2,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='ORDER_FAILED', index=3, number=3,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=8009,
+  serialized_end=8107,
+)
+_sym_db.RegisterEnumDescriptor(_ORDERSTATUS)
+
+OrderStatus = enum_type_wrapper.EnumTypeWrapper(_ORDERSTATUS)
+CHEAPEST = 0
+FASTEST = 1
+SHIPMENT_STATUS_UNSPECIFIED = 0
+LABEL_CREATED = 1
+IN_TRANSIT = 2
+OUT_FOR_DELIVERY = 3
+DELIVERED = 4
+CANCELED = 5
+RETURN_STATUS_UNSPECIFIED = 0
+RETURN_LABEL_CREATED = 1
+RETURN_IN_TRANSIT = 2
+RETURN_RECEIVED = 3
+RETURN_REFUNDED = 4
+ORDER_STATUS_UNSPECIFIED = 0
+ORDER_PENDING = 1
+ORDER_PLACED = 2
+ORDER_FAILED = 3
 
 
 
@@ -338,6 +484,72 @@ _PRODUCT = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='categories', full_name='hipstershop.Product.categories', index=5,
+      number=6, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dimensions', full_name='hipstershop.Product.dimensions', index=6,
+      number=7, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=414,
+  serialized_end=598,
+)
+
+
+_PACKAGEDIMENSIONS = _descriptor.Descriptor(
+  name='PackageDimensions',
+  full_name='hipstershop.PackageDimensions',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='weight_kg', full_name='hipstershop.PackageDimensions.weight_kg', index=0,
+      number=1, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='length_cm', full_name='hipstershop.PackageDimensions.length_cm', index=1,
+      number=2, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='width_cm', full_name='hipstershop.PackageDimensions.width_cm', index=2,
+      number=3, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='height_cm', full_name='hipstershop.PackageDimensions.height_cm', index=3,
+      number=4, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -350,8 +562,8 @@ _PRODUCT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=413,
-  serialized_end=525,
+  serialized_start=600,
+  serialized_end=694,
 )
 
 
@@ -381,8 +593,8 @@ _LISTPRODUCTSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=527,
-  serialized_end=589,
+  serialized_start=696,
+  serialized_end=758,
 )
 
 
@@ -412,8 +624,8 @@ _GETPRODUCTREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=591,
-  serialized_end=622,
+  serialized_start=760,
+  serialized_end=791,
 )
 
 
@@ -443,8 +655,8 @@ _SEARCHPRODUCTSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=624,
-  serialized_end=662,
+  serialized_start=793,
+  serialized_end=831,
 )
 
 
@@ -474,8 +686,8 @@ _SEARCHPRODUCTSRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=664,
-  serialized_end=727,
+  serialized_start=833,
+  serialized_end=896,
 )
 
 
@@ -500,6 +712,41 @@ _GETQUOTEREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='shipping_option_id', full_name='hipstershop.GetQuoteRequest.shipping_option_id', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='currency_code', full_name='hipstershop.GetQuoteRequest.currency_code', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='subtotal', full_name='hipstershop.GetQuoteRequest.subtotal', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='promo_code', full_name='hipstershop.GetQuoteRequest.promo_code', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='rate_shopping', full_name='hipstershop.GetQuoteRequest.rate_shopping', index=6,
+      number=7, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -512,8 +759,8 @@ _GETQUOTEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=729,
-  serialized_end=823,
+  serialized_start=899,
+  serialized_end=1152,
 )
 
 
@@ -531,6 +778,41 @@ _GETQUOTERESPONSE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cost', full_name='hipstershop.GetQuoteResponse.cost', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='promotion', full_name='hipstershop.GetQuoteResponse.promotion', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='carrier_id', full_name='hipstershop.GetQuoteResponse.carrier_id', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='carrier_name', full_name='hipstershop.GetQuoteResponse.carrier_name', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='duties', full_name='hipstershop.GetQuoteResponse.duties', index=5,
+      number=6, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -543,29 +825,50 @@ _GETQUOTERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=825,
-  serialized_end=881,
+  serialized_start=1155,
+  serialized_end=1383,
 )
 
 
-_SHIPORDERREQUEST = _descriptor.Descriptor(
-  name='ShipOrderRequest',
-  full_name='hipstershop.ShipOrderRequest',
+_DUTIESESTIMATE = _descriptor.Descriptor(
+  name='DutiesEstimate',
+  full_name='hipstershop.DutiesEstimate',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='address', full_name='hipstershop.ShipOrderRequest.address', index=0,
-      number=1, type=11, cpp_type=10, label=1,
+      name='destination', full_name='hipstershop.DutiesEstimate.destination', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='goods_value', full_name='hipstershop.DutiesEstimate.goods_value', index=1,
+      number=2, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='items', full_name='hipstershop.ShipOrderRequest.items', index=1,
-      number=2, type=11, cpp_type=10, label=3,
-      has_default_value=False, default_value=[],
+      name='duties', full_name='hipstershop.DutiesEstimate.duties', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='taxes', full_name='hipstershop.DutiesEstimate.taxes', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='total', full_name='hipstershop.DutiesEstimate.total', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -581,25 +884,39 @@ _SHIPORDERREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=883,
-  serialized_end=978,
+  serialized_start=1386,
+  serialized_end=1570,
 )
 
 
-_SHIPORDERRESPONSE = _descriptor.Descriptor(
-  name='ShipOrderResponse',
-  full_name='hipstershop.ShipOrderResponse',
+_SHIPPINGPROMOTION = _descriptor.Descriptor(
+  name='ShippingPromotion',
+  full_name='hipstershop.ShippingPromotion',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='tracking_id', full_name='hipstershop.ShipOrderResponse.tracking_id', index=0,
+      name='id', full_name='hipstershop.ShippingPromotion.id', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='description', full_name='hipstershop.ShippingPromotion.description', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='discount', full_name='hipstershop.ShippingPromotion.discount', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -612,50 +929,57 @@ _SHIPORDERRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=980,
-  serialized_end=1020,
+  serialized_start=1572,
+  serialized_end=1662,
 )
 
 
-_ADDRESS = _descriptor.Descriptor(
-  name='Address',
-  full_name='hipstershop.Address',
+_SHIPORDERREQUEST = _descriptor.Descriptor(
+  name='ShipOrderRequest',
+  full_name='hipstershop.ShipOrderRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='street_address_1', full_name='hipstershop.Address.street_address_1', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='address', full_name='hipstershop.ShipOrderRequest.address', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='street_address_2', full_name='hipstershop.Address.street_address_2', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='items', full_name='hipstershop.ShipOrderRequest.items', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='city', full_name='hipstershop.Address.city', index=2,
+      name='shipping_option_id', full_name='hipstershop.ShipOrderRequest.shipping_option_id', index=2,
       number=3, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='country', full_name='hipstershop.Address.country', index=3,
+      name='carrier_id', full_name='hipstershop.ShipOrderRequest.carrier_id', index=3,
       number=4, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='zip_code', full_name='hipstershop.Address.zip_code', index=4,
-      number=5, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
+      name='order_id', full_name='hipstershop.ShipOrderRequest.order_id', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pickup_point_id', full_name='hipstershop.ShipOrderRequest.pickup_point_id', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -671,36 +995,36 @@ _ADDRESS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1022,
-  serialized_end=1132,
+  serialized_start=1665,
+  serialized_end=1851,
 )
 
 
-_MONEY = _descriptor.Descriptor(
-  name='Money',
-  full_name='hipstershop.Money',
+_SHIPORDERRESPONSE = _descriptor.Descriptor(
+  name='ShipOrderResponse',
+  full_name='hipstershop.ShipOrderResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='currency_code', full_name='hipstershop.Money.currency_code', index=0,
+      name='tracking_id', full_name='hipstershop.ShipOrderResponse.tracking_id', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='units', full_name='hipstershop.Money.units', index=1,
-      number=2, type=3, cpp_type=2, label=1,
-      has_default_value=False, default_value=0,
+      name='parcels', full_name='hipstershop.ShipOrderResponse.parcels', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='nanos', full_name='hipstershop.Money.nanos', index=2,
-      number=3, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
+      name='pickup_point', full_name='hipstershop.ShipOrderResponse.pickup_point', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -716,25 +1040,60 @@ _MONEY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1134,
-  serialized_end=1194,
+  serialized_start=1854,
+  serialized_end=1987,
 )
 
 
-_GETSUPPORTEDCURRENCIESRESPONSE = _descriptor.Descriptor(
-  name='GetSupportedCurrenciesResponse',
-  full_name='hipstershop.GetSupportedCurrenciesResponse',
+_SHIPPEDPARCEL = _descriptor.Descriptor(
+  name='ShippedParcel',
+  full_name='hipstershop.ShippedParcel',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='currency_codes', full_name='hipstershop.GetSupportedCurrenciesResponse.currency_codes', index=0,
-      number=1, type=9, cpp_type=9, label=3,
+      name='tracking_id', full_name='hipstershop.ShippedParcel.tracking_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='items', full_name='hipstershop.ShippedParcel.items', index=1,
+      number=2, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='carrier_id', full_name='hipstershop.ShippedParcel.carrier_id', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='carrier_name', full_name='hipstershop.ShippedParcel.carrier_name', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='carrier_tracking_number', full_name='hipstershop.ShippedParcel.carrier_tracking_number', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='estimated_delivery_date', full_name='hipstershop.ShippedParcel.estimated_delivery_date', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -747,32 +1106,60 @@ _GETSUPPORTEDCURRENCIESRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1196,
-  serialized_end=1252,
+  serialized_start=1990,
+  serialized_end=2172,
 )
 
 
-_CURRENCYCONVERSIONREQUEST = _descriptor.Descriptor(
-  name='CurrencyConversionRequest',
-  full_name='hipstershop.CurrencyConversionRequest',
+_LISTSHIPPINGOPTIONSREQUEST = _descriptor.Descriptor(
+  name='ListShippingOptionsRequest',
+  full_name='hipstershop.ListShippingOptionsRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='from', full_name='hipstershop.CurrencyConversionRequest.from', index=0,
+      name='address', full_name='hipstershop.ListShippingOptionsRequest.address', index=0,
       number=1, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='to_code', full_name='hipstershop.CurrencyConversionRequest.to_code', index=1,
-      number=2, type=9, cpp_type=9, label=1,
+      name='items', full_name='hipstershop.ListShippingOptionsRequest.items', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='currency_code', full_name='hipstershop.ListShippingOptionsRequest.currency_code', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='subtotal', full_name='hipstershop.ListShippingOptionsRequest.subtotal', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='promo_code', full_name='hipstershop.ListShippingOptionsRequest.promo_code', index=4,
+      number=5, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='rate_shopping', full_name='hipstershop.ListShippingOptionsRequest.rate_shopping', index=5,
+      number=6, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -785,43 +1172,109 @@ _CURRENCYCONVERSIONREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1254,
-  serialized_end=1332,
+  serialized_start=2175,
+  serialized_end=2411,
 )
 
 
-_CREDITCARDINFO = _descriptor.Descriptor(
-  name='CreditCardInfo',
-  full_name='hipstershop.CreditCardInfo',
+_LISTSHIPPINGOPTIONSRESPONSE = _descriptor.Descriptor(
+  name='ListShippingOptionsResponse',
+  full_name='hipstershop.ListShippingOptionsResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='credit_card_number', full_name='hipstershop.CreditCardInfo.credit_card_number', index=0,
+      name='options', full_name='hipstershop.ListShippingOptionsResponse.options', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2413,
+  serialized_end=2488,
+)
+
+
+_SHIPPINGOPTION = _descriptor.Descriptor(
+  name='ShippingOption',
+  full_name='hipstershop.ShippingOption',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='hipstershop.ShippingOption.id', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='credit_card_cvv', full_name='hipstershop.CreditCardInfo.credit_card_cvv', index=1,
-      number=2, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
+      name='name', full_name='hipstershop.ShippingOption.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='credit_card_expiration_year', full_name='hipstershop.CreditCardInfo.credit_card_expiration_year', index=2,
-      number=3, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
+      name='cost_usd', full_name='hipstershop.ShippingOption.cost_usd', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='credit_card_expiration_month', full_name='hipstershop.CreditCardInfo.credit_card_expiration_month', index=3,
-      number=4, type=5, cpp_type=1, label=1,
-      has_default_value=False, default_value=0,
+      name='earliest_delivery_date', full_name='hipstershop.ShippingOption.earliest_delivery_date', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='latest_delivery_date', full_name='hipstershop.ShippingOption.latest_delivery_date', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cost', full_name='hipstershop.ShippingOption.cost', index=5,
+      number=6, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='promotion', full_name='hipstershop.ShippingOption.promotion', index=6,
+      number=7, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='carrier_id', full_name='hipstershop.ShippingOption.carrier_id', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='carrier_name', full_name='hipstershop.ShippingOption.carrier_name', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -837,29 +1290,60 @@ _CREDITCARDINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1335,
-  serialized_end=1479,
+  serialized_start=2491,
+  serialized_end=2760,
 )
 
 
-_CHARGEREQUEST = _descriptor.Descriptor(
-  name='ChargeRequest',
-  full_name='hipstershop.ChargeRequest',
+_GETSHIPMENTREQUEST = _descriptor.Descriptor(
+  name='GetShipmentRequest',
+  full_name='hipstershop.GetShipmentRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='amount', full_name='hipstershop.ChargeRequest.amount', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='tracking_id', full_name='hipstershop.GetShipmentRequest.tracking_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2762,
+  serialized_end=2803,
+)
+
+
+_CANCELSHIPMENTREQUEST = _descriptor.Descriptor(
+  name='CancelShipmentRequest',
+  full_name='hipstershop.CancelShipmentRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
     _descriptor.FieldDescriptor(
-      name='credit_card', full_name='hipstershop.ChargeRequest.credit_card', index=1,
-      number=2, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='tracking_id', full_name='hipstershop.CancelShipmentRequest.tracking_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='order_id', full_name='hipstershop.CancelShipmentRequest.order_id', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -875,20 +1359,51 @@ _CHARGEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1481,
-  serialized_end=1582,
+  serialized_start=2805,
+  serialized_end=2867,
 )
 
 
-_CHARGERESPONSE = _descriptor.Descriptor(
-  name='ChargeResponse',
-  full_name='hipstershop.ChargeResponse',
+_CANCELSHIPMENTRESPONSE = _descriptor.Descriptor(
+  name='CancelShipmentResponse',
+  full_name='hipstershop.CancelShipmentResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='transaction_id', full_name='hipstershop.ChargeResponse.transaction_id', index=0,
+      name='tracking_ids', full_name='hipstershop.CancelShipmentResponse.tracking_ids', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2869,
+  serialized_end=2915,
+)
+
+
+_WATCHSHIPMENTREQUEST = _descriptor.Descriptor(
+  name='WatchShipmentRequest',
+  full_name='hipstershop.WatchShipmentRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='tracking_id', full_name='hipstershop.WatchShipmentRequest.tracking_id', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
@@ -906,29 +1421,29 @@ _CHARGERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1584,
-  serialized_end=1624,
+  serialized_start=2917,
+  serialized_end=2960,
 )
 
 
-_ORDERITEM = _descriptor.Descriptor(
-  name='OrderItem',
-  full_name='hipstershop.OrderItem',
+_SHIPMENTEVENT = _descriptor.Descriptor(
+  name='ShipmentEvent',
+  full_name='hipstershop.ShipmentEvent',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='item', full_name='hipstershop.OrderItem.item', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='status', full_name='hipstershop.ShipmentEvent.status', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='cost', full_name='hipstershop.OrderItem.cost', index=1,
-      number=2, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='time', full_name='hipstershop.ShipmentEvent.time', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -944,53 +1459,102 @@ _ORDERITEM = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1626,
-  serialized_end=1708,
+  serialized_start=2962,
+  serialized_end=3036,
 )
 
 
-_ORDERRESULT = _descriptor.Descriptor(
-  name='OrderResult',
-  full_name='hipstershop.OrderResult',
+_SHIPMENT = _descriptor.Descriptor(
+  name='Shipment',
+  full_name='hipstershop.Shipment',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='order_id', full_name='hipstershop.OrderResult.order_id', index=0,
+      name='tracking_id', full_name='hipstershop.Shipment.tracking_id', index=0,
       number=1, type=9, cpp_type=9, label=1,
       has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='shipping_tracking_id', full_name='hipstershop.OrderResult.shipping_tracking_id', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='status', full_name='hipstershop.Shipment.status', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='shipping_cost', full_name='hipstershop.OrderResult.shipping_cost', index=2,
+      name='address', full_name='hipstershop.Shipment.address', index=2,
       number=3, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='shipping_address', full_name='hipstershop.OrderResult.shipping_address', index=3,
-      number=4, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='items', full_name='hipstershop.Shipment.items', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='items', full_name='hipstershop.OrderResult.items', index=4,
-      number=5, type=11, cpp_type=10, label=3,
+      name='shipping_option_id', full_name='hipstershop.Shipment.shipping_option_id', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='estimated_delivery_date', full_name='hipstershop.Shipment.estimated_delivery_date', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='events', full_name='hipstershop.Shipment.events', index=6,
+      number=7, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='carrier_id', full_name='hipstershop.Shipment.carrier_id', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='carrier_name', full_name='hipstershop.Shipment.carrier_name', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='carrier_tracking_number', full_name='hipstershop.Shipment.carrier_tracking_number', index=9,
+      number=10, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='order_id', full_name='hipstershop.Shipment.order_id', index=10,
+      number=11, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pickup_point_id', full_name='hipstershop.Shipment.pickup_point_id', index=11,
+      number=12, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -1003,28 +1567,21 @@ _ORDERRESULT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1711,
-  serialized_end=1902,
+  serialized_start=3039,
+  serialized_end=3415,
 )
 
 
-_SENDORDERCONFIRMATIONREQUEST = _descriptor.Descriptor(
-  name='SendOrderConfirmationRequest',
-  full_name='hipstershop.SendOrderConfirmationRequest',
+_VALIDATEADDRESSREQUEST = _descriptor.Descriptor(
+  name='ValidateAddressRequest',
+  full_name='hipstershop.ValidateAddressRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='email', full_name='hipstershop.SendOrderConfirmationRequest.email', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='order', full_name='hipstershop.SendOrderConfirmationRequest.order', index=1,
-      number=2, type=11, cpp_type=10, label=1,
+      name='address', full_name='hipstershop.ValidateAddressRequest.address', index=0,
+      number=1, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -1041,36 +1598,1685 @@ _SENDORDERCONFIRMATIONREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1904,
-  serialized_end=1990,
+  serialized_start=3417,
+  serialized_end=3480,
 )
 
 
-_CREATEORDERREQUEST = _descriptor.Descriptor(
-  name='CreateOrderRequest',
-  full_name='hipstershop.CreateOrderRequest',
+_VALIDATEADDRESSRESPONSE = _descriptor.Descriptor(
+  name='ValidateAddressResponse',
+  full_name='hipstershop.ValidateAddressResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='user_id', full_name='hipstershop.CreateOrderRequest.user_id', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='valid', full_name='hipstershop.ValidateAddressResponse.valid', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='user_currency', full_name='hipstershop.CreateOrderRequest.user_currency', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='normalized_address', full_name='hipstershop.ValidateAddressResponse.normalized_address', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
     _descriptor.FieldDescriptor(
-      name='address', full_name='hipstershop.CreateOrderRequest.address', index=2,
-      number=3, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='errors', full_name='hipstershop.ValidateAddressResponse.errors', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3483,
+  serialized_end=3621,
+)
+
+
+_ADDRESSFIELDERROR = _descriptor.Descriptor(
+  name='AddressFieldError',
+  full_name='hipstershop.AddressFieldError',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='field', full_name='hipstershop.AddressFieldError.field', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='description', full_name='hipstershop.AddressFieldError.description', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3623,
+  serialized_end=3678,
+)
+
+
+_CREATERETURNREQUEST = _descriptor.Descriptor(
+  name='CreateReturnRequest',
+  full_name='hipstershop.CreateReturnRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='tracking_id', full_name='hipstershop.CreateReturnRequest.tracking_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='order_id', full_name='hipstershop.CreateReturnRequest.order_id', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='items', full_name='hipstershop.CreateReturnRequest.items', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='reason', full_name='hipstershop.CreateReturnRequest.reason', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3680,
+  serialized_end=3794,
+)
+
+
+_GETRETURNREQUEST = _descriptor.Descriptor(
+  name='GetReturnRequest',
+  full_name='hipstershop.GetReturnRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='tracking_id', full_name='hipstershop.GetReturnRequest.tracking_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3796,
+  serialized_end=3835,
+)
+
+
+_RETURNEVENT = _descriptor.Descriptor(
+  name='ReturnEvent',
+  full_name='hipstershop.ReturnEvent',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='status', full_name='hipstershop.ReturnEvent.status', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='time', full_name='hipstershop.ReturnEvent.time', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3837,
+  serialized_end=3907,
+)
+
+
+_RETURN = _descriptor.Descriptor(
+  name='Return',
+  full_name='hipstershop.Return',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='tracking_id', full_name='hipstershop.Return.tracking_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='order_id', full_name='hipstershop.Return.order_id', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='shipment_tracking_ids', full_name='hipstershop.Return.shipment_tracking_ids', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='items', full_name='hipstershop.Return.items', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='reason', full_name='hipstershop.Return.reason', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='hipstershop.Return.status', index=5,
+      number=6, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='events', full_name='hipstershop.Return.events', index=6,
+      number=7, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='carrier_id', full_name='hipstershop.Return.carrier_id', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='carrier_name', full_name='hipstershop.Return.carrier_name', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='carrier_tracking_number', full_name='hipstershop.Return.carrier_tracking_number', index=9,
+      number=10, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='refund_id', full_name='hipstershop.Return.refund_id', index=10,
+      number=11, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3910,
+  serialized_end=4221,
+)
+
+
+_LISTPICKUPPOINTSREQUEST = _descriptor.Descriptor(
+  name='ListPickupPointsRequest',
+  full_name='hipstershop.ListPickupPointsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='address', full_name='hipstershop.ListPickupPointsRequest.address', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='radius_km', full_name='hipstershop.ListPickupPointsRequest.radius_km', index=1,
+      number=2, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4223,
+  serialized_end=4306,
+)
+
+
+_LISTPICKUPPOINTSRESPONSE = _descriptor.Descriptor(
+  name='ListPickupPointsResponse',
+  full_name='hipstershop.ListPickupPointsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='points', full_name='hipstershop.ListPickupPointsResponse.points', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4308,
+  serialized_end=4376,
+)
+
+
+_GETPICKUPPOINTREQUEST = _descriptor.Descriptor(
+  name='GetPickupPointRequest',
+  full_name='hipstershop.GetPickupPointRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='hipstershop.GetPickupPointRequest.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4378,
+  serialized_end=4413,
+)
+
+
+_PICKUPPOINT = _descriptor.Descriptor(
+  name='PickupPoint',
+  full_name='hipstershop.PickupPoint',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='hipstershop.PickupPoint.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='hipstershop.PickupPoint.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='address', full_name='hipstershop.PickupPoint.address', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='latitude', full_name='hipstershop.PickupPoint.latitude', index=3,
+      number=4, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='longitude', full_name='hipstershop.PickupPoint.longitude', index=4,
+      number=5, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='distance_km', full_name='hipstershop.PickupPoint.distance_km', index=5,
+      number=6, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='opening_hours', full_name='hipstershop.PickupPoint.opening_hours', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4416,
+  serialized_end=4575,
+)
+
+
+_ADDRESS = _descriptor.Descriptor(
+  name='Address',
+  full_name='hipstershop.Address',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='street_address', full_name='hipstershop.Address.street_address', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='city', full_name='hipstershop.Address.city', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='state', full_name='hipstershop.Address.state', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='country', full_name='hipstershop.Address.country', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='zip_code', full_name='hipstershop.Address.zip_code', index=4,
+      number=5, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='postal_code', full_name='hipstershop.Address.postal_code', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4577,
+  serialized_end=4695,
+)
+
+
+_MONEY = _descriptor.Descriptor(
+  name='Money',
+  full_name='hipstershop.Money',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='currency_code', full_name='hipstershop.Money.currency_code', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='units', full_name='hipstershop.Money.units', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='nanos', full_name='hipstershop.Money.nanos', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4697,
+  serialized_end=4757,
+)
+
+
+_GETSUPPORTEDCURRENCIESRESPONSE = _descriptor.Descriptor(
+  name='GetSupportedCurrenciesResponse',
+  full_name='hipstershop.GetSupportedCurrenciesResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='currency_codes', full_name='hipstershop.GetSupportedCurrenciesResponse.currency_codes', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4759,
+  serialized_end=4815,
+)
+
+
+_CURRENCYCONVERSIONREQUEST = _descriptor.Descriptor(
+  name='CurrencyConversionRequest',
+  full_name='hipstershop.CurrencyConversionRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='from', full_name='hipstershop.CurrencyConversionRequest.from', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='to_code', full_name='hipstershop.CurrencyConversionRequest.to_code', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4817,
+  serialized_end=4895,
+)
+
+
+_CREDITCARDINFO = _descriptor.Descriptor(
+  name='CreditCardInfo',
+  full_name='hipstershop.CreditCardInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='credit_card_number', full_name='hipstershop.CreditCardInfo.credit_card_number', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='credit_card_cvv', full_name='hipstershop.CreditCardInfo.credit_card_cvv', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='credit_card_expiration_year', full_name='hipstershop.CreditCardInfo.credit_card_expiration_year', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='credit_card_expiration_month', full_name='hipstershop.CreditCardInfo.credit_card_expiration_month', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4898,
+  serialized_end=5042,
+)
+
+
+_CHARGEREQUEST = _descriptor.Descriptor(
+  name='ChargeRequest',
+  full_name='hipstershop.ChargeRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='amount', full_name='hipstershop.ChargeRequest.amount', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='credit_card', full_name='hipstershop.ChargeRequest.credit_card', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5044,
+  serialized_end=5145,
+)
+
+
+_CHARGERESPONSE = _descriptor.Descriptor(
+  name='ChargeResponse',
+  full_name='hipstershop.ChargeResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='transaction_id', full_name='hipstershop.ChargeResponse.transaction_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5147,
+  serialized_end=5187,
+)
+
+
+_REFUNDREQUEST = _descriptor.Descriptor(
+  name='RefundRequest',
+  full_name='hipstershop.RefundRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='transaction_id', full_name='hipstershop.RefundRequest.transaction_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='amount', full_name='hipstershop.RefundRequest.amount', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5189,
+  serialized_end=5264,
+)
+
+
+_REFUNDRESPONSE = _descriptor.Descriptor(
+  name='RefundResponse',
+  full_name='hipstershop.RefundResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='refund_id', full_name='hipstershop.RefundResponse.refund_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5266,
+  serialized_end=5301,
+)
+
+
+_ORDERITEM = _descriptor.Descriptor(
+  name='OrderItem',
+  full_name='hipstershop.OrderItem',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='item', full_name='hipstershop.OrderItem.item', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='cost', full_name='hipstershop.OrderItem.cost', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5303,
+  serialized_end=5385,
+)
+
+
+_ORDERRESULT = _descriptor.Descriptor(
+  name='OrderResult',
+  full_name='hipstershop.OrderResult',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='order_id', full_name='hipstershop.OrderResult.order_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='shipping_tracking_id', full_name='hipstershop.OrderResult.shipping_tracking_id', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='shipping_cost', full_name='hipstershop.OrderResult.shipping_cost', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='shipping_address', full_name='hipstershop.OrderResult.shipping_address', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='items', full_name='hipstershop.OrderResult.items', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='shipping_promotion', full_name='hipstershop.OrderResult.shipping_promotion', index=5,
+      number=6, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='parcels', full_name='hipstershop.OrderResult.parcels', index=6,
+      number=7, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='duties', full_name='hipstershop.OrderResult.duties', index=7,
+      number=8, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='duties_prepaid', full_name='hipstershop.OrderResult.duties_prepaid', index=8,
+      number=9, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pickup_point', full_name='hipstershop.OrderResult.pickup_point', index=9,
+      number=10, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='discounts', full_name='hipstershop.OrderResult.discounts', index=10,
+      number=11, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='taxes', full_name='hipstershop.OrderResult.taxes', index=11,
+      number=12, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5388,
+  serialized_end=5880,
+)
+
+
+_DISCOUNT = _descriptor.Descriptor(
+  name='Discount',
+  full_name='hipstershop.Discount',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='promotion_id', full_name='hipstershop.Discount.promotion_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='description', full_name='hipstershop.Discount.description', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='amount', full_name='hipstershop.Discount.amount', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5882,
+  serialized_end=5971,
+)
+
+
+_TAXLINE = _descriptor.Descriptor(
+  name='TaxLine',
+  full_name='hipstershop.TaxLine',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='jurisdiction_id', full_name='hipstershop.TaxLine.jurisdiction_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='description', full_name='hipstershop.TaxLine.description', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='rate', full_name='hipstershop.TaxLine.rate', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='taxable_amount', full_name='hipstershop.TaxLine.taxable_amount', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='amount', full_name='hipstershop.TaxLine.amount', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='included', full_name='hipstershop.TaxLine.included', index=5,
+      number=6, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5974,
+  serialized_end=6141,
+)
+
+
+_SENDORDERCONFIRMATIONREQUEST = _descriptor.Descriptor(
+  name='SendOrderConfirmationRequest',
+  full_name='hipstershop.SendOrderConfirmationRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='email', full_name='hipstershop.SendOrderConfirmationRequest.email', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='order', full_name='hipstershop.SendOrderConfirmationRequest.order', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6143,
+  serialized_end=6229,
+)
+
+
+_PLACEORDERREQUEST = _descriptor.Descriptor(
+  name='PlaceOrderRequest',
+  full_name='hipstershop.PlaceOrderRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='user_id', full_name='hipstershop.PlaceOrderRequest.user_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='user_currency', full_name='hipstershop.PlaceOrderRequest.user_currency', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='address', full_name='hipstershop.PlaceOrderRequest.address', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='email', full_name='hipstershop.PlaceOrderRequest.email', index=3,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='credit_card', full_name='hipstershop.PlaceOrderRequest.credit_card', index=4,
+      number=6, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='shipping_option_id', full_name='hipstershop.PlaceOrderRequest.shipping_option_id', index=5,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='shipping_promo_code', full_name='hipstershop.PlaceOrderRequest.shipping_promo_code', index=6,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='prepay_duties', full_name='hipstershop.PlaceOrderRequest.prepay_duties', index=7,
+      number=9, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pickup_point_id', full_name='hipstershop.PlaceOrderRequest.pickup_point_id', index=8,
+      number=10, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='idempotency_key', full_name='hipstershop.PlaceOrderRequest.idempotency_key', index=9,
+      number=11, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='promo_code', full_name='hipstershop.PlaceOrderRequest.promo_code', index=10,
+      number=12, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6232,
+  serialized_end=6545,
+)
+
+
+_PLACEORDERRESPONSE = _descriptor.Descriptor(
+  name='PlaceOrderResponse',
+  full_name='hipstershop.PlaceOrderResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='order', full_name='hipstershop.PlaceOrderResponse.order', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6547,
+  serialized_end=6608,
+)
+
+
+_REFUNDRETURNREQUEST = _descriptor.Descriptor(
+  name='RefundReturnRequest',
+  full_name='hipstershop.RefundReturnRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='return_id', full_name='hipstershop.RefundReturnRequest.return_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='order_id', full_name='hipstershop.RefundReturnRequest.order_id', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='items', full_name='hipstershop.RefundReturnRequest.items', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6610,
+  serialized_end=6706,
+)
+
+
+_REFUNDRETURNRESPONSE = _descriptor.Descriptor(
+  name='RefundReturnResponse',
+  full_name='hipstershop.RefundReturnResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='refund_id', full_name='hipstershop.RefundReturnResponse.refund_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='amount', full_name='hipstershop.RefundReturnResponse.amount', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6708,
+  serialized_end=6785,
+)
+
+
+_ORDERSTEP = _descriptor.Descriptor(
+  name='OrderStep',
+  full_name='hipstershop.OrderStep',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='step', full_name='hipstershop.OrderStep.step', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='hipstershop.OrderStep.status', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='hipstershop.OrderStep.error', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='time', full_name='hipstershop.OrderStep.time', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6787,
+  serialized_end=6857,
+)
+
+
+_ORDER = _descriptor.Descriptor(
+  name='Order',
+  full_name='hipstershop.Order',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='order_id', full_name='hipstershop.Order.order_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='user_id', full_name='hipstershop.Order.user_id', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='email', full_name='hipstershop.Order.email', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='hipstershop.Order.status', index=3,
+      number=4, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='result', full_name='hipstershop.Order.result', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='total_paid', full_name='hipstershop.Order.total_paid', index=5,
+      number=6, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='created_at', full_name='hipstershop.Order.created_at', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='steps', full_name='hipstershop.Order.steps', index=7,
+      number=8, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=6860,
+  serialized_end=7100,
+)
+
+
+_GETORDERREQUEST = _descriptor.Descriptor(
+  name='GetOrderRequest',
+  full_name='hipstershop.GetOrderRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='order_id', full_name='hipstershop.GetOrderRequest.order_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7102,
+  serialized_end=7137,
+)
+
+
+_LISTORDERSREQUEST = _descriptor.Descriptor(
+  name='ListOrdersRequest',
+  full_name='hipstershop.ListOrdersRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='user_id', full_name='hipstershop.ListOrdersRequest.user_id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='page_size', full_name='hipstershop.ListOrdersRequest.page_size', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='page_token', full_name='hipstershop.ListOrdersRequest.page_token', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7139,
+  serialized_end=7214,
+)
+
+
+_LISTORDERSRESPONSE = _descriptor.Descriptor(
+  name='ListOrdersResponse',
+  full_name='hipstershop.ListOrdersResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='orders', full_name='hipstershop.ListOrdersResponse.orders', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='next_page_token', full_name='hipstershop.ListOrdersResponse.next_page_token', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7216,
+  serialized_end=7297,
+)
+
+
+_QUOTETAXESREQUEST = _descriptor.Descriptor(
+  name='QuoteTaxesRequest',
+  full_name='hipstershop.QuoteTaxesRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='address', full_name='hipstershop.QuoteTaxesRequest.address', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='user_currency', full_name='hipstershop.QuoteTaxesRequest.user_currency', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='items', full_name='hipstershop.QuoteTaxesRequest.items', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='shipping_cost', full_name='hipstershop.QuoteTaxesRequest.shipping_cost', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='duties', full_name='hipstershop.QuoteTaxesRequest.duties', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=7300,
+  serialized_end=7507,
+)
+
+
+_QUOTETAXESRESPONSE = _descriptor.Descriptor(
+  name='QuoteTaxesResponse',
+  full_name='hipstershop.QuoteTaxesResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='taxes', full_name='hipstershop.QuoteTaxesResponse.taxes', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -1086,32 +3292,25 @@ _CREATEORDERREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1992,
-  serialized_end=2091,
+  serialized_start=7509,
+  serialized_end=7566,
 )
 
 
-_CREATEORDERRESPONSE = _descriptor.Descriptor(
-  name='CreateOrderResponse',
-  full_name='hipstershop.CreateOrderResponse',
+_ADREQUEST = _descriptor.Descriptor(
+  name='AdRequest',
+  full_name='hipstershop.AdRequest',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='items', full_name='hipstershop.CreateOrderResponse.items', index=0,
-      number=1, type=11, cpp_type=10, label=3,
+      name='context_keys', full_name='hipstershop.AdRequest.context_keys', index=0,
+      number=1, type=9, cpp_type=9, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='shipping_cost', full_name='hipstershop.CreateOrderResponse.shipping_cost', index=1,
-      number=2, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -1124,50 +3323,22 @@ _CREATEORDERRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2093,
-  serialized_end=2196,
+  serialized_start=7568,
+  serialized_end=7601,
 )
 
 
-_PLACEORDERREQUEST = _descriptor.Descriptor(
-  name='PlaceOrderRequest',
-  full_name='hipstershop.PlaceOrderRequest',
+_ADRESPONSE = _descriptor.Descriptor(
+  name='AdResponse',
+  full_name='hipstershop.AdResponse',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='user_id', full_name='hipstershop.PlaceOrderRequest.user_id', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='user_currency', full_name='hipstershop.PlaceOrderRequest.user_currency', index=1,
-      number=2, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='address', full_name='hipstershop.PlaceOrderRequest.address', index=2,
-      number=3, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='email', full_name='hipstershop.PlaceOrderRequest.email', index=3,
-      number=5, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
-      message_type=None, enum_type=None, containing_type=None,
-      is_extension=False, extension_scope=None,
-      options=None, file=DESCRIPTOR),
-    _descriptor.FieldDescriptor(
-      name='credit_card', full_name='hipstershop.PlaceOrderRequest.credit_card', index=4,
-      number=6, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='ads', full_name='hipstershop.AdResponse.ads', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -1183,22 +3354,29 @@ _PLACEORDERREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2199,
-  serialized_end=2362,
+  serialized_start=7603,
+  serialized_end=7645,
 )
 
 
-_PLACEORDERRESPONSE = _descriptor.Descriptor(
-  name='PlaceOrderResponse',
-  full_name='hipstershop.PlaceOrderResponse',
+_AD = _descriptor.Descriptor(
+  name='Ad',
+  full_name='hipstershop.Ad',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='order', full_name='hipstershop.PlaceOrderResponse.order', index=0,
-      number=1, type=11, cpp_type=10, label=1,
-      has_default_value=False, default_value=None,
+      name='redirect_url', full_name='hipstershop.Ad.redirect_url', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='text', full_name='hipstershop.Ad.text', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None, file=DESCRIPTOR),
@@ -1214,35 +3392,93 @@ _PLACEORDERRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2364,
-  serialized_end=2425,
+  serialized_start=7647,
+  serialized_end=7687,
 )
 
 _ADDITEMREQUEST.fields_by_name['item'].message_type = _CARTITEM
 _CART.fields_by_name['items'].message_type = _CARTITEM
 _PRODUCT.fields_by_name['price_usd'].message_type = _MONEY
+_PRODUCT.fields_by_name['dimensions'].message_type = _PACKAGEDIMENSIONS
 _LISTPRODUCTSRESPONSE.fields_by_name['products'].message_type = _PRODUCT
 _SEARCHPRODUCTSRESPONSE.fields_by_name['results'].message_type = _PRODUCT
 _GETQUOTEREQUEST.fields_by_name['address'].message_type = _ADDRESS
 _GETQUOTEREQUEST.fields_by_name['items'].message_type = _CARTITEM
+_GETQUOTEREQUEST.fields_by_name['subtotal'].message_type = _MONEY
+_GETQUOTEREQUEST.fields_by_name['rate_shopping'].enum_type = _RATESHOPPING
 _GETQUOTERESPONSE.fields_by_name['cost_usd'].message_type = _MONEY
+_GETQUOTERESPONSE.fields_by_name['cost'].message_type = _MONEY
+_GETQUOTERESPONSE.fields_by_name['promotion'].message_type = _SHIPPINGPROMOTION
+_GETQUOTERESPONSE.fields_by_name['duties'].message_type = _DUTIESESTIMATE
+_DUTIESESTIMATE.fields_by_name['goods_value'].message_type = _MONEY
+_DUTIESESTIMATE.fields_by_name['duties'].message_type = _MONEY
+_DUTIESESTIMATE.fields_by_name['taxes'].message_type = _MONEY
+_DUTIESESTIMATE.fields_by_name['total'].message_type = _MONEY
+_SHIPPINGPROMOTION.fields_by_name['discount'].message_type = _MONEY
 _SHIPORDERREQUEST.fields_by_name['address'].message_type = _ADDRESS
 _SHIPORDERREQUEST.fields_by_name['items'].message_type = _CARTITEM
+_SHIPORDERRESPONSE.fields_by_name['parcels'].message_type = _SHIPPEDPARCEL
+_SHIPORDERRESPONSE.fields_by_name['pickup_point'].message_type = _PICKUPPOINT
+_SHIPPEDPARCEL.fields_by_name['items'].message_type = _CARTITEM
+_LISTSHIPPINGOPTIONSREQUEST.fields_by_name['address'].message_type = _ADDRESS
+_LISTSHIPPINGOPTIONSREQUEST.fields_by_name['items'].message_type = _CARTITEM
+_LISTSHIPPINGOPTIONSREQUEST.fields_by_name['subtotal'].message_type = _MONEY
+_LISTSHIPPINGOPTIONSREQUEST.fields_by_name['rate_shopping'].enum_type = _RATESHOPPING
+_LISTSHIPPINGOPTIONSRESPONSE.fields_by_name['options'].message_type = _SHIPPINGOPTION
+_SHIPPINGOPTION.fields_by_name['cost_usd'].message_type = _MONEY
+_SHIPPINGOPTION.fields_by_name['cost'].message_type = _MONEY
+_SHIPPINGOPTION.fields_by_name['promotion'].message_type = _SHIPPINGPROMOTION
+_SHIPMENTEVENT.fields_by_name['status'].enum_type = _SHIPMENTSTATUS
+_SHIPMENT.fields_by_name['status'].enum_type = _SHIPMENTSTATUS
+_SHIPMENT.fields_by_name['address'].message_type = _ADDRESS
+_SHIPMENT.fields_by_name['items'].message_type = _CARTITEM
+_SHIPMENT.fields_by_name['events'].message_type = _SHIPMENTEVENT
+_VALIDATEADDRESSREQUEST.fields_by_name['address'].message_type = _ADDRESS
+_VALIDATEADDRESSRESPONSE.fields_by_name['normalized_address'].message_type = _ADDRESS
+_VALIDATEADDRESSRESPONSE.fields_by_name['errors'].message_type = _ADDRESSFIELDERROR
+_CREATERETURNREQUEST.fields_by_name['items'].message_type = _CARTITEM
+_RETURNEVENT.fields_by_name['status'].enum_type = _RETURNSTATUS
+_RETURN.fields_by_name['items'].message_type = _CARTITEM
+_RETURN.fields_by_name['status'].enum_type = _RETURNSTATUS
+_RETURN.fields_by_name['events'].message_type = _RETURNEVENT
+_LISTPICKUPPOINTSREQUEST.fields_by_name['address'].message_type = _ADDRESS
+_LISTPICKUPPOINTSRESPONSE.fields_by_name['points'].message_type = _PICKUPPOINT
+_PICKUPPOINT.fields_by_name['address'].message_type = _ADDRESS
 _CURRENCYCONVERSIONREQUEST.fields_by_name['from'].message_type = _MONEY
 _CHARGEREQUEST.fields_by_name['amount'].message_type = _MONEY
 _CHARGEREQUEST.fields_by_name['credit_card'].message_type = _CREDITCARDINFO
+_REFUNDREQUEST.fields_by_name['amount'].message_type = _MONEY
 _ORDERITEM.fields_by_name['item'].message_type = _CARTITEM
 _ORDERITEM.fields_by_name['cost'].message_type = _MONEY
 _ORDERRESULT.fields_by_name['shipping_cost'].message_type = _MONEY
 _ORDERRESULT.fields_by_name['shipping_address'].message_type = _ADDRESS
 _ORDERRESULT.fields_by_name['items'].message_type = _ORDERITEM
+_ORDERRESULT.fields_by_name['shipping_promotion'].message_type = _SHIPPINGPROMOTION
+_ORDERRESULT.fields_by_name['parcels'].message_type = _SHIPPEDPARCEL
+_ORDERRESULT.fields_by_name['duties'].message_type = _DUTIESESTIMATE
+_ORDERRESULT.fields_by_name['pickup_point'].message_type = _PICKUPPOINT
+_ORDERRESULT.fields_by_name['discounts'].message_type = _DISCOUNT
+_ORDERRESULT.fields_by_name['taxes'].message_type = _TAXLINE
+_DISCOUNT.fields_by_name['amount'].message_type = _MONEY
+_TAXLINE.fields_by_name['taxable_amount'].message_type = _MONEY
+_TAXLINE.fields_by_name['amount'].message_type = _MONEY
 _SENDORDERCONFIRMATIONREQUEST.fields_by_name['order'].message_type = _ORDERRESULT
-_CREATEORDERREQUEST.fields_by_name['address'].message_type = _ADDRESS
-_CREATEORDERRESPONSE.fields_by_name['items'].message_type = _ORDERITEM
-_CREATEORDERRESPONSE.fields_by_name['shipping_cost'].message_type = _MONEY
 _PLACEORDERREQUEST.fields_by_name['address'].message_type = _ADDRESS
 _PLACEORDERREQUEST.fields_by_name['credit_card'].message_type = _CREDITCARDINFO
 _PLACEORDERRESPONSE.fields_by_name['order'].message_type = _ORDERRESULT
+_REFUNDRETURNREQUEST.fields_by_name['items'].message_type = _CARTITEM
+_REFUNDRETURNRESPONSE.fields_by_name['amount'].message_type = _MONEY
+_ORDER.fields_by_name['status'].enum_type = _ORDERSTATUS
+_ORDER.fields_by_name['result'].message_type = _ORDERRESULT
+_ORDER.fields_by_name['total_paid'].message_type = _MONEY
+_ORDER.fields_by_name['steps'].message_type = _ORDERSTEP
+_LISTORDERSRESPONSE.fields_by_name['orders'].message_type = _ORDER
+_QUOTETAXESREQUEST.fields_by_name['address'].message_type = _ADDRESS
+_QUOTETAXESREQUEST.fields_by_name['items'].message_type = _CARTITEM
+_QUOTETAXESREQUEST.fields_by_name['shipping_cost'].message_type = _MONEY
+_QUOTETAXESREQUEST.fields_by_name['duties'].message_type = _DUTIESESTIMATE
+_QUOTETAXESRESPONSE.fields_by_name['taxes'].message_type = _TAXLINE
+_ADRESPONSE.fields_by_name['ads'].message_type = _AD
 DESCRIPTOR.message_types_by_name['CartItem'] = _CARTITEM
 DESCRIPTOR.message_types_by_name['AddItemRequest'] = _ADDITEMREQUEST
 DESCRIPTOR.message_types_by_name['EmptyCartRequest'] = _EMPTYCARTREQUEST
@@ -1252,14 +3488,38 @@ DESCRIPTOR.message_types_by_name['Empty'] = _EMPTY
 DESCRIPTOR.message_types_by_name['ListRecommendationsRequest'] = _LISTRECOMMENDATIONSREQUEST
 DESCRIPTOR.message_types_by_name['ListRecommendationsResponse'] = _LISTRECOMMENDATIONSRESPONSE
 DESCRIPTOR.message_types_by_name['Product'] = _PRODUCT
+DESCRIPTOR.message_types_by_name['PackageDimensions'] = _PACKAGEDIMENSIONS
 DESCRIPTOR.message_types_by_name['ListProductsResponse'] = _LISTPRODUCTSRESPONSE
 DESCRIPTOR.message_types_by_name['GetProductRequest'] = _GETPRODUCTREQUEST
 DESCRIPTOR.message_types_by_name['SearchProductsRequest'] = _SEARCHPRODUCTSREQUEST
 DESCRIPTOR.message_types_by_name['SearchProductsResponse'] = _SEARCHPRODUCTSRESPONSE
 DESCRIPTOR.message_types_by_name['GetQuoteRequest'] = _GETQUOTEREQUEST
 DESCRIPTOR.message_types_by_name['GetQuoteResponse'] = _GETQUOTERESPONSE
+DESCRIPTOR.message_types_by_name['DutiesEstimate'] = _DUTIESESTIMATE
+DESCRIPTOR.message_types_by_name['ShippingPromotion'] = _SHIPPINGPROMOTION
 DESCRIPTOR.message_types_by_name['ShipOrderRequest'] = _SHIPORDERREQUEST
 DESCRIPTOR.message_types_by_name['ShipOrderResponse'] = _SHIPORDERRESPONSE
+DESCRIPTOR.message_types_by_name['ShippedParcel'] = _SHIPPEDPARCEL
+DESCRIPTOR.message_types_by_name['ListShippingOptionsRequest'] = _LISTSHIPPINGOPTIONSREQUEST
+DESCRIPTOR.message_types_by_name['ListShippingOptionsResponse'] = _LISTSHIPPINGOPTIONSRESPONSE
+DESCRIPTOR.message_types_by_name['ShippingOption'] = _SHIPPINGOPTION
+DESCRIPTOR.message_types_by_name['GetShipmentRequest'] = _GETSHIPMENTREQUEST
+DESCRIPTOR.message_types_by_name['CancelShipmentRequest'] = _CANCELSHIPMENTREQUEST
+DESCRIPTOR.message_types_by_name['CancelShipmentResponse'] = _CANCELSHIPMENTRESPONSE
+DESCRIPTOR.message_types_by_name['WatchShipmentRequest'] = _WATCHSHIPMENTREQUEST
+DESCRIPTOR.message_types_by_name['ShipmentEvent'] = _SHIPMENTEVENT
+DESCRIPTOR.message_types_by_name['Shipment'] = _SHIPMENT
+DESCRIPTOR.message_types_by_name['ValidateAddressRequest'] = _VALIDATEADDRESSREQUEST
+DESCRIPTOR.message_types_by_name['ValidateAddressResponse'] = _VALIDATEADDRESSRESPONSE
+DESCRIPTOR.message_types_by_name['AddressFieldError'] = _ADDRESSFIELDERROR
+DESCRIPTOR.message_types_by_name['CreateReturnRequest'] = _CREATERETURNREQUEST
+DESCRIPTOR.message_types_by_name['GetReturnRequest'] = _GETRETURNREQUEST
+DESCRIPTOR.message_types_by_name['ReturnEvent'] = _RETURNEVENT
+DESCRIPTOR.message_types_by_name['Return'] = _RETURN
+DESCRIPTOR.message_types_by_name['ListPickupPointsRequest'] = _LISTPICKUPPOINTSREQUEST
+DESCRIPTOR.message_types_by_name['ListPickupPointsResponse'] = _LISTPICKUPPOINTSRESPONSE
+DESCRIPTOR.message_types_by_name['GetPickupPointRequest'] = _GETPICKUPPOINTREQUEST
+DESCRIPTOR.message_types_by_name['PickupPoint'] = _PICKUPPOINT
 DESCRIPTOR.message_types_by_name['Address'] = _ADDRESS
 DESCRIPTOR.message_types_by_name['Money'] = _MONEY
 DESCRIPTOR.message_types_by_name['GetSupportedCurrenciesResponse'] = _GETSUPPORTEDCURRENCIESRESPONSE
@@ -1267,13 +3527,31 @@ DESCRIPTOR.message_types_by_name['CurrencyConversionRequest'] = _CURRENCYCONVERS
 DESCRIPTOR.message_types_by_name['CreditCardInfo'] = _CREDITCARDINFO
 DESCRIPTOR.message_types_by_name['ChargeRequest'] = _CHARGEREQUEST
 DESCRIPTOR.message_types_by_name['ChargeResponse'] = _CHARGERESPONSE
+DESCRIPTOR.message_types_by_name['RefundRequest'] = _REFUNDREQUEST
+DESCRIPTOR.message_types_by_name['RefundResponse'] = _REFUNDRESPONSE
 DESCRIPTOR.message_types_by_name['OrderItem'] = _ORDERITEM
 DESCRIPTOR.message_types_by_name['OrderResult'] = _ORDERRESULT
+DESCRIPTOR.message_types_by_name['Discount'] = _DISCOUNT
+DESCRIPTOR.message_types_by_name['TaxLine'] = _TAXLINE
 DESCRIPTOR.message_types_by_name['SendOrderConfirmationRequest'] = _SENDORDERCONFIRMATIONREQUEST
-DESCRIPTOR.message_types_by_name['CreateOrderRequest'] = _CREATEORDERREQUEST
-DESCRIPTOR.message_types_by_name['CreateOrderResponse'] = _CREATEORDERRESPONSE
 DESCRIPTOR.message_types_by_name['PlaceOrderRequest'] = _PLACEORDERREQUEST
 DESCRIPTOR.message_types_by_name['PlaceOrderResponse'] = _PLACEORDERRESPONSE
+DESCRIPTOR.message_types_by_name['RefundReturnRequest'] = _REFUNDRETURNREQUEST
+DESCRIPTOR.message_types_by_name['RefundReturnResponse'] = _REFUNDRETURNRESPONSE
+DESCRIPTOR.message_types_by_name['OrderStep'] = _ORDERSTEP
+DESCRIPTOR.message_types_by_name['Order'] = _ORDER
+DESCRIPTOR.message_types_by_name['GetOrderRequest'] = _GETORDERREQUEST
+DESCRIPTOR.message_types_by_name['ListOrdersRequest'] = _LISTORDERSREQUEST
+DESCRIPTOR.message_types_by_name['ListOrdersResponse'] = _LISTORDERSRESPONSE
+DESCRIPTOR.message_types_by_name['QuoteTaxesRequest'] = _QUOTETAXESREQUEST
+DESCRIPTOR.message_types_by_name['QuoteTaxesResponse'] = _QUOTETAXESRESPONSE
+DESCRIPTOR.message_types_by_name['AdRequest'] = _ADREQUEST
+DESCRIPTOR.message_types_by_name['AdResponse'] = _ADRESPONSE
+DESCRIPTOR.message_types_by_name['Ad'] = _AD
+DESCRIPTOR.enum_types_by_name['RateShopping'] = _RATESHOPPING
+DESCRIPTOR.enum_types_by_name['ShipmentStatus'] = _SHIPMENTSTATUS
+DESCRIPTOR.enum_types_by_name['ReturnStatus'] = _RETURNSTATUS
+DESCRIPTOR.enum_types_by_name['OrderStatus'] = _ORDERSTATUS
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 CartItem = _reflection.GeneratedProtocolMessageType('CartItem', (_message.Message,), dict(
@@ -1339,6 +3617,13 @@ Product = _reflection.GeneratedProtocolMessageType('Product', (_message.Message,
   ))
 _sym_db.RegisterMessage(Product)
 
+PackageDimensions = _reflection.GeneratedProtocolMessageType('PackageDimensions', (_message.Message,), dict(
+  DESCRIPTOR = _PACKAGEDIMENSIONS,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.PackageDimensions)
+  ))
+_sym_db.RegisterMessage(PackageDimensions)
+
 ListProductsResponse = _reflection.GeneratedProtocolMessageType('ListProductsResponse', (_message.Message,), dict(
   DESCRIPTOR = _LISTPRODUCTSRESPONSE,
   __module__ = 'demo_pb2'
@@ -1381,6 +3666,20 @@ GetQuoteResponse = _reflection.GeneratedProtocolMessageType('GetQuoteResponse',
   ))
 _sym_db.RegisterMessage(GetQuoteResponse)
 
+DutiesEstimate = _reflection.GeneratedProtocolMessageType('DutiesEstimate', (_message.Message,), dict(
+  DESCRIPTOR = _DUTIESESTIMATE,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.DutiesEstimate)
+  ))
+_sym_db.RegisterMessage(DutiesEstimate)
+
+ShippingPromotion = _reflection.GeneratedProtocolMessageType('ShippingPromotion', (_message.Message,), dict(
+  DESCRIPTOR = _SHIPPINGPROMOTION,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.ShippingPromotion)
+  ))
+_sym_db.RegisterMessage(ShippingPromotion)
+
 ShipOrderRequest = _reflection.GeneratedProtocolMessageType('ShipOrderRequest', (_message.Message,), dict(
   DESCRIPTOR = _SHIPORDERREQUEST,
   __module__ = 'demo_pb2'
@@ -1395,6 +3694,153 @@ ShipOrderResponse = _reflection.GeneratedProtocolMessageType('ShipOrderResponse'
   ))
 _sym_db.RegisterMessage(ShipOrderResponse)
 
+ShippedParcel = _reflection.GeneratedProtocolMessageType('ShippedParcel', (_message.Message,), dict(
+  DESCRIPTOR = _SHIPPEDPARCEL,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.ShippedParcel)
+  ))
+_sym_db.RegisterMessage(ShippedParcel)
+
+ListShippingOptionsRequest = _reflection.GeneratedProtocolMessageType('ListShippingOptionsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _LISTSHIPPINGOPTIONSREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.ListShippingOptionsRequest)
+  ))
+_sym_db.RegisterMessage(ListShippingOptionsRequest)
+
+ListShippingOptionsResponse = _reflection.GeneratedProtocolMessageType('ListShippingOptionsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _LISTSHIPPINGOPTIONSRESPONSE,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.ListShippingOptionsResponse)
+  ))
+_sym_db.RegisterMessage(ListShippingOptionsResponse)
+
+ShippingOption = _reflection.GeneratedProtocolMessageType('ShippingOption', (_message.Message,), dict(
+  DESCRIPTOR = _SHIPPINGOPTION,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.ShippingOption)
+  ))
+_sym_db.RegisterMessage(ShippingOption)
+
+GetShipmentRequest = _reflection.GeneratedProtocolMessageType('GetShipmentRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETSHIPMENTREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.GetShipmentRequest)
+  ))
+_sym_db.RegisterMessage(GetShipmentRequest)
+
+CancelShipmentRequest = _reflection.GeneratedProtocolMessageType('CancelShipmentRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CANCELSHIPMENTREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.CancelShipmentRequest)
+  ))
+_sym_db.RegisterMessage(CancelShipmentRequest)
+
+CancelShipmentResponse = _reflection.GeneratedProtocolMessageType('CancelShipmentResponse', (_message.Message,), dict(
+  DESCRIPTOR = _CANCELSHIPMENTRESPONSE,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.CancelShipmentResponse)
+  ))
+_sym_db.RegisterMessage(CancelShipmentResponse)
+
+WatchShipmentRequest = _reflection.GeneratedProtocolMessageType('WatchShipmentRequest', (_message.Message,), dict(
+  DESCRIPTOR = _WATCHSHIPMENTREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.WatchShipmentRequest)
+  ))
+_sym_db.RegisterMessage(WatchShipmentRequest)
+
+ShipmentEvent = _reflection.GeneratedProtocolMessageType('ShipmentEvent', (_message.Message,), dict(
+  DESCRIPTOR = _SHIPMENTEVENT,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.ShipmentEvent)
+  ))
+_sym_db.RegisterMessage(ShipmentEvent)
+
+Shipment = _reflection.GeneratedProtocolMessageType('Shipment', (_message.Message,), dict(
+  DESCRIPTOR = _SHIPMENT,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.Shipment)
+  ))
+_sym_db.RegisterMessage(Shipment)
+
+ValidateAddressRequest = _reflection.GeneratedProtocolMessageType('ValidateAddressRequest', (_message.Message,), dict(
+  DESCRIPTOR = _VALIDATEADDRESSREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.ValidateAddressRequest)
+  ))
+_sym_db.RegisterMessage(ValidateAddressRequest)
+
+ValidateAddressResponse = _reflection.GeneratedProtocolMessageType('ValidateAddressResponse', (_message.Message,), dict(
+  DESCRIPTOR = _VALIDATEADDRESSRESPONSE,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.ValidateAddressResponse)
+  ))
+_sym_db.RegisterMessage(ValidateAddressResponse)
+
+AddressFieldError = _reflection.GeneratedProtocolMessageType('AddressFieldError', (_message.Message,), dict(
+  DESCRIPTOR = _ADDRESSFIELDERROR,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.AddressFieldError)
+  ))
+_sym_db.RegisterMessage(AddressFieldError)
+
+CreateReturnRequest = _reflection.GeneratedProtocolMessageType('CreateReturnRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CREATERETURNREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.CreateReturnRequest)
+  ))
+_sym_db.RegisterMessage(CreateReturnRequest)
+
+GetReturnRequest = _reflection.GeneratedProtocolMessageType('GetReturnRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETRETURNREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.GetReturnRequest)
+  ))
+_sym_db.RegisterMessage(GetReturnRequest)
+
+ReturnEvent = _reflection.GeneratedProtocolMessageType('ReturnEvent', (_message.Message,), dict(
+  DESCRIPTOR = _RETURNEVENT,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.ReturnEvent)
+  ))
+_sym_db.RegisterMessage(ReturnEvent)
+
+Return = _reflection.GeneratedProtocolMessageType('Return', (_message.Message,), dict(
+  DESCRIPTOR = _RETURN,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.Return)
+  ))
+_sym_db.RegisterMessage(Return)
+
+ListPickupPointsRequest = _reflection.GeneratedProtocolMessageType('ListPickupPointsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _LISTPICKUPPOINTSREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.ListPickupPointsRequest)
+  ))
+_sym_db.RegisterMessage(ListPickupPointsRequest)
+
+ListPickupPointsResponse = _reflection.GeneratedProtocolMessageType('ListPickupPointsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _LISTPICKUPPOINTSRESPONSE,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.ListPickupPointsResponse)
+  ))
+_sym_db.RegisterMessage(ListPickupPointsResponse)
+
+GetPickupPointRequest = _reflection.GeneratedProtocolMessageType('GetPickupPointRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETPICKUPPOINTREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.GetPickupPointRequest)
+  ))
+_sym_db.RegisterMessage(GetPickupPointRequest)
+
+PickupPoint = _reflection.GeneratedProtocolMessageType('PickupPoint', (_message.Message,), dict(
+  DESCRIPTOR = _PICKUPPOINT,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.PickupPoint)
+  ))
+_sym_db.RegisterMessage(PickupPoint)
+
 Address = _reflection.GeneratedProtocolMessageType('Address', (_message.Message,), dict(
   DESCRIPTOR = _ADDRESS,
   __module__ = 'demo_pb2'
@@ -1444,6 +3890,20 @@ ChargeResponse = _reflection.GeneratedProtocolMessageType('ChargeResponse', (_me
   ))
 _sym_db.RegisterMessage(ChargeResponse)
 
+RefundRequest = _reflection.GeneratedProtocolMessageType('RefundRequest', (_message.Message,), dict(
+  DESCRIPTOR = _REFUNDREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.RefundRequest)
+  ))
+_sym_db.RegisterMessage(RefundRequest)
+
+RefundResponse = _reflection.GeneratedProtocolMessageType('RefundResponse', (_message.Message,), dict(
+  DESCRIPTOR = _REFUNDRESPONSE,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.RefundResponse)
+  ))
+_sym_db.RegisterMessage(RefundResponse)
+
 OrderItem = _reflection.GeneratedProtocolMessageType('OrderItem', (_message.Message,), dict(
   DESCRIPTOR = _ORDERITEM,
   __module__ = 'demo_pb2'
@@ -1458,26 +3918,26 @@ OrderResult = _reflection.GeneratedProtocolMessageType('OrderResult', (_message.
   ))
 _sym_db.RegisterMessage(OrderResult)
 
-SendOrderConfirmationRequest = _reflection.GeneratedProtocolMessageType('SendOrderConfirmationRequest', (_message.Message,), dict(
-  DESCRIPTOR = _SENDORDERCONFIRMATIONREQUEST,
+Discount = _reflection.GeneratedProtocolMessageType('Discount', (_message.Message,), dict(
+  DESCRIPTOR = _DISCOUNT,
   __module__ = 'demo_pb2'
-  # @@protoc_insertion_point(class_scope:hipstershop.SendOrderConfirmationRequest)
+  # @@protoc_insertion_point(class_scope:hipstershop.Discount)
   ))
-_sym_db.RegisterMessage(SendOrderConfirmationRequest)
+_sym_db.RegisterMessage(Discount)
 
-CreateOrderRequest = _reflection.GeneratedProtocolMessageType('CreateOrderRequest', (_message.Message,), dict(
-  DESCRIPTOR = _CREATEORDERREQUEST,
+TaxLine = _reflection.GeneratedProtocolMessageType('TaxLine', (_message.Message,), dict(
+  DESCRIPTOR = _TAXLINE,
   __module__ = 'demo_pb2'
-  # @@protoc_insertion_point(class_scope:hipstershop.CreateOrderRequest)
+  # @@protoc_insertion_point(class_scope:hipstershop.TaxLine)
   ))
-_sym_db.RegisterMessage(CreateOrderRequest)
+_sym_db.RegisterMessage(TaxLine)
 
-CreateOrderResponse = _reflection.GeneratedProtocolMessageType('CreateOrderResponse', (_message.Message,), dict(
-  DESCRIPTOR = _CREATEORDERRESPONSE,
+SendOrderConfirmationRequest = _reflection.GeneratedProtocolMessageType('SendOrderConfirmationRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SENDORDERCONFIRMATIONREQUEST,
   __module__ = 'demo_pb2'
-  # @@protoc_insertion_point(class_scope:hipstershop.CreateOrderResponse)
+  # @@protoc_insertion_point(class_scope:hipstershop.SendOrderConfirmationRequest)
   ))
-_sym_db.RegisterMessage(CreateOrderResponse)
+_sym_db.RegisterMessage(SendOrderConfirmationRequest)
 
 PlaceOrderRequest = _reflection.GeneratedProtocolMessageType('PlaceOrderRequest', (_message.Message,), dict(
   DESCRIPTOR = _PLACEORDERREQUEST,
@@ -1493,6 +3953,90 @@ PlaceOrderResponse = _reflection.GeneratedProtocolMessageType('PlaceOrderRespons
   ))
 _sym_db.RegisterMessage(PlaceOrderResponse)
 
+RefundReturnRequest = _reflection.GeneratedProtocolMessageType('RefundReturnRequest', (_message.Message,), dict(
+  DESCRIPTOR = _REFUNDRETURNREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.RefundReturnRequest)
+  ))
+_sym_db.RegisterMessage(RefundReturnRequest)
+
+RefundReturnResponse = _reflection.GeneratedProtocolMessageType('RefundReturnResponse', (_message.Message,), dict(
+  DESCRIPTOR = _REFUNDRETURNRESPONSE,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.RefundReturnResponse)
+  ))
+_sym_db.RegisterMessage(RefundReturnResponse)
+
+OrderStep = _reflection.GeneratedProtocolMessageType('OrderStep', (_message.Message,), dict(
+  DESCRIPTOR = _ORDERSTEP,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.OrderStep)
+  ))
+_sym_db.RegisterMessage(OrderStep)
+
+Order = _reflection.GeneratedProtocolMessageType('Order', (_message.Message,), dict(
+  DESCRIPTOR = _ORDER,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.Order)
+  ))
+_sym_db.RegisterMessage(Order)
+
+GetOrderRequest = _reflection.GeneratedProtocolMessageType('GetOrderRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETORDERREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.GetOrderRequest)
+  ))
+_sym_db.RegisterMessage(GetOrderRequest)
+
+ListOrdersRequest = _reflection.GeneratedProtocolMessageType('ListOrdersRequest', (_message.Message,), dict(
+  DESCRIPTOR = _LISTORDERSREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.ListOrdersRequest)
+  ))
+_sym_db.RegisterMessage(ListOrdersRequest)
+
+ListOrdersResponse = _reflection.GeneratedProtocolMessageType('ListOrdersResponse', (_message.Message,), dict(
+  DESCRIPTOR = _LISTORDERSRESPONSE,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.ListOrdersResponse)
+  ))
+_sym_db.RegisterMessage(ListOrdersResponse)
+
+QuoteTaxesRequest = _reflection.GeneratedProtocolMessageType('QuoteTaxesRequest', (_message.Message,), dict(
+  DESCRIPTOR = _QUOTETAXESREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.QuoteTaxesRequest)
+  ))
+_sym_db.RegisterMessage(QuoteTaxesRequest)
+
+QuoteTaxesResponse = _reflection.GeneratedProtocolMessageType('QuoteTaxesResponse', (_message.Message,), dict(
+  DESCRIPTOR = _QUOTETAXESRESPONSE,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.QuoteTaxesResponse)
+  ))
+_sym_db.RegisterMessage(QuoteTaxesResponse)
+
+AdRequest = _reflection.GeneratedProtocolMessageType('AdRequest', (_message.Message,), dict(
+  DESCRIPTOR = _ADREQUEST,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.AdRequest)
+  ))
+_sym_db.RegisterMessage(AdRequest)
+
+AdResponse = _reflection.GeneratedProtocolMessageType('AdResponse', (_message.Message,), dict(
+  DESCRIPTOR = _ADRESPONSE,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.AdResponse)
+  ))
+_sym_db.RegisterMessage(AdResponse)
+
+Ad = _reflection.GeneratedProtocolMessageType('Ad', (_message.Message,), dict(
+  DESCRIPTOR = _AD,
+  __module__ = 'demo_pb2'
+  # @@protoc_insertion_point(class_scope:hipstershop.Ad)
+  ))
+_sym_db.RegisterMessage(Ad)
+
 
 
 _CARTSERVICE = _descriptor.ServiceDescriptor(
@@ -1501,8 +4045,8 @@ _CARTSERVICE = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=0,
   options=None,
-  serialized_start=2428,
-  serialized_end=2630,
+  serialized_start=8110,
+  serialized_end=8312,
   methods=[
   _descriptor.MethodDescriptor(
     name='AddItem',
@@ -1543,8 +4087,8 @@ _RECOMMENDATIONSERVICE = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=1,
   options=None,
-  serialized_start=2633,
-  serialized_end=2764,
+  serialized_start=8315,
+  serialized_end=8446,
   methods=[
   _descriptor.MethodDescriptor(
     name='ListRecommendations',
@@ -1567,8 +4111,8 @@ _PRODUCTCATALOGSERVICE = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=2,
   options=None,
-  serialized_start=2767,
-  serialized_end=3026,
+  serialized_start=8449,
+  serialized_end=8708,
   methods=[
   _descriptor.MethodDescriptor(
     name='ListProducts',
@@ -1609,8 +4153,8 @@ _SHIPPINGSERVICE = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=3,
   options=None,
-  serialized_start=3029,
-  serialized_end=3199,
+  serialized_start=8711,
+  serialized_end=9656,
   methods=[
   _descriptor.MethodDescriptor(
     name='GetQuote',
@@ -1630,6 +4174,87 @@ _SHIPPINGSERVICE = _descriptor.ServiceDescriptor(
     output_type=_SHIPORDERRESPONSE,
     options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='ListShippingOptions',
+    full_name='hipstershop.ShippingService.ListShippingOptions',
+    index=2,
+    containing_service=None,
+    input_type=_LISTSHIPPINGOPTIONSREQUEST,
+    output_type=_LISTSHIPPINGOPTIONSRESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetShipment',
+    full_name='hipstershop.ShippingService.GetShipment',
+    index=3,
+    containing_service=None,
+    input_type=_GETSHIPMENTREQUEST,
+    output_type=_SHIPMENT,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='WatchShipment',
+    full_name='hipstershop.ShippingService.WatchShipment',
+    index=4,
+    containing_service=None,
+    input_type=_WATCHSHIPMENTREQUEST,
+    output_type=_SHIPMENTEVENT,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='CancelShipment',
+    full_name='hipstershop.ShippingService.CancelShipment',
+    index=5,
+    containing_service=None,
+    input_type=_CANCELSHIPMENTREQUEST,
+    output_type=_CANCELSHIPMENTRESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ValidateAddress',
+    full_name='hipstershop.ShippingService.ValidateAddress',
+    index=6,
+    containing_service=None,
+    input_type=_VALIDATEADDRESSREQUEST,
+    output_type=_VALIDATEADDRESSRESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='CreateReturn',
+    full_name='hipstershop.ShippingService.CreateReturn',
+    index=7,
+    containing_service=None,
+    input_type=_CREATERETURNREQUEST,
+    output_type=_RETURN,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetReturn',
+    full_name='hipstershop.ShippingService.GetReturn',
+    index=8,
+    containing_service=None,
+    input_type=_GETRETURNREQUEST,
+    output_type=_RETURN,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListPickupPoints',
+    full_name='hipstershop.ShippingService.ListPickupPoints',
+    index=9,
+    containing_service=None,
+    input_type=_LISTPICKUPPOINTSREQUEST,
+    output_type=_LISTPICKUPPOINTSRESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetPickupPoint',
+    full_name='hipstershop.ShippingService.GetPickupPoint',
+    index=10,
+    containing_service=None,
+    input_type=_GETPICKUPPOINTREQUEST,
+    output_type=_PICKUPPOINT,
+    options=None,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_SHIPPINGSERVICE)
 
@@ -1642,8 +4267,8 @@ _CURRENCYSERVICE = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=4,
   options=None,
-  serialized_start=3202,
-  serialized_end=3385,
+  serialized_start=9659,
+  serialized_end=9842,
   methods=[
   _descriptor.MethodDescriptor(
     name='GetSupportedCurrencies',
@@ -1675,8 +4300,8 @@ _PAYMENTSERVICE = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=5,
   options=None,
-  serialized_start=3387,
-  serialized_end=3472,
+  serialized_start=9845,
+  serialized_end=9999,
   methods=[
   _descriptor.MethodDescriptor(
     name='Charge',
@@ -1687,6 +4312,15 @@ _PAYMENTSERVICE = _descriptor.ServiceDescriptor(
     output_type=_CHARGERESPONSE,
     options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='Refund',
+    full_name='hipstershop.PaymentService.Refund',
+    index=1,
+    containing_service=None,
+    input_type=_REFUNDREQUEST,
+    output_type=_REFUNDRESPONSE,
+    options=None,
+  ),
 ])
 _sym_db.RegisterServiceDescriptor(_PAYMENTSERVICE)
 
@@ -1699,8 +4333,8 @@ _EMAILSERVICE = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=6,
   options=None,
-  serialized_start=3474,
-  serialized_end=3578,
+  serialized_start=10001,
+  serialized_end=10105,
   methods=[
   _descriptor.MethodDescriptor(
     name='SendOrderConfirmation',
@@ -1723,25 +4357,52 @@ _CHECKOUTSERVICE = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=7,
   options=None,
-  serialized_start=3581,
-  serialized_end=3763,
+  serialized_start=10108,
+  serialized_end=10519,
   methods=[
   _descriptor.MethodDescriptor(
-    name='CreateOrder',
-    full_name='hipstershop.CheckoutService.CreateOrder',
+    name='PlaceOrder',
+    full_name='hipstershop.CheckoutService.PlaceOrder',
     index=0,
     containing_service=None,
-    input_type=_CREATEORDERREQUEST,
-    output_type=_CREATEORDERRESPONSE,
+    input_type=_PLACEORDERREQUEST,
+    output_type=_PLACEORDERRESPONSE,
     options=None,
   ),
   _descriptor.MethodDescriptor(
-    name='PlaceOrder',
-    full_name='hipstershop.CheckoutService.PlaceOrder',
+    name='RefundReturn',
+    full_name='hipstershop.CheckoutService.RefundReturn',
     index=1,
     containing_service=None,
-    input_type=_PLACEORDERREQUEST,
-    output_type=_PLACEORDERRESPONSE,
+    input_type=_REFUNDRETURNREQUEST,
+    output_type=_REFUNDRETURNRESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetOrder',
+    full_name='hipstershop.CheckoutService.GetOrder',
+    index=2,
+    containing_service=None,
+    input_type=_GETORDERREQUEST,
+    output_type=_ORDER,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='ListOrders',
+    full_name='hipstershop.CheckoutService.ListOrders',
+    index=3,
+    containing_service=None,
+    input_type=_LISTORDERSREQUEST,
+    output_type=_LISTORDERSRESPONSE,
+    options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='QuoteTaxes',
+    full_name='hipstershop.CheckoutService.QuoteTaxes',
+    index=4,
+    containing_service=None,
+    input_type=_QUOTETAXESREQUEST,
+    output_type=_QUOTETAXESRESPONSE,
     options=None,
   ),
 ])
@@ -1749,4 +4410,28 @@ _sym_db.RegisterServiceDescriptor(_CHECKOUTSERVICE)
 
 DESCRIPTOR.services_by_name['CheckoutService'] = _CHECKOUTSERVICE
 
+
+_ADSERVICE = _descriptor.ServiceDescriptor(
+  name='AdService',
+  full_name='hipstershop.AdService',
+  file=DESCRIPTOR,
+  index=8,
+  options=None,
+  serialized_start=10521,
+  serialized_end=10593,
+  methods=[
+  _descriptor.MethodDescriptor(
+    name='GetAds',
+    full_name='hipstershop.AdService.GetAds',
+    index=0,
+    containing_service=None,
+    input_type=_ADREQUEST,
+    output_type=_ADRESPONSE,
+    options=None,
+  ),
+])
+_sym_db.RegisterServiceDescriptor(_ADSERVICE)
+
+DESCRIPTOR.services_by_name['AdService'] = _ADSERVICE
+
 # @@protoc_insertion_point(module_scope)
@@ -225,6 +225,51 @@ class ShippingServiceStub(object):
         request_serializer=demo__pb2.ShipOrderRequest.SerializeToString,
         response_deserializer=demo__pb2.ShipOrderResponse.FromString,
         )
+    self.ListShippingOptions = channel.unary_unary(
+        '/hipstershop.ShippingService/ListShippingOptions',
+        request_serializer=demo__pb2.ListShippingOptionsRequest.SerializeToString,
+        response_deserializer=demo__pb2.ListShippingOptionsResponse.FromString,
+        )
+    self.GetShipment = channel.unary_unary(
+        '/hipstershop.ShippingService/GetShipment',
+        request_serializer=demo__pb2.GetShipmentRequest.SerializeToString,
+        response_deserializer=demo__pb2.Shipment.FromString,
+        )
+    self.WatchShipment = channel.unary_stream(
+        '/hipstershop.ShippingService/WatchShipment',
+        request_serializer=demo__pb2.WatchShipmentRequest.SerializeToString,
+        response_deserializer=demo__pb2.ShipmentEvent.FromString,
+        )
+    self.CancelShipment = channel.unary_unary(
+        '/hipstershop.ShippingService/CancelShipment',
+        request_serializer=demo__pb2.CancelShipmentRequest.SerializeToString,
+        response_deserializer=demo__pb2.CancelShipmentResponse.FromString,
+        )
+    self.ValidateAddress = channel.unary_unary(
+        '/hipstershop.ShippingService/ValidateAddress',
+        request_serializer=demo__pb2.ValidateAddressRequest.SerializeToString,
+        response_deserializer=demo__pb2.ValidateAddressResponse.FromString,
+        )
+    self.CreateReturn = channel.unary_unary(
+        '/hipstershop.ShippingService/CreateReturn',
+        request_serializer=demo__pb2.CreateReturnRequest.SerializeToString,
+        response_deserializer=demo__pb2.Return.FromString,
+        )
+    self.GetReturn = channel.unary_unary(
+        '/hipstershop.ShippingService/GetReturn',
+        request_serializer=demo__pb2.GetReturnRequest.SerializeToString,
+        response_deserializer=demo__pb2.Return.FromString,
+        )
+    self.ListPickupPoints = channel.unary_unary(
+        '/hipstershop.ShippingService/ListPickupPoints',
+        request_serializer=demo__pb2.ListPickupPointsRequest.SerializeToString,
+        response_deserializer=demo__pb2.ListPickupPointsResponse.FromString,
+        )
+    self.GetPickupPoint = channel.unary_unary(
+        '/hipstershop.ShippingService/GetPickupPoint',
+        request_serializer=demo__pb2.GetPickupPointRequest.SerializeToString,
+        response_deserializer=demo__pb2.PickupPoint.FromString,
+        )
 
 
 class ShippingServiceServicer(object):
@@ -246,6 +291,70 @@ class ShippingServiceServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def ListShippingOptions(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetShipment(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def WatchShipment(self, request, context):
+    """WatchShipment streams the status changes of a shipment, starting with
+    those so far, until it is delivered.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def CancelShipment(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ValidateAddress(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def CreateReturn(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetReturn(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListPickupPoints(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetPickupPoint(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_ShippingServiceServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -259,6 +368,51 @@ def add_ShippingServiceServicer_to_server(servicer, server):
           request_deserializer=demo__pb2.ShipOrderRequest.FromString,
           response_serializer=demo__pb2.ShipOrderResponse.SerializeToString,
       ),
+      'ListShippingOptions': grpc.unary_unary_rpc_method_handler(
+          servicer.ListShippingOptions,
+          request_deserializer=demo__pb2.ListShippingOptionsRequest.FromString,
+          response_serializer=demo__pb2.ListShippingOptionsResponse.SerializeToString,
+      ),
+      'GetShipment': grpc.unary_unary_rpc_method_handler(
+          servicer.GetShipment,
+          request_deserializer=demo__pb2.GetShipmentRequest.FromString,
+          response_serializer=demo__pb2.Shipment.SerializeToString,
+      ),
+      'WatchShipment': grpc.unary_stream_rpc_method_handler(
+          servicer.WatchShipment,
+          request_deserializer=demo__pb2.WatchShipmentRequest.FromString,
+          response_serializer=demo__pb2.ShipmentEvent.SerializeToString,
+      ),
+      'CancelShipment': grpc.unary_unary_rpc_method_handler(
+          servicer.CancelShipment,
+          request_deserializer=demo__pb2.CancelShipmentRequest.FromString,
+          response_serializer=demo__pb2.CancelShipmentResponse.SerializeToString,
+      ),
+      'ValidateAddress': grpc.unary_unary_rpc_method_handler(
+          servicer.ValidateAddress,
+          request_deserializer=demo__pb2.ValidateAddressRequest.FromString,
+          response_serializer=demo__pb2.ValidateAddressResponse.SerializeToString,
+      ),
+      'CreateReturn': grpc.unary_unary_rpc_method_handler(
+          servicer.CreateReturn,
+          request_deserializer=demo__pb2.CreateReturnRequest.FromString,
+          response_serializer=demo__pb2.Return.SerializeToString,
+      ),
+      'GetReturn': grpc.unary_unary_rpc_method_handler(
+          servicer.GetReturn,
+          request_deserializer=demo__pb2.GetReturnRequest.FromString,
+          response_serializer=demo__pb2.Return.SerializeToString,
+      ),
+      'ListPickupPoints': grpc.unary_unary_rpc_method_handler(
+          servicer.ListPickupPoints,
+          request_deserializer=demo__pb2.ListPickupPointsRequest.FromString,
+          response_serializer=demo__pb2.ListPickupPointsResponse.SerializeToString,
+      ),
+      'GetPickupPoint': grpc.unary_unary_rpc_method_handler(
+          servicer.GetPickupPoint,
+          request_deserializer=demo__pb2.GetPickupPointRequest.FromString,
+          response_serializer=demo__pb2.PickupPoint.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'hipstershop.ShippingService', rpc_method_handlers)
@@ -342,6 +496,11 @@ class PaymentServiceStub(object):
         request_serializer=demo__pb2.ChargeRequest.SerializeToString,
         response_deserializer=demo__pb2.ChargeResponse.FromString,
         )
+    self.Refund = channel.unary_unary(
+        '/hipstershop.PaymentService/Refund',
+        request_serializer=demo__pb2.RefundRequest.SerializeToString,
+        response_deserializer=demo__pb2.RefundResponse.FromString,
+        )
 
 
 class PaymentServiceServicer(object):
@@ -356,6 +515,13 @@ class PaymentServiceServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def Refund(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_PaymentServiceServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -364,6 +530,11 @@ def add_PaymentServiceServicer_to_server(servicer, server):
           request_deserializer=demo__pb2.ChargeRequest.FromString,
           response_serializer=demo__pb2.ChargeResponse.SerializeToString,
       ),
+      'Refund': grpc.unary_unary_rpc_method_handler(
+          servicer.Refund,
+          request_deserializer=demo__pb2.RefundRequest.FromString,
+          response_serializer=demo__pb2.RefundResponse.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'hipstershop.PaymentService', rpc_method_handlers)
@@ -425,16 +596,31 @@ class CheckoutServiceStub(object):
     Args:
       channel: A grpc.Channel.
     """
-    self.CreateOrder = channel.unary_unary(
-        '/hipstershop.CheckoutService/CreateOrder',
-        request_serializer=demo__pb2.CreateOrderRequest.SerializeToString,
-        response_deserializer=demo__pb2.CreateOrderResponse.FromString,
-        )
     self.PlaceOrder = channel.unary_unary(
         '/hipstershop.CheckoutService/PlaceOrder',
         request_serializer=demo__pb2.PlaceOrderRequest.SerializeToString,
         response_deserializer=demo__pb2.PlaceOrderResponse.FromString,
         )
+    self.RefundReturn = channel.unary_unary(
+        '/hipstershop.CheckoutService/RefundReturn',
+        request_serializer=demo__pb2.RefundReturnRequest.SerializeToString,
+        response_deserializer=demo__pb2.RefundReturnResponse.FromString,
+        )
+    self.GetOrder = channel.unary_unary(
+        '/hipstershop.CheckoutService/GetOrder',
+        request_serializer=demo__pb2.GetOrderRequest.SerializeToString,
+        response_deserializer=demo__pb2.Order.FromString,
+        )
+    self.ListOrders = channel.unary_unary(
+        '/hipstershop.CheckoutService/ListOrders',
+        request_serializer=demo__pb2.ListOrdersRequest.SerializeToString,
+        response_deserializer=demo__pb2.ListOrdersResponse.FromString,
+        )
+    self.QuoteTaxes = channel.unary_unary(
+        '/hipstershop.CheckoutService/QuoteTaxes',
+        request_serializer=demo__pb2.QuoteTaxesRequest.SerializeToString,
+        response_deserializer=demo__pb2.QuoteTaxesResponse.FromString,
+        )
 
 
 class CheckoutServiceServicer(object):
@@ -442,14 +628,35 @@ class CheckoutServiceServicer(object):
 
   """
 
-  def CreateOrder(self, request, context):
+  def PlaceOrder(self, request, context):
     # missing associated documentation comment in .proto file
     pass
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
-  def PlaceOrder(self, request, context):
+  def RefundReturn(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetOrder(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListOrders(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def QuoteTaxes(self, request, context):
     # missing associated documentation comment in .proto file
     pass
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
@@ -459,17 +666,76 @@ class CheckoutServiceServicer(object):
 
 def add_CheckoutServiceServicer_to_server(servicer, server):
   rpc_method_handlers = {
-      'CreateOrder': grpc.unary_unary_rpc_method_handler(
-          servicer.CreateOrder,
-          request_deserializer=demo__pb2.CreateOrderRequest.FromString,
-          response_serializer=demo__pb2.CreateOrderResponse.SerializeToString,
-      ),
       'PlaceOrder': grpc.unary_unary_rpc_method_handler(
           servicer.PlaceOrder,
           request_deserializer=demo__pb2.PlaceOrderRequest.FromString,
           response_serializer=demo__pb2.PlaceOrderResponse.SerializeToString,
       ),
+      'RefundReturn': grpc.unary_unary_rpc_method_handler(
+          servicer.RefundReturn,
+          request_deserializer=demo__pb2.RefundReturnRequest.FromString,
+          response_serializer=demo__pb2.RefundReturnResponse.SerializeToString,
+      ),
+      'GetOrder': grpc.unary_unary_rpc_method_handler(
+          servicer.GetOrder,
+          request_deserializer=demo__pb2.GetOrderRequest.FromString,
+          response_serializer=demo__pb2.Order.SerializeToString,
+      ),
+      'ListOrders': grpc.unary_unary_rpc_method_handler(
+          servicer.ListOrders,
+          request_deserializer=demo__pb2.ListOrdersRequest.FromString,
+          response_serializer=demo__pb2.ListOrdersResponse.SerializeToString,
+      ),
+      'QuoteTaxes': grpc.unary_unary_rpc_method_handler(
+          servicer.QuoteTaxes,
+          request_deserializer=demo__pb2.QuoteTaxesRequest.FromString,
+          response_serializer=demo__pb2.QuoteTaxesResponse.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'hipstershop.CheckoutService', rpc_method_handlers)
   server.add_generic_rpc_handlers((generic_handler,))
+
+
+class AdServiceStub(object):
+  """------------Ad service------------------
+
+  """
+
+  def __init__(self, channel):
+    """Constructor.
+
+    Args:
+      channel: A grpc.Channel.
+    """
+    self.GetAds = channel.unary_unary(
+        '/hipstershop.AdService/GetAds',
+        request_serializer=demo__pb2.AdRequest.SerializeToString,
+        response_deserializer=demo__pb2.AdResponse.FromString,
+        )
+
+
+class AdServiceServicer(object):
+  """------------Ad service------------------
+
+  """
+
+  def GetAds(self, request, context):
+    # missing associated documentation comment in .proto file
+    pass
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+
+def add_AdServiceServicer_to_server(servicer, server):
+  rpc_method_handlers = {
+      'GetAds': grpc.unary_unary_rpc_method_handler(
+          servicer.GetAds,
+          request_deserializer=demo__pb2.AdRequest.FromString,
+          response_serializer=demo__pb2.AdResponse.SerializeToString,
+      ),
+  }
+  generic_handler = grpc.method_handlers_generic_handler(
+      'hipstershop.AdService', rpc_method_handlers)
+  server.add_generic_rpc_handlers((generic_handler,))
@@ -11,12 +11,17 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/abruneau/hipstershop/src/frontend/genproto"
 	"github.com/abruneau/hipstershop/src/frontend/money"
+	"github.com/abruneau/hipstershop/src/frontend/tracking"
 )
 
 type platformDetails struct {
@@ -27,7 +32,8 @@ type platformDetails struct {
 var (
 	templates = template.Must(template.New("").
 			Funcs(template.FuncMap{
-			"renderMoney": renderMoney,
+			"renderMoney":          renderMoney,
+			"renderShipmentStatus": renderShipmentStatus,
 		}).ParseGlob("templates/*.html"))
 	plat platformDetails
 )
@@ -203,6 +209,12 @@ func (fe *frontendServer) emptyCartHandler(w http.ResponseWriter, r *http.Reques
 func (fe *frontendServer) viewCartHandler(w http.ResponseWriter, r *http.Request) {
 	log := r.Context().Value(ctxKeyLog{}).(logrus.FieldLogger)
 	log.Debug("view user cart")
+	fe.renderCart(log, r, w, defaultCheckoutForm(), nil)
+}
+
+// renderCart shows the cart with the checkout form filled in with form. The
+// fields of an order rejected as invalid are highlighted with their errors.
+func (fe *frontendServer) renderCart(log logrus.FieldLogger, r *http.Request, w http.ResponseWriter, form, fieldErrors map[string]string) {
 	currencies, err := fe.getCurrencies(r.Context())
 	if err != nil {
 		renderHTTPError(log, r, w, errors.Wrap(err, "could not retrieve currencies"), http.StatusInternalServerError)
@@ -220,12 +232,6 @@ func (fe *frontendServer) viewCartHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	shippingCost, err := fe.getShippingQuote(r.Context(), cart, currentCurrency(r))
-	if err != nil {
-		renderHTTPError(log, r, w, errors.Wrap(err, "failed to get shipping quote"), http.StatusInternalServerError)
-		return
-	}
-
 	type cartItemView struct {
 		Item     *pb.Product
 		Quantity int32
@@ -252,23 +258,75 @@ func (fe *frontendServer) viewCartHandler(w http.ResponseWriter, r *http.Request
 			Price:    &multPrice}
 		totalPrice = money.Must(money.Sum(totalPrice, multPrice))
 	}
-	totalPrice = money.Must(money.Sum(totalPrice, *shippingCost))
+
+	var (
+		shippingQuote   *pb.GetQuoteResponse
+		shippingOptions []*pb.ShippingOption
+		pickupPoints    []*pb.PickupPoint
+		taxes           []*pb.TaxLine
+		totalWithDuties *pb.Money
+	)
+	// An empty cart has nothing to ship, so it is neither quoted nor taxed.
+	if len(cart) > 0 {
+		// Shipping is quoted on the subtotal, which may qualify for free shipping.
+		subtotal := totalPrice
+		shippingQuote, err = fe.getShippingQuote(r.Context(), cart, &subtotal)
+		if err != nil {
+			renderHTTPError(log, r, w, errors.Wrap(err, "failed to get shipping quote"), http.StatusInternalServerError)
+			return
+		}
+		shippingOptions, err = fe.getShippingOptions(r.Context(), cart, &subtotal)
+		if err != nil {
+			renderHTTPError(log, r, w, errors.Wrap(err, "failed to get shipping options"), http.StatusInternalServerError)
+			return
+		}
+		// Pickup points are optional: home delivery is offered without them.
+		pickupPoints, err = fe.getPickupPoints(r.Context())
+		if err != nil {
+			log.WithField("error", err).Warn("failed to get pickup points")
+		}
+		taxes, err = fe.quoteTaxes(r.Context(), cart, currentCurrency(r), shippingQuote)
+		if err != nil {
+			renderHTTPError(log, r, w, errors.Wrap(err, "failed to quote taxes"), http.StatusInternalServerError)
+			return
+		}
+		totalPrice = money.Must(money.Sum(totalPrice, *shippingQuote.GetCost()))
+		totalPrice = money.Must(money.Sum(totalPrice, addedTaxes(taxes, currentCurrency(r))))
+		// Cross-border orders cost more when duties are paid with the order (DDP).
+		if duties := shippingQuote.GetDuties(); duties != nil {
+			total := money.Must(money.Sum(totalPrice, *duties.GetTotal()))
+			totalWithDuties = &total
+		}
+	}
 
 	year := time.Now().Year()
+	if len(fieldErrors) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+	}
 	if err := templates.ExecuteTemplate(w, "cart", map[string]interface{}{
-		"session_id":       sessionID(r),
-		"request_id":       r.Context().Value(ctxKeyRequestID{}),
-		"user_currency":    currentCurrency(r),
-		"currencies":       currencies,
-		"recommendations":  recommendations,
-		"cart_size":        cartSize(cart),
-		"shipping_cost":    shippingCost,
-		"show_currency":    true,
-		"total_cost":       totalPrice,
-		"items":            items,
-		"expiration_years": []int{year, year + 1, year + 2, year + 3, year + 4},
-		"platform_css":     plat.css,
-		"platform_name":    plat.provider,
+		"session_id":         sessionID(r),
+		"request_id":         r.Context().Value(ctxKeyRequestID{}),
+		"user_currency":      currentCurrency(r),
+		"currencies":         currencies,
+		"recommendations":    recommendations,
+		"cart_size":          cartSize(cart),
+		"shipping_cost":      shippingQuote.GetCost(),
+		"shipping_promotion": shippingQuote.GetPromotion(),
+		"shipping_options":   shippingOptions,
+		"pickup_points":      pickupPoints,
+		"duties":             shippingQuote.GetDuties(),
+		"taxes":              taxes,
+		"show_currency":      true,
+		"total_cost":         totalPrice,
+		"total_with_duties":  totalWithDuties,
+		"items":              items,
+		"expiration_months":  expirationMonths,
+		"expiration_years":   []string{strconv.Itoa(year), strconv.Itoa(year + 1), strconv.Itoa(year + 2), strconv.Itoa(year + 3), strconv.Itoa(year + 4)},
+		"form":               form,
+		"field_errors":       fieldErrors,
+		"idempotency_key":    uuid.New().String(),
+		"platform_css":       plat.css,
+		"platform_name":      plat.provider,
 	}); err != nil {
 		log.Println(err)
 	}
@@ -281,7 +339,7 @@ func (fe *frontendServer) placeOrderHandler(w http.ResponseWriter, r *http.Reque
 	var (
 		email         = r.FormValue("email")
 		streetAddress = r.FormValue("street_address")
-		zipCode, _    = strconv.ParseInt(r.FormValue("zip_code"), 10, 32)
+		postalCode    = strings.TrimSpace(r.FormValue("zip_code"))
 		city          = r.FormValue("city")
 		state         = r.FormValue("state")
 		country       = r.FormValue("country")
@@ -289,7 +347,17 @@ func (fe *frontendServer) placeOrderHandler(w http.ResponseWriter, r *http.Reque
 		ccMonth, _    = strconv.ParseInt(r.FormValue("credit_card_expiration_month"), 10, 32)
 		ccYear, _     = strconv.ParseInt(r.FormValue("credit_card_expiration_year"), 10, 32)
 		ccCVV, _      = strconv.ParseInt(r.FormValue("credit_card_cvv"), 10, 32)
+		shipping      = r.FormValue("shipping_option_id")
+		promoCode     = strings.TrimSpace(r.FormValue("shipping_promo_code"))
+		itemsPromo    = strings.TrimSpace(r.FormValue("promo_code"))
+		prepayDuties  = r.FormValue("duties") == "ddp"
+		pickupPointID = r.FormValue("pickup_point_id")
+		// The key is drawn when the cart is shown, so that the same order
+		// submitted twice is placed once.
+		idempotencyKey = r.FormValue("idempotency_key")
 	)
+	// Postal codes may contain letters; only numeric ones have a zip code.
+	zipCode, _ := strconv.ParseInt(postalCode, 10, 32)
 
 	order, err := pb.NewCheckoutServiceClient(fe.checkoutSvcConn).
 		PlaceOrder(r.Context(), &pb.PlaceOrderRequest{
@@ -306,20 +374,137 @@ func (fe *frontendServer) placeOrderHandler(w http.ResponseWriter, r *http.Reque
 				City:          city,
 				State:         state,
 				ZipCode:       int32(zipCode),
+				PostalCode:    postalCode,
 				Country:       country},
+			ShippingOptionId:  shipping,
+			ShippingPromoCode: promoCode,
+			PrepayDuties:      prepayDuties,
+			PickupPointId:     pickupPointID,
+			IdempotencyKey:    idempotencyKey,
+			PromoCode:         itemsPromo,
 		})
+	// Invalid fields and declined cards are shown on the checkout form.
+	if fieldErrors := checkoutFieldErrors(err); len(fieldErrors) > 0 {
+		log.WithField("error", err).Warn("invalid order")
+		form := make(map[string]string)
+		for field := range defaultCheckoutForm() {
+			form[field] = r.FormValue(field)
+		}
+		fe.renderCart(log, r, w, form, fieldErrors)
+		return
+	}
+	if status.Code(err) == codes.FailedPrecondition {
+		fe.renderRestrictedItems(log, r, w, err)
+		return
+	}
+	if errorReason(err) == reasonProductNotFound {
+		fe.renderMissingProduct(log, r, w, err)
+		return
+	}
 	if err != nil {
-		renderHTTPError(log, r, w, errors.Wrap(err, "failed to complete the order"), http.StatusInternalServerError)
+		renderHTTPError(log, r, w, errors.Wrap(err, "failed to complete the order"), orderErrorStatus(err))
 		return
 	}
 	log.WithField("order", order.GetOrder().GetOrderId()).Info("order placed")
 
-	order.GetOrder().GetItems()
-	recommendations, _ := fe.getRecommendations(r.Context(), sessionID(r), nil)
-
 	totalPaid := *order.GetOrder().GetShippingCost()
 	for _, v := range order.GetOrder().GetItems() {
-		totalPaid = money.Must(money.Sum(totalPaid, *v.GetCost()))
+		cost := money.MultiplySlow(*v.GetCost(), uint32(v.GetItem().GetQuantity()))
+		totalPaid = money.Must(money.Sum(totalPaid, cost))
+	}
+	for _, d := range order.GetOrder().GetDiscounts() {
+		totalPaid = money.Must(money.Sum(totalPaid, money.Negate(*d.GetAmount())))
+	}
+	totalPaid = money.Must(money.Sum(totalPaid, addedTaxes(order.GetOrder().GetTaxes(), totalPaid.GetCurrencyCode())))
+	if order.GetOrder().GetDutiesPrepaid() {
+		totalPaid = money.Must(money.Sum(totalPaid, *order.GetOrder().GetDuties().GetTotal()))
+	}
+	fe.renderOrder(log, r, w, order.GetOrder(), &totalPaid, true)
+}
+
+// ordersHandler lists the past orders of the user, one page at a time.
+func (fe *frontendServer) ordersHandler(w http.ResponseWriter, r *http.Request) {
+	log := r.Context().Value(ctxKeyLog{}).(logrus.FieldLogger)
+	log.Debug("listing orders")
+
+	orders, err := fe.listOrders(r.Context(), sessionID(r), r.FormValue("page"))
+	if status.Code(err) == codes.InvalidArgument {
+		renderHTTPError(log, r, w, errors.Wrap(err, "invalid page"), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		renderHTTPError(log, r, w, errors.Wrap(err, "could not retrieve orders"), http.StatusInternalServerError)
+		return
+	}
+	currencies, err := fe.getCurrencies(r.Context())
+	if err != nil {
+		renderHTTPError(log, r, w, errors.Wrap(err, "could not retrieve currencies"), http.StatusInternalServerError)
+		return
+	}
+	cart, err := fe.getCart(r.Context(), sessionID(r))
+	if err != nil {
+		renderHTTPError(log, r, w, errors.Wrap(err, "could not retrieve cart"), http.StatusInternalServerError)
+		return
+	}
+
+	if err := templates.ExecuteTemplate(w, "orders", map[string]interface{}{
+		"session_id":    sessionID(r),
+		"request_id":    r.Context().Value(ctxKeyRequestID{}),
+		"user_currency": currentCurrency(r),
+		"show_currency": false,
+		"currencies":    currencies,
+		"cart_size":     cartSize(cart),
+		"orders":        orders.GetOrders(),
+		"next_page":     orders.GetNextPageToken(),
+		"platform_css":  plat.css,
+		"platform_name": plat.provider,
+	}); err != nil {
+		log.Println(err)
+	}
+}
+
+// orderHandler shows a past order of the user.
+func (fe *frontendServer) orderHandler(w http.ResponseWriter, r *http.Request) {
+	log := r.Context().Value(ctxKeyLog{}).(logrus.FieldLogger)
+	id := mux.Vars(r)["id"]
+	log.WithField("order", id).Debug("showing order")
+
+	order, err := fe.getOrder(r.Context(), id)
+	// Orders of other users, and orders not placed, are not shown.
+	if status.Code(err) == codes.NotFound || (err == nil &&
+		(order.GetUserId() != sessionID(r) || order.GetStatus() != pb.OrderStatus_ORDER_PLACED)) {
+		renderHTTPError(log, r, w, errors.Errorf("unknown order %q", id), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		renderHTTPError(log, r, w, errors.Wrap(err, "could not retrieve order"), http.StatusInternalServerError)
+		return
+	}
+	fe.renderOrder(log, r, w, order.GetResult(), order.GetTotalPaid(), false)
+}
+
+// renderOrder shows an order, right after it is placed or later on.
+func (fe *frontendServer) renderOrder(log logrus.FieldLogger, r *http.Request, w http.ResponseWriter, order *pb.OrderResult, totalPaid *pb.Money, placed bool) {
+	recommendations, _ := fe.getRecommendations(r.Context(), sessionID(r), nil)
+	// Parcels list their items by name, or by ID if the catalog fails.
+	type parcelItemView struct {
+		Name     string
+		Quantity int32
+	}
+	type parcelView struct {
+		*pb.ShippedParcel
+		Contents []parcelItemView
+	}
+	parcels := make([]parcelView, len(order.GetParcels()))
+	for i, p := range order.GetParcels() {
+		parcels[i].ShippedParcel = p
+		for _, item := range p.GetItems() {
+			name := item.GetProductId()
+			if product, err := fe.getProduct(r.Context(), item.GetProductId()); err == nil {
+				name = product.GetName()
+			}
+			parcels[i].Contents = append(parcels[i].Contents, parcelItemView{Name: name, Quantity: item.GetQuantity()})
+		}
 	}
 
 	currencies, err := fe.getCurrencies(r.Context())
@@ -334,8 +519,10 @@ func (fe *frontendServer) placeOrderHandler(w http.ResponseWriter, r *http.Reque
 		"user_currency":   currentCurrency(r),
 		"show_currency":   false,
 		"currencies":      currencies,
-		"order":           order.GetOrder(),
-		"total_paid":      &totalPaid,
+		"order":           order,
+		"placed":          placed,
+		"parcels":         parcels,
+		"total_paid":      totalPaid,
 		"recommendations": recommendations,
 		"platform_css":    plat.css,
 		"platform_name":   plat.provider,
@@ -344,6 +531,49 @@ func (fe *frontendServer) placeOrderHandler(w http.ResponseWriter, r *http.Reque
 	}
 }
 
+func (fe *frontendServer) trackingHandler(w http.ResponseWriter, r *http.Request) {
+	log := r.Context().Value(ctxKeyLog{}).(logrus.FieldLogger)
+	id := mux.Vars(r)["id"]
+	if id == "" {
+		id = strings.ToUpper(strings.TrimSpace(r.FormValue("id")))
+	}
+	log.WithField("tracking_id", id).Debug("tracking shipment")
+
+	// Reject typos before calling the shipping service.
+	if err := tracking.ValidateTrackingID(id); err != nil {
+		renderHTTPError(log, r, w, errors.Wrapf(err, "invalid tracking ID %q", id), http.StatusBadRequest)
+		return
+	}
+
+	shipment, err := fe.getShipment(r.Context(), id)
+	if status.Code(err) == codes.NotFound {
+		renderHTTPError(log, r, w, errors.Wrap(err, "unknown tracking ID"), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		renderHTTPError(log, r, w, errors.Wrap(err, "could not retrieve shipment"), http.StatusInternalServerError)
+		return
+	}
+	cart, err := fe.getCart(r.Context(), sessionID(r))
+	if err != nil {
+		renderHTTPError(log, r, w, errors.Wrap(err, "could not retrieve cart"), http.StatusInternalServerError)
+		return
+	}
+
+	if err := templates.ExecuteTemplate(w, "tracking", map[string]interface{}{
+		"session_id":    sessionID(r),
+		"request_id":    r.Context().Value(ctxKeyRequestID{}),
+		"user_currency": currentCurrency(r),
+		"show_currency": false,
+		"cart_size":     cartSize(cart),
+		"shipment":      shipment,
+		"platform_css":  plat.css,
+		"platform_name": plat.provider,
+	}); err != nil {
+		log.Println(err)
+	}
+}
+
 func (fe *frontendServer) logoutHandler(w http.ResponseWriter, r *http.Request) {
 	log := r.Context().Value(ctxKeyLog{}).(logrus.FieldLogger)
 	log.Debug("logging out")
@@ -401,6 +631,170 @@ func renderHTTPError(log logrus.FieldLogger, r *http.Request, w http.ResponseWri
 		"status":      http.StatusText(code)})
 }
 
+// renderMissingProduct explains that a product of the cart is no longer in
+// the catalog, from the ResourceInfo detail of a NotFound error.
+func (fe *frontendServer) renderMissingProduct(log logrus.FieldLogger, r *http.Request, w http.ResponseWriter, err error) {
+	product := "A product"
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ResourceInfo); ok && info.GetResourceType() == "product" {
+			product = fmt.Sprintf("Product %q", info.GetResourceName())
+		}
+	}
+	renderHTTPError(log, r, w, errors.Errorf("%s of your cart is no longer available: empty the cart and add the other items again", product), http.StatusConflict)
+}
+
+// renderRestrictedItems explains why items of the order cannot be shipped to
+// the address, from the details of a FailedPrecondition error.
+func (fe *frontendServer) renderRestrictedItems(log logrus.FieldLogger, r *http.Request, w http.ResponseWriter, err error) {
+	log.WithField("error", err).Warn("order has restricted items")
+	type restrictedItemView struct {
+		Name   string
+		Reason string
+	}
+	var items []restrictedItemView
+	for _, d := range status.Convert(err).Details() {
+		failure, ok := d.(*errdetails.PreconditionFailure)
+		if !ok {
+			continue
+		}
+		for _, v := range failure.GetViolations() {
+			name := v.GetSubject()
+			if product, err := fe.getProduct(r.Context(), v.GetSubject()); err == nil {
+				name = product.GetName()
+			}
+			items = append(items, restrictedItemView{Name: name, Reason: v.GetDescription()})
+		}
+	}
+
+	code := http.StatusUnprocessableEntity
+	w.WriteHeader(code)
+	templates.ExecuteTemplate(w, "error", map[string]interface{}{
+		"session_id":       sessionID(r),
+		"request_id":       r.Context().Value(ctxKeyRequestID{}),
+		"error":            status.Convert(err).Message(),
+		"restricted_items": items,
+		"status_code":      code,
+		"status":           http.StatusText(code)})
+}
+
+// checkoutInputs maps the fields of a PlaceOrderRequest to the inputs of the
+// checkout form that set them.
+var checkoutInputs = map[string]string{
+	"email":                          "email",
+	"address":                        "street_address",
+	"address.street_address":         "street_address",
+	"address.city":                   "city",
+	"address.state":                  "state",
+	"address.country":                "country",
+	"address.postal_code":            "zip_code",
+	"address.zip_code":               "zip_code",
+	"credit_card":                    "credit_card_number",
+	"credit_card.credit_card_number": "credit_card_number",
+	"credit_card.credit_card_expiration_month": "credit_card_expiration_month",
+	"credit_card.credit_card_expiration_year":  "credit_card_expiration_year",
+	"credit_card.credit_card_cvv":              "credit_card_cvv",
+	"shipping_option_id":                       "shipping_option_id",
+	"pickup_point_id":                          "pickup_point_id",
+	"promo_code":                               "promo_code",
+	"shipping_promo_code":                      "shipping_promo_code",
+}
+
+type expirationMonth struct {
+	Value string
+	Name  string
+}
+
+var expirationMonths = []expirationMonth{
+	{"1", "January"}, {"2", "February"}, {"3", "March"}, {"4", "April"},
+	{"5", "May"}, {"6", "June"}, {"7", "July"}, {"8", "August"},
+	{"9", "September"}, {"10", "October"}, {"11", "November"}, {"12", "December"},
+}
+
+// defaultCheckoutForm fills in the checkout form of the cart page.
+func defaultCheckoutForm() map[string]string {
+	return map[string]string{
+		"email":                        "someone@example.com",
+		"street_address":               "1600 Amphitheatre Parkway",
+		"zip_code":                     "94043",
+		"city":                         "Mountain View",
+		"state":                        "CA",
+		"country":                      "United States",
+		"shipping_option_id":           "",
+		"pickup_point_id":              "",
+		"promo_code":                   "",
+		"shipping_promo_code":          "",
+		"duties":                       "ddu",
+		"credit_card_number":           "4432-8015-6152-0454",
+		"credit_card_expiration_month": "1",
+		"credit_card_expiration_year":  strconv.Itoa(time.Now().Year() + 1),
+		"credit_card_cvv":              "672",
+	}
+}
+
+// checkoutFieldErrors returns the errors of the checkout form inputs, from
+// the BadRequest details of an InvalidArgument error. Errors of fields that
+// no input sets are returned under "". Declined cards are errors of the card
+// number.
+func checkoutFieldErrors(err error) map[string]string {
+	if errorReason(err) == reasonPaymentDeclined {
+		return map[string]string{"credit_card_number": status.Convert(err).Message()}
+	}
+	if status.Code(err) != codes.InvalidArgument {
+		return nil
+	}
+	out := make(map[string]string)
+	for _, d := range status.Convert(err).Details() {
+		req, ok := d.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, v := range req.GetFieldViolations() {
+			input := checkoutInputs[v.GetField()]
+			if out[input] != "" {
+				out[input] += "; "
+			}
+			out[input] += v.GetDescription()
+		}
+	}
+	return out
+}
+
+// Reasons of the ErrorInfo details of checkout errors.
+const (
+	reasonPaymentDeclined = "PAYMENT_DECLINED"
+	reasonProductNotFound = "PRODUCT_NOT_FOUND"
+)
+
+// errorReason returns the reason of the ErrorInfo detail of an error, if any.
+func errorReason(err error) string {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+// orderErrorStatus returns the HTTP status of an order that failed with err.
+func orderErrorStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition:
+		return http.StatusUnprocessableEntity
+	case codes.Aborted, codes.ResourceExhausted:
+		// The same order was submitted again while being placed, or a
+		// promotion was used up.
+		return http.StatusConflict
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 func currentCurrency(r *http.Request) string {
 	c, _ := r.Cookie(cookieCurrency)
 	if c != nil {
@@ -434,6 +828,35 @@ func cartSize(c []*pb.CartItem) int {
 	return cartSize
 }
 
+// addedTaxes sums the taxes that are added to the total, rather than included
+// in the prices.
+func addedTaxes(taxes []*pb.TaxLine, currency string) pb.Money {
+	total := pb.Money{CurrencyCode: currency}
+	for _, t := range taxes {
+		if !t.GetIncluded() {
+			total = money.Must(money.Sum(total, *t.GetAmount()))
+		}
+	}
+	return total
+}
+
 func renderMoney(money pb.Money) string {
 	return fmt.Sprintf("%s %d.%02d", money.GetCurrencyCode(), money.GetUnits(), money.GetNanos()/10000000)
 }
+
+func renderShipmentStatus(s pb.ShipmentStatus) string {
+	switch s {
+	case pb.ShipmentStatus_LABEL_CREATED:
+		return "Label created"
+	case pb.ShipmentStatus_IN_TRANSIT:
+		return "In transit"
+	case pb.ShipmentStatus_OUT_FOR_DELIVERY:
+		return "Out for delivery"
+	case pb.ShipmentStatus_DELIVERED:
+		return "Delivered"
+	case pb.ShipmentStatus_CANCELED:
+		return "Canceled"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkoutError returns an error of checkout with details.
+func checkoutError(t *testing.T, code codes.Code, msg string, details ...proto.Message) error {
+	st, err := status.New(code, msg).WithDetails(details...)
+	if err != nil {
+		t.Fatalf("WithDetails failed: %v", err)
+	}
+	return st.Err()
+}
+
+func violation(field, description string) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{Field: field, Description: description}
+}
+
+func TestOrderErrorStatus(t *testing.T) {
+	for code, want := range map[codes.Code]int{
+		codes.InvalidArgument:    http.StatusBadRequest,
+		codes.NotFound:           http.StatusNotFound,
+		codes.FailedPrecondition: http.StatusUnprocessableEntity,
+		codes.Aborted:            http.StatusConflict,
+		codes.ResourceExhausted:  http.StatusConflict,
+		codes.Unavailable:        http.StatusServiceUnavailable,
+		codes.DeadlineExceeded:   http.StatusServiceUnavailable,
+		codes.Internal:           http.StatusInternalServerError,
+		codes.Unknown:            http.StatusInternalServerError,
+		codes.OutOfRange:         http.StatusInternalServerError,
+	} {
+		if got := orderErrorStatus(status.Error(code, "failed")); got != want {
+			t.Errorf("orderErrorStatus(%v) = %d, expected %d", code, got, want)
+		}
+	}
+	if got := orderErrorStatus(errors.New("failed")); got != http.StatusInternalServerError {
+		t.Errorf("orderErrorStatus of an error without a code = %d, expected 500", got)
+	}
+}
+
+func TestCheckoutFieldErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want map[string]string
+	}{
+		{"not invalid", status.Error(codes.Unavailable, "no payment service"), nil},
+		{"no details", status.Error(codes.InvalidArgument, "invalid order"), map[string]string{}},
+		{"fields", checkoutError(t, codes.InvalidArgument, "invalid order",
+			&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				violation("email", "invalid email address"),
+				violation("address.zip_code", "invalid zip code"),
+				violation("credit_card.credit_card_cvv", "a CVV has 3 or 4 digits"),
+			}}),
+			map[string]string{
+				"email":           "invalid email address",
+				"zip_code":        "invalid zip code",
+				"credit_card_cvv": "a CVV has 3 or 4 digits",
+			}},
+		{"same input", checkoutError(t, codes.InvalidArgument, "invalid order",
+			&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				violation("credit_card.credit_card_expiration_month", "invalid expiration month 13"),
+				violation("credit_card.credit_card_expiration_month", "the card expired"),
+			}}),
+			map[string]string{"credit_card_expiration_month": "invalid expiration month 13; the card expired"}},
+		{"no input", checkoutError(t, codes.InvalidArgument, "invalid order",
+			&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				violation("user_currency", "invalid currency code"),
+			}}),
+			map[string]string{"": "invalid currency code"}},
+		{"payment declined", checkoutError(t, codes.FailedPrecondition, "payment declined: card expired",
+			&errdetails.ErrorInfo{Reason: reasonPaymentDeclined, Domain: "checkoutservice.hipstershop"}),
+			map[string]string{"credit_card_number": "payment declined: card expired"}},
+		{"other reason", checkoutError(t, codes.NotFound, "no such product",
+			&errdetails.ErrorInfo{Reason: reasonProductNotFound, Domain: "checkoutservice.hipstershop"}),
+			nil},
+	}
+	for _, tt := range tests {
+		if got := checkoutFieldErrors(tt.err); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: checkoutFieldErrors = %v, expected %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestErrorReason(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{checkoutError(t, codes.NotFound, "no such product",
+			&errdetails.BadRequest{}, &errdetails.ErrorInfo{Reason: reasonProductNotFound}), reasonProductNotFound},
+		{status.Error(codes.Internal, "failed"), ""},
+		{errors.New("failed"), ""},
+	}
+	for _, tt := range tests {
+		if got := errorReason(tt.err); got != tt.want {
+			t.Errorf("errorReason(%v) = %q, expected %q", tt.err, got, tt.want)
+		}
+	}
+}
@@ -103,6 +103,10 @@ func main() {
 	r.HandleFunc("/setCurrency", svc.setCurrencyHandler).Methods(http.MethodPost)
 	r.HandleFunc("/logout", svc.logoutHandler).Methods(http.MethodGet)
 	r.HandleFunc("/cart/checkout", svc.placeOrderHandler).Methods(http.MethodPost)
+	r.HandleFunc("/orders", svc.ordersHandler).Methods(http.MethodGet, http.MethodHead)
+	r.HandleFunc("/orders/{id}", svc.orderHandler).Methods(http.MethodGet, http.MethodHead)
+	r.HandleFunc("/tracking", svc.trackingHandler).Methods(http.MethodGet, http.MethodHead)
+	r.HandleFunc("/tracking/{id}", svc.trackingHandler).Methods(http.MethodGet, http.MethodHead)
 	r.PathPrefix("/static/").Handler(http.StripPrefix("/static/", http.FileServer(http.Dir("./static/"))))
 	r.HandleFunc("/robots.txt", func(w http.ResponseWriter, _ *http.Request) { fmt.Fprint(w, "User-agent: *\nDisallow: /") })
 	r.HandleFunc("/_healthz", func(w http.ResponseWriter, _ *http.Request) { fmt.Fprint(w, "ok") })
@@ -13,6 +13,16 @@ const (
 	avoidNoopCurrencyConversionRPC = false
 )
 
+// shippingEstimateAddress is used to quote shipping on the cart page, before
+// the user has entered an address. It matches the checkout form defaults.
+var shippingEstimateAddress = &pb.Address{
+	StreetAddress: "1600 Amphitheatre Parkway",
+	City:          "Mountain View",
+	State:         "CA",
+	Country:       "United States",
+	ZipCode:       94043,
+}
+
 func (fe *frontendServer) getCurrencies(ctx context.Context) ([]string, error) {
 	currs, err := pb.NewCurrencyServiceClient(fe.currencySvcConn).
 		GetSupportedCurrencies(ctx, &pb.Empty{})
@@ -70,16 +80,60 @@ func (fe *frontendServer) convertCurrency(ctx context.Context, money *pb.Money,
 			ToCode: currency})
 }
 
-func (fe *frontendServer) getShippingQuote(ctx context.Context, items []*pb.CartItem, currency string) (*pb.Money, error) {
-	quote, err := pb.NewShippingServiceClient(fe.shippingSvcConn).GetQuote(ctx,
+// getShippingQuote quotes shipping in the currency of the cart subtotal.
+func (fe *frontendServer) getShippingQuote(ctx context.Context, items []*pb.CartItem, subtotal *pb.Money) (*pb.GetQuoteResponse, error) {
+	return pb.NewShippingServiceClient(fe.shippingSvcConn).GetQuote(ctx,
 		&pb.GetQuoteRequest{
-			Address: nil,
-			Items:   items})
-	if err != nil {
-		return nil, err
-	}
-	localized, err := fe.convertCurrency(ctx, quote.GetCostUsd(), currency)
-	return localized, errors.Wrap(err, "failed to convert currency for shipping cost")
+			Address:      shippingEstimateAddress,
+			Items:        items,
+			CurrencyCode: subtotal.GetCurrencyCode(),
+			Subtotal:     subtotal})
+}
+
+func (fe *frontendServer) getShippingOptions(ctx context.Context, items []*pb.CartItem, subtotal *pb.Money) ([]*pb.ShippingOption, error) {
+	resp, err := pb.NewShippingServiceClient(fe.shippingSvcConn).ListShippingOptions(ctx,
+		&pb.ListShippingOptionsRequest{
+			Address:      shippingEstimateAddress,
+			Items:        items,
+			CurrencyCode: subtotal.GetCurrencyCode(),
+			Subtotal:     subtotal})
+	return resp.GetOptions(), err
+}
+
+// getPickupPoints lists the pickup points near the address used to quote
+// shipping on the cart page.
+func (fe *frontendServer) getPickupPoints(ctx context.Context) ([]*pb.PickupPoint, error) {
+	resp, err := pb.NewShippingServiceClient(fe.shippingSvcConn).ListPickupPoints(ctx,
+		&pb.ListPickupPointsRequest{Address: shippingEstimateAddress})
+	return resp.GetPoints(), err
+}
+
+func (fe *frontendServer) getShipment(ctx context.Context, trackingID string) (*pb.Shipment, error) {
+	return pb.NewShippingServiceClient(fe.shippingSvcConn).GetShipment(ctx,
+		&pb.GetShipmentRequest{TrackingId: trackingID})
+}
+
+func (fe *frontendServer) getOrder(ctx context.Context, orderID string) (*pb.Order, error) {
+	return pb.NewCheckoutServiceClient(fe.checkoutSvcConn).GetOrder(ctx,
+		&pb.GetOrderRequest{OrderId: orderID})
+}
+
+func (fe *frontendServer) listOrders(ctx context.Context, userID, pageToken string) (*pb.ListOrdersResponse, error) {
+	return pb.NewCheckoutServiceClient(fe.checkoutSvcConn).ListOrders(ctx,
+		&pb.ListOrdersRequest{UserId: userID, PageToken: pageToken})
+}
+
+// quoteTaxes estimates the taxes of the cart at the address used to quote
+// shipping on the cart page.
+func (fe *frontendServer) quoteTaxes(ctx context.Context, items []*pb.CartItem, currency string, quote *pb.GetQuoteResponse) ([]*pb.TaxLine, error) {
+	resp, err := pb.NewCheckoutServiceClient(fe.checkoutSvcConn).QuoteTaxes(ctx,
+		&pb.QuoteTaxesRequest{
+			Address:      shippingEstimateAddress,
+			UserCurrency: currency,
+			Items:        items,
+			ShippingCost: quote.GetCost(),
+			Duties:       quote.GetDuties()})
+	return resp.GetTaxes(), err
 }
 
 func (fe *frontendServer) getRecommendations(ctx context.Context, userID string, productIDs []string) ([]*pb.Product, error) {
@@ -0,0 +1,74 @@
+package tracking
+
+import "errors"
+
+// Tracking IDs look like "AB-1234567890-K": two letters, ten digits and a
+// check character computed over the letters and digits with the Luhn mod 36
+// algorithm, which catches every single-character typo and most transposed
+// characters.
+const (
+	idLength    = 15
+	digitsCount = 10
+)
+
+const checkAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+var (
+	// ErrMalformed means the ID does not follow the tracking ID format.
+	ErrMalformed = errors.New("malformed tracking ID")
+	// ErrChecksum means the ID has the right format but a wrong check
+	// character, as happens with most typos.
+	ErrChecksum = errors.New("tracking ID check character mismatch")
+)
+
+// ValidateTrackingID checks the format and check character of a tracking ID.
+func ValidateTrackingID(id string) error {
+	if len(id) != idLength || id[2] != '-' || id[idLength-2] != '-' {
+		return ErrMalformed
+	}
+	for i := 0; i < 2; i++ {
+		if id[i] < 'A' || id[i] > 'Z' {
+			return ErrMalformed
+		}
+	}
+	for i := 3; i < 3+digitsCount; i++ {
+		if id[i] < '0' || id[i] > '9' {
+			return ErrMalformed
+		}
+	}
+	check, err := checkCharacter(id[:2] + id[3:3+digitsCount])
+	if err != nil {
+		return ErrMalformed
+	}
+	if id[idLength-1] != check {
+		return ErrChecksum
+	}
+	return nil
+}
+
+// checkCharacter computes the Luhn mod 36 check character of s.
+func checkCharacter(s string) (byte, error) {
+	n := len(checkAlphabet)
+	sum, factor := 0, 2
+	for i := len(s) - 1; i >= 0; i-- {
+		cp := codePoint(s[i])
+		if cp < 0 {
+			return 0, ErrMalformed
+		}
+		addend := factor * cp
+		sum += addend/n + addend%n
+		factor = 3 - factor
+	}
+	return checkAlphabet[(n-sum%n)%n], nil
+}
+
+func codePoint(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10
+	default:
+		return -1
+	}
+}
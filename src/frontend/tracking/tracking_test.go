@@ -0,0 +1,58 @@
+package tracking
+
+import "testing"
+
+// validID is a tracking ID issued by the shipping service.
+const validID = "CP-6344650012-0"
+
+func TestValidateTrackingID(t *testing.T) {
+	valid := validID
+	tests := []struct {
+		name string
+		id   string
+		want error
+	}{
+		{"valid", valid, nil},
+		{"empty", "", ErrMalformed},
+		{"lowercase", "ab" + valid[2:], ErrMalformed},
+		{"missing dash", valid[:2] + "0" + valid[3:], ErrMalformed},
+		{"letter in digits", valid[:5] + "X" + valid[6:], ErrMalformed},
+		{"too long", valid + "0", ErrMalformed},
+	}
+	for _, tt := range tests {
+		if got := ValidateTrackingID(tt.id); got != tt.want {
+			t.Errorf("%s: ValidateTrackingID(%q) = %v, want %v", tt.name, tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestValidateTrackingIDCatchesTypos(t *testing.T) {
+	id := validID
+
+	// Every single-character substitution is caught.
+	for i := 0; i < len(id); i++ {
+		if id[i] == '-' {
+			continue
+		}
+		for _, c := range []byte(checkAlphabet) {
+			if c == id[i] {
+				continue
+			}
+			typo := id[:i] + string(c) + id[i+1:]
+			if ValidateTrackingID(typo) == nil {
+				t.Errorf("ValidateTrackingID(%q) accepted a typo of %q", typo, id)
+			}
+		}
+	}
+
+	// Swapping two different adjacent digits is caught.
+	for i := 3; i < 12; i++ {
+		if id[i] == id[i+1] {
+			continue
+		}
+		swapped := id[:i] + string(id[i+1]) + string(id[i]) + id[i+2:]
+		if ValidateTrackingID(swapped) == nil {
+			t.Errorf("ValidateTrackingID(%q) accepted a transposition of %q", swapped, id)
+		}
+	}
+}
@@ -2,6 +2,8 @@ const cardValidator = require('simple-card-validator');
 const uuid = require('uuid/v4');
 const pino = require('pino');
 
+const { transactions, toNanos } = require('./transactions');
+
 const logger = pino({
   name: 'paymentservice-charge',
   messageKey: 'message',
@@ -13,7 +15,7 @@ const logger = pino({
 class CreditCardError extends Error {
   constructor (message) {
     super(message);
-    this.code = 400; // Invalid argument error
+    this.code = 3; // Invalid argument
   }
 }
 
@@ -65,5 +67,11 @@ module.exports = function charge (request) {
   logger.info(`Transaction processed: ${cardType} ending ${cardNumber.substr(-4)} \
     Amount: ${amount.currency_code}${amount.units}.${amount.nanos}`);
 
-  return { transaction_id: uuid() };
+  const transactionId = uuid();
+  transactions.set(transactionId, {
+    currencyCode: amount.currency_code,
+    charged: toNanos(amount),
+    refunded: 0n
+  });
+  return { transaction_id: transactionId };
 };
\ No newline at end of file
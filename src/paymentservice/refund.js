@@ -0,0 +1,63 @@
+const uuid = require('uuid/v4');
+const pino = require('pino');
+
+const { transactions, toNanos } = require('./transactions');
+
+const logger = pino({
+  name: 'paymentservice-refund',
+  messageKey: 'message',
+  changeLevelName: 'severity',
+  useLevelLabels: true
+});
+
+class RefundError extends Error {
+  constructor (message, code) {
+    super(message);
+    this.code = code;
+  }
+}
+
+class UnknownTransaction extends RefundError {
+  constructor (transactionId) {
+    super(`No transaction ${transactionId} to refund`, 5); // Not found
+  }
+}
+
+class InvalidRefund extends RefundError {
+  constructor (message) {
+    super(message, 3); // Invalid argument
+  }
+}
+
+class RefundExceedsCharge extends RefundError {
+  constructor (transactionId) {
+    super(`Refunds of transaction ${transactionId} would exceed its charge`, 9); // Failed precondition
+  }
+}
+
+/**
+ * (Pretend) refunds part or all of a charged transaction. Refunds of a
+ * transaction never add up to more than its charge.
+ *
+ * @param {*} request
+ * @return refund_id - a random uuid v4.
+ */
+module.exports = function refund (request) {
+  const { transaction_id: transactionId, amount } = request;
+  const transaction = transactions.get(transactionId);
+  if (!transaction) { throw new UnknownTransaction(transactionId); }
+  if (!amount || amount.currency_code !== transaction.currencyCode) {
+    throw new InvalidRefund(`Refunds of transaction ${transactionId} must be in ${transaction.currencyCode}`);
+  }
+
+  const nanos = toNanos(amount);
+  if (nanos < 0n) { throw new InvalidRefund('Refund amount must not be negative'); }
+  if (transaction.refunded + nanos > transaction.charged) { throw new RefundExceedsCharge(transactionId); }
+  transaction.refunded += nanos;
+
+  const refundId = uuid();
+  logger.info(`Refund processed: ${refundId} of transaction ${transactionId} \
+    Amount: ${amount.currency_code}${amount.units}.${amount.nanos}`);
+
+  return { refund_id: refundId };
+};
@@ -0,0 +1,16 @@
+const NANOS_PER_UNIT = 1000000000n;
+
+/**
+ * The transactions charged since the service started, by transaction_id.
+ * Each one holds its currency, and the amounts charged and refunded in nanos.
+ */
+const transactions = new Map();
+
+/**
+ * Converts a Money message to a BigInt number of nanos.
+ */
+function toNanos ({ units, nanos }) {
+  return BigInt(units) * NANOS_PER_UNIT + BigInt(nanos);
+}
+
+module.exports = { transactions, toNanos };
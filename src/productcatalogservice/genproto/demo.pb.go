@@ -25,6 +25,138 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// RateShopping picks a carrier among those quoting an order.
+type RateShopping int32
+
+const (
+	// The carrier with the lowest cost, then the earliest delivery.
+	RateShopping_CHEAPEST RateShopping = 0
+	// The carrier with the earliest delivery, then the lowest cost.
+	RateShopping_FASTEST RateShopping = 1
+)
+
+var RateShopping_name = map[int32]string{
+	0: "CHEAPEST",
+	1: "FASTEST",
+}
+
+var RateShopping_value = map[string]int32{
+	"CHEAPEST": 0,
+	"FASTEST":  1,
+}
+
+func (x RateShopping) String() string {
+	return proto.EnumName(RateShopping_name, int32(x))
+}
+
+func (RateShopping) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{0}
+}
+
+type ShipmentStatus int32
+
+const (
+	ShipmentStatus_SHIPMENT_STATUS_UNSPECIFIED ShipmentStatus = 0
+	ShipmentStatus_LABEL_CREATED               ShipmentStatus = 1
+	ShipmentStatus_IN_TRANSIT                  ShipmentStatus = 2
+	ShipmentStatus_OUT_FOR_DELIVERY            ShipmentStatus = 3
+	ShipmentStatus_DELIVERED                   ShipmentStatus = 4
+	ShipmentStatus_CANCELED                    ShipmentStatus = 5
+)
+
+var ShipmentStatus_name = map[int32]string{
+	0: "SHIPMENT_STATUS_UNSPECIFIED",
+	1: "LABEL_CREATED",
+	2: "IN_TRANSIT",
+	3: "OUT_FOR_DELIVERY",
+	4: "DELIVERED",
+	5: "CANCELED",
+}
+
+var ShipmentStatus_value = map[string]int32{
+	"SHIPMENT_STATUS_UNSPECIFIED": 0,
+	"LABEL_CREATED":               1,
+	"IN_TRANSIT":                  2,
+	"OUT_FOR_DELIVERY":            3,
+	"DELIVERED":                   4,
+	"CANCELED":                    5,
+}
+
+func (x ShipmentStatus) String() string {
+	return proto.EnumName(ShipmentStatus_name, int32(x))
+}
+
+func (ShipmentStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{1}
+}
+
+type ReturnStatus int32
+
+const (
+	ReturnStatus_RETURN_STATUS_UNSPECIFIED ReturnStatus = 0
+	ReturnStatus_RETURN_LABEL_CREATED      ReturnStatus = 1
+	ReturnStatus_RETURN_IN_TRANSIT         ReturnStatus = 2
+	ReturnStatus_RETURN_RECEIVED           ReturnStatus = 3
+	ReturnStatus_RETURN_REFUNDED           ReturnStatus = 4
+)
+
+var ReturnStatus_name = map[int32]string{
+	0: "RETURN_STATUS_UNSPECIFIED",
+	1: "RETURN_LABEL_CREATED",
+	2: "RETURN_IN_TRANSIT",
+	3: "RETURN_RECEIVED",
+	4: "RETURN_REFUNDED",
+}
+
+var ReturnStatus_value = map[string]int32{
+	"RETURN_STATUS_UNSPECIFIED": 0,
+	"RETURN_LABEL_CREATED":      1,
+	"RETURN_IN_TRANSIT":         2,
+	"RETURN_RECEIVED":           3,
+	"RETURN_REFUNDED":           4,
+}
+
+func (x ReturnStatus) String() string {
+	return proto.EnumName(ReturnStatus_name, int32(x))
+}
+
+func (ReturnStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{2}
+}
+
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_STATUS_UNSPECIFIED OrderStatus = 0
+	// The order is being placed.
+	OrderStatus_ORDER_PENDING OrderStatus = 1
+	OrderStatus_ORDER_PLACED  OrderStatus = 2
+	// The order failed, and the steps done were undone.
+	OrderStatus_ORDER_FAILED OrderStatus = 3
+)
+
+var OrderStatus_name = map[int32]string{
+	0: "ORDER_STATUS_UNSPECIFIED",
+	1: "ORDER_PENDING",
+	2: "ORDER_PLACED",
+	3: "ORDER_FAILED",
+}
+
+var OrderStatus_value = map[string]int32{
+	"ORDER_STATUS_UNSPECIFIED": 0,
+	"ORDER_PENDING":            1,
+	"ORDER_PLACED":             2,
+	"ORDER_FAILED":             3,
+}
+
+func (x OrderStatus) String() string {
+	return proto.EnumName(OrderStatus_name, int32(x))
+}
+
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{3}
+}
+
 type CartItem struct {
 	ProductId            string   `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Quantity             int32    `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
@@ -369,10 +501,12 @@ type Product struct {
 	PriceUsd    *Money `protobuf:"bytes,5,opt,name=price_usd,json=priceUsd,proto3" json:"price_usd,omitempty"`
 	// Categories such as "vintage" or "gardening" that can be used to look up
 	// other related products.
-	Categories           []string `protobuf:"bytes,6,rep,name=categories,proto3" json:"categories,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Categories []string `protobuf:"bytes,6,rep,name=categories,proto3" json:"categories,omitempty"`
+	// The size and weight of the product once packed, used to price shipping.
+	Dimensions           *PackageDimensions `protobuf:"bytes,7,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *Product) Reset()         { *m = Product{} }
@@ -442,6 +576,76 @@ func (m *Product) GetCategories() []string {
 	return nil
 }
 
+func (m *Product) GetDimensions() *PackageDimensions {
+	if m != nil {
+		return m.Dimensions
+	}
+	return nil
+}
+
+type PackageDimensions struct {
+	WeightKg             float64  `protobuf:"fixed64,1,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg,omitempty"`
+	LengthCm             float64  `protobuf:"fixed64,2,opt,name=length_cm,json=lengthCm,proto3" json:"length_cm,omitempty"`
+	WidthCm              float64  `protobuf:"fixed64,3,opt,name=width_cm,json=widthCm,proto3" json:"width_cm,omitempty"`
+	HeightCm             float64  `protobuf:"fixed64,4,opt,name=height_cm,json=heightCm,proto3" json:"height_cm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PackageDimensions) Reset()         { *m = PackageDimensions{} }
+func (m *PackageDimensions) String() string { return proto.CompactTextString(m) }
+func (*PackageDimensions) ProtoMessage()    {}
+func (*PackageDimensions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{9}
+}
+
+func (m *PackageDimensions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PackageDimensions.Unmarshal(m, b)
+}
+func (m *PackageDimensions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PackageDimensions.Marshal(b, m, deterministic)
+}
+func (m *PackageDimensions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PackageDimensions.Merge(m, src)
+}
+func (m *PackageDimensions) XXX_Size() int {
+	return xxx_messageInfo_PackageDimensions.Size(m)
+}
+func (m *PackageDimensions) XXX_DiscardUnknown() {
+	xxx_messageInfo_PackageDimensions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PackageDimensions proto.InternalMessageInfo
+
+func (m *PackageDimensions) GetWeightKg() float64 {
+	if m != nil {
+		return m.WeightKg
+	}
+	return 0
+}
+
+func (m *PackageDimensions) GetLengthCm() float64 {
+	if m != nil {
+		return m.LengthCm
+	}
+	return 0
+}
+
+func (m *PackageDimensions) GetWidthCm() float64 {
+	if m != nil {
+		return m.WidthCm
+	}
+	return 0
+}
+
+func (m *PackageDimensions) GetHeightCm() float64 {
+	if m != nil {
+		return m.HeightCm
+	}
+	return 0
+}
+
 type ListProductsResponse struct {
 	Products             []*Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -453,7 +657,7 @@ func (m *ListProductsResponse) Reset()         { *m = ListProductsResponse{} }
 func (m *ListProductsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListProductsResponse) ProtoMessage()    {}
 func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{9}
+	return fileDescriptor_ca53982754088a9d, []int{10}
 }
 
 func (m *ListProductsResponse) XXX_Unmarshal(b []byte) error {
@@ -492,7 +696,7 @@ func (m *GetProductRequest) Reset()         { *m = GetProductRequest{} }
 func (m *GetProductRequest) String() string { return proto.CompactTextString(m) }
 func (*GetProductRequest) ProtoMessage()    {}
 func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{10}
+	return fileDescriptor_ca53982754088a9d, []int{11}
 }
 
 func (m *GetProductRequest) XXX_Unmarshal(b []byte) error {
@@ -531,7 +735,7 @@ func (m *SearchProductsRequest) Reset()         { *m = SearchProductsRequest{} }
 func (m *SearchProductsRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchProductsRequest) ProtoMessage()    {}
 func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{11}
+	return fileDescriptor_ca53982754088a9d, []int{12}
 }
 
 func (m *SearchProductsRequest) XXX_Unmarshal(b []byte) error {
@@ -570,7 +774,7 @@ func (m *SearchProductsResponse) Reset()         { *m = SearchProductsResponse{}
 func (m *SearchProductsResponse) String() string { return proto.CompactTextString(m) }
 func (*SearchProductsResponse) ProtoMessage()    {}
 func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{12}
+	return fileDescriptor_ca53982754088a9d, []int{13}
 }
 
 func (m *SearchProductsResponse) XXX_Unmarshal(b []byte) error {
@@ -599,18 +803,28 @@ func (m *SearchProductsResponse) GetResults() []*Product {
 }
 
 type GetQuoteRequest struct {
-	Address              *Address    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Items                []*CartItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Address *Address    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Items   []*CartItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// The shipping option to quote, such as "express". Defaults to "standard".
+	ShippingOptionId string `protobuf:"bytes,3,opt,name=shipping_option_id,json=shippingOptionId,proto3" json:"shipping_option_id,omitempty"`
+	// The ISO 4217 code of the currency to quote in. Defaults to USD.
+	CurrencyCode string `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// The order subtotal, in any currency, for free-shipping thresholds.
+	Subtotal *Money `protobuf:"bytes,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	// A promo code entered by the customer to discount shipping.
+	PromoCode string `protobuf:"bytes,6,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	// How to choose between the carriers able to ship the order.
+	RateShopping         RateShopping `protobuf:"varint,7,opt,name=rate_shopping,json=rateShopping,proto3,enum=hipstershop.RateShopping" json:"rate_shopping,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *GetQuoteRequest) Reset()         { *m = GetQuoteRequest{} }
 func (m *GetQuoteRequest) String() string { return proto.CompactTextString(m) }
 func (*GetQuoteRequest) ProtoMessage()    {}
 func (*GetQuoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{13}
+	return fileDescriptor_ca53982754088a9d, []int{14}
 }
 
 func (m *GetQuoteRequest) XXX_Unmarshal(b []byte) error {
@@ -645,18 +859,63 @@ func (m *GetQuoteRequest) GetItems() []*CartItem {
 	return nil
 }
 
+func (m *GetQuoteRequest) GetShippingOptionId() string {
+	if m != nil {
+		return m.ShippingOptionId
+	}
+	return ""
+}
+
+func (m *GetQuoteRequest) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+func (m *GetQuoteRequest) GetSubtotal() *Money {
+	if m != nil {
+		return m.Subtotal
+	}
+	return nil
+}
+
+func (m *GetQuoteRequest) GetPromoCode() string {
+	if m != nil {
+		return m.PromoCode
+	}
+	return ""
+}
+
+func (m *GetQuoteRequest) GetRateShopping() RateShopping {
+	if m != nil {
+		return m.RateShopping
+	}
+	return RateShopping_CHEAPEST
+}
+
 type GetQuoteResponse struct {
-	CostUsd              *Money   `protobuf:"bytes,1,opt,name=cost_usd,json=costUsd,proto3" json:"cost_usd,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CostUsd *Money `protobuf:"bytes,1,opt,name=cost_usd,json=costUsd,proto3" json:"cost_usd,omitempty"`
+	// The cost in the requested currency, rounded to its minor unit.
+	Cost *Money `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	// The promotion included in the cost, if any.
+	Promotion *ShippingPromotion `protobuf:"bytes,3,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	// The carrier chosen to ship the order.
+	CarrierId   string `protobuf:"bytes,4,opt,name=carrier_id,json=carrierId,proto3" json:"carrier_id,omitempty"`
+	CarrierName string `protobuf:"bytes,5,opt,name=carrier_name,json=carrierName,proto3" json:"carrier_name,omitempty"`
+	// The import duties and taxes of a cross-border order, if any. They are
+	// not included in the cost.
+	Duties               *DutiesEstimate `protobuf:"bytes,6,opt,name=duties,proto3" json:"duties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *GetQuoteResponse) Reset()         { *m = GetQuoteResponse{} }
 func (m *GetQuoteResponse) String() string { return proto.CompactTextString(m) }
 func (*GetQuoteResponse) ProtoMessage()    {}
 func (*GetQuoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{14}
+	return fileDescriptor_ca53982754088a9d, []int{15}
 }
 
 func (m *GetQuoteResponse) XXX_Unmarshal(b []byte) error {
@@ -684,736 +943,3142 @@ func (m *GetQuoteResponse) GetCostUsd() *Money {
 	return nil
 }
 
-type ShipOrderRequest struct {
-	Address              *Address    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Items                []*CartItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *GetQuoteResponse) GetCost() *Money {
+	if m != nil {
+		return m.Cost
+	}
+	return nil
 }
 
-func (m *ShipOrderRequest) Reset()         { *m = ShipOrderRequest{} }
-func (m *ShipOrderRequest) String() string { return proto.CompactTextString(m) }
-func (*ShipOrderRequest) ProtoMessage()    {}
-func (*ShipOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{15}
+func (m *GetQuoteResponse) GetPromotion() *ShippingPromotion {
+	if m != nil {
+		return m.Promotion
+	}
+	return nil
 }
 
-func (m *ShipOrderRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShipOrderRequest.Unmarshal(m, b)
-}
-func (m *ShipOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShipOrderRequest.Marshal(b, m, deterministic)
-}
-func (m *ShipOrderRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShipOrderRequest.Merge(m, src)
-}
-func (m *ShipOrderRequest) XXX_Size() int {
-	return xxx_messageInfo_ShipOrderRequest.Size(m)
-}
-func (m *ShipOrderRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShipOrderRequest.DiscardUnknown(m)
+func (m *GetQuoteResponse) GetCarrierId() string {
+	if m != nil {
+		return m.CarrierId
+	}
+	return ""
 }
 
-var xxx_messageInfo_ShipOrderRequest proto.InternalMessageInfo
-
-func (m *ShipOrderRequest) GetAddress() *Address {
+func (m *GetQuoteResponse) GetCarrierName() string {
 	if m != nil {
-		return m.Address
+		return m.CarrierName
 	}
-	return nil
+	return ""
 }
 
-func (m *ShipOrderRequest) GetItems() []*CartItem {
+func (m *GetQuoteResponse) GetDuties() *DutiesEstimate {
 	if m != nil {
-		return m.Items
+		return m.Duties
 	}
 	return nil
 }
 
-type ShipOrderResponse struct {
-	TrackingId           string   `protobuf:"bytes,1,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+// DutiesEstimate is the import duties and taxes expected for an order at its
+// destination, in the quoted currency.
+type DutiesEstimate struct {
+	// The customs territory of the destination, such as "european-union".
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// The value of the goods, as declared to customs.
+	GoodsValue *Money `protobuf:"bytes,2,opt,name=goods_value,json=goodsValue,proto3" json:"goods_value,omitempty"`
+	Duties     *Money `protobuf:"bytes,3,opt,name=duties,proto3" json:"duties,omitempty"`
+	Taxes      *Money `protobuf:"bytes,4,opt,name=taxes,proto3" json:"taxes,omitempty"`
+	// The sum of the duties and taxes.
+	Total                *Money   `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShipOrderResponse) Reset()         { *m = ShipOrderResponse{} }
-func (m *ShipOrderResponse) String() string { return proto.CompactTextString(m) }
-func (*ShipOrderResponse) ProtoMessage()    {}
-func (*ShipOrderResponse) Descriptor() ([]byte, []int) {
+func (m *DutiesEstimate) Reset()         { *m = DutiesEstimate{} }
+func (m *DutiesEstimate) String() string { return proto.CompactTextString(m) }
+func (*DutiesEstimate) ProtoMessage()    {}
+func (*DutiesEstimate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ca53982754088a9d, []int{16}
 }
 
-func (m *ShipOrderResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShipOrderResponse.Unmarshal(m, b)
+func (m *DutiesEstimate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DutiesEstimate.Unmarshal(m, b)
 }
-func (m *ShipOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShipOrderResponse.Marshal(b, m, deterministic)
+func (m *DutiesEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DutiesEstimate.Marshal(b, m, deterministic)
 }
-func (m *ShipOrderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShipOrderResponse.Merge(m, src)
+func (m *DutiesEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutiesEstimate.Merge(m, src)
 }
-func (m *ShipOrderResponse) XXX_Size() int {
-	return xxx_messageInfo_ShipOrderResponse.Size(m)
+func (m *DutiesEstimate) XXX_Size() int {
+	return xxx_messageInfo_DutiesEstimate.Size(m)
 }
-func (m *ShipOrderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShipOrderResponse.DiscardUnknown(m)
+func (m *DutiesEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutiesEstimate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShipOrderResponse proto.InternalMessageInfo
+var xxx_messageInfo_DutiesEstimate proto.InternalMessageInfo
 
-func (m *ShipOrderResponse) GetTrackingId() string {
+func (m *DutiesEstimate) GetDestination() string {
 	if m != nil {
-		return m.TrackingId
+		return m.Destination
 	}
 	return ""
 }
 
-type Address struct {
-	StreetAddress        string   `protobuf:"bytes,1,opt,name=street_address,json=streetAddress,proto3" json:"street_address,omitempty"`
-	City                 string   `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
-	State                string   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
-	Country              string   `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
-	ZipCode              int32    `protobuf:"varint,5,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty"`
+func (m *DutiesEstimate) GetGoodsValue() *Money {
+	if m != nil {
+		return m.GoodsValue
+	}
+	return nil
+}
+
+func (m *DutiesEstimate) GetDuties() *Money {
+	if m != nil {
+		return m.Duties
+	}
+	return nil
+}
+
+func (m *DutiesEstimate) GetTaxes() *Money {
+	if m != nil {
+		return m.Taxes
+	}
+	return nil
+}
+
+func (m *DutiesEstimate) GetTotal() *Money {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+// ShippingPromotion explains a discount applied to a shipping quote.
+type ShippingPromotion struct {
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The amount taken off the cost, in the quoted currency.
+	Discount             *Money   `protobuf:"bytes,3,opt,name=discount,proto3" json:"discount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Address) Reset()         { *m = Address{} }
-func (m *Address) String() string { return proto.CompactTextString(m) }
-func (*Address) ProtoMessage()    {}
-func (*Address) Descriptor() ([]byte, []int) {
+func (m *ShippingPromotion) Reset()         { *m = ShippingPromotion{} }
+func (m *ShippingPromotion) String() string { return proto.CompactTextString(m) }
+func (*ShippingPromotion) ProtoMessage()    {}
+func (*ShippingPromotion) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ca53982754088a9d, []int{17}
 }
 
-func (m *Address) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Address.Unmarshal(m, b)
+func (m *ShippingPromotion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShippingPromotion.Unmarshal(m, b)
 }
-func (m *Address) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Address.Marshal(b, m, deterministic)
+func (m *ShippingPromotion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShippingPromotion.Marshal(b, m, deterministic)
 }
-func (m *Address) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Address.Merge(m, src)
+func (m *ShippingPromotion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShippingPromotion.Merge(m, src)
 }
-func (m *Address) XXX_Size() int {
-	return xxx_messageInfo_Address.Size(m)
+func (m *ShippingPromotion) XXX_Size() int {
+	return xxx_messageInfo_ShippingPromotion.Size(m)
 }
-func (m *Address) XXX_DiscardUnknown() {
-	xxx_messageInfo_Address.DiscardUnknown(m)
+func (m *ShippingPromotion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShippingPromotion.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Address proto.InternalMessageInfo
-
-func (m *Address) GetStreetAddress() string {
-	if m != nil {
-		return m.StreetAddress
-	}
-	return ""
-}
+var xxx_messageInfo_ShippingPromotion proto.InternalMessageInfo
 
-func (m *Address) GetCity() string {
+func (m *ShippingPromotion) GetId() string {
 	if m != nil {
-		return m.City
+		return m.Id
 	}
 	return ""
 }
 
-func (m *Address) GetState() string {
+func (m *ShippingPromotion) GetDescription() string {
 	if m != nil {
-		return m.State
+		return m.Description
 	}
 	return ""
 }
 
-func (m *Address) GetCountry() string {
+func (m *ShippingPromotion) GetDiscount() *Money {
 	if m != nil {
-		return m.Country
+		return m.Discount
 	}
-	return ""
+	return nil
 }
 
-func (m *Address) GetZipCode() int32 {
-	if m != nil {
-		return m.ZipCode
-	}
-	return 0
-}
-
-// Represents an amount of money with its currency type.
-type Money struct {
-	// The 3-letter currency code defined in ISO 4217.
-	CurrencyCode string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
-	// The whole units of the amount.
-	// For example if `currencyCode` is `"USD"`, then 1 unit is one US dollar.
-	Units int64 `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
-	// Number of nano (10^-9) units of the amount.
-	// The value must be between -999,999,999 and +999,999,999 inclusive.
-	// If `units` is positive, `nanos` must be positive or zero.
-	// If `units` is zero, `nanos` can be positive, zero, or negative.
-	// If `units` is negative, `nanos` must be negative or zero.
-	// For example $-1.75 is represented as `units`=-1 and `nanos`=-750,000,000.
-	Nanos                int32    `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`
+type ShipOrderRequest struct {
+	Address *Address    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Items   []*CartItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// The shipping option chosen by the customer. Defaults to "standard".
+	ShippingOptionId string `protobuf:"bytes,3,opt,name=shipping_option_id,json=shippingOptionId,proto3" json:"shipping_option_id,omitempty"`
+	// The carrier of the quote accepted by the customer. When empty, the
+	// cheapest carrier is chosen.
+	CarrierId string `protobuf:"bytes,4,opt,name=carrier_id,json=carrierId,proto3" json:"carrier_id,omitempty"`
+	// The order being shipped, saved with each of its parcels.
+	OrderId string `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// The pickup point to ship the order to instead of the address.
+	PickupPointId        string   `protobuf:"bytes,6,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Money) Reset()         { *m = Money{} }
-func (m *Money) String() string { return proto.CompactTextString(m) }
-func (*Money) ProtoMessage()    {}
-func (*Money) Descriptor() ([]byte, []int) {
+func (m *ShipOrderRequest) Reset()         { *m = ShipOrderRequest{} }
+func (m *ShipOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*ShipOrderRequest) ProtoMessage()    {}
+func (*ShipOrderRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ca53982754088a9d, []int{18}
 }
 
-func (m *Money) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Money.Unmarshal(m, b)
+func (m *ShipOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShipOrderRequest.Unmarshal(m, b)
 }
-func (m *Money) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Money.Marshal(b, m, deterministic)
+func (m *ShipOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShipOrderRequest.Marshal(b, m, deterministic)
 }
-func (m *Money) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Money.Merge(m, src)
+func (m *ShipOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShipOrderRequest.Merge(m, src)
 }
-func (m *Money) XXX_Size() int {
-	return xxx_messageInfo_Money.Size(m)
+func (m *ShipOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_ShipOrderRequest.Size(m)
 }
-func (m *Money) XXX_DiscardUnknown() {
-	xxx_messageInfo_Money.DiscardUnknown(m)
+func (m *ShipOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShipOrderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Money proto.InternalMessageInfo
+var xxx_messageInfo_ShipOrderRequest proto.InternalMessageInfo
 
-func (m *Money) GetCurrencyCode() string {
+func (m *ShipOrderRequest) GetAddress() *Address {
 	if m != nil {
-		return m.CurrencyCode
+		return m.Address
+	}
+	return nil
+}
+
+func (m *ShipOrderRequest) GetItems() []*CartItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ShipOrderRequest) GetShippingOptionId() string {
+	if m != nil {
+		return m.ShippingOptionId
 	}
 	return ""
 }
 
-func (m *Money) GetUnits() int64 {
+func (m *ShipOrderRequest) GetCarrierId() string {
 	if m != nil {
-		return m.Units
+		return m.CarrierId
 	}
-	return 0
+	return ""
 }
 
-func (m *Money) GetNanos() int32 {
+func (m *ShipOrderRequest) GetOrderId() string {
 	if m != nil {
-		return m.Nanos
+		return m.OrderId
 	}
-	return 0
+	return ""
 }
 
-type GetSupportedCurrenciesResponse struct {
-	// The 3-letter currency code defined in ISO 4217.
-	CurrencyCodes        []string `protobuf:"bytes,1,rep,name=currency_codes,json=currencyCodes,proto3" json:"currency_codes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ShipOrderRequest) GetPickupPointId() string {
+	if m != nil {
+		return m.PickupPointId
+	}
+	return ""
 }
 
-func (m *GetSupportedCurrenciesResponse) Reset()         { *m = GetSupportedCurrenciesResponse{} }
-func (m *GetSupportedCurrenciesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetSupportedCurrenciesResponse) ProtoMessage()    {}
-func (*GetSupportedCurrenciesResponse) Descriptor() ([]byte, []int) {
+type ShipOrderResponse struct {
+	// The tracking ID of the first parcel.
+	TrackingId string `protobuf:"bytes,1,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	// The parcels the order was split into, each tracked on its own.
+	Parcels []*ShippedParcel `protobuf:"bytes,2,rep,name=parcels,proto3" json:"parcels,omitempty"`
+	// The pickup point the order was shipped to, if any.
+	PickupPoint          *PickupPoint `protobuf:"bytes,3,opt,name=pickup_point,json=pickupPoint,proto3" json:"pickup_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ShipOrderResponse) Reset()         { *m = ShipOrderResponse{} }
+func (m *ShipOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*ShipOrderResponse) ProtoMessage()    {}
+func (*ShipOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ca53982754088a9d, []int{19}
 }
 
-func (m *GetSupportedCurrenciesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSupportedCurrenciesResponse.Unmarshal(m, b)
+func (m *ShipOrderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShipOrderResponse.Unmarshal(m, b)
 }
-func (m *GetSupportedCurrenciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSupportedCurrenciesResponse.Marshal(b, m, deterministic)
+func (m *ShipOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShipOrderResponse.Marshal(b, m, deterministic)
 }
-func (m *GetSupportedCurrenciesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSupportedCurrenciesResponse.Merge(m, src)
+func (m *ShipOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShipOrderResponse.Merge(m, src)
 }
-func (m *GetSupportedCurrenciesResponse) XXX_Size() int {
-	return xxx_messageInfo_GetSupportedCurrenciesResponse.Size(m)
+func (m *ShipOrderResponse) XXX_Size() int {
+	return xxx_messageInfo_ShipOrderResponse.Size(m)
 }
-func (m *GetSupportedCurrenciesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSupportedCurrenciesResponse.DiscardUnknown(m)
+func (m *ShipOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShipOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSupportedCurrenciesResponse proto.InternalMessageInfo
+var xxx_messageInfo_ShipOrderResponse proto.InternalMessageInfo
 
-func (m *GetSupportedCurrenciesResponse) GetCurrencyCodes() []string {
+func (m *ShipOrderResponse) GetTrackingId() string {
 	if m != nil {
-		return m.CurrencyCodes
+		return m.TrackingId
+	}
+	return ""
+}
+
+func (m *ShipOrderResponse) GetParcels() []*ShippedParcel {
+	if m != nil {
+		return m.Parcels
 	}
 	return nil
 }
 
-type CurrencyConversionRequest struct {
-	From *Money `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	// The 3-letter currency code defined in ISO 4217.
-	ToCode               string   `protobuf:"bytes,2,opt,name=to_code,json=toCode,proto3" json:"to_code,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ShipOrderResponse) GetPickupPoint() *PickupPoint {
+	if m != nil {
+		return m.PickupPoint
+	}
+	return nil
 }
 
-func (m *CurrencyConversionRequest) Reset()         { *m = CurrencyConversionRequest{} }
-func (m *CurrencyConversionRequest) String() string { return proto.CompactTextString(m) }
-func (*CurrencyConversionRequest) ProtoMessage()    {}
-func (*CurrencyConversionRequest) Descriptor() ([]byte, []int) {
+// ShippedParcel is one parcel of a shipped order.
+type ShippedParcel struct {
+	TrackingId            string      `protobuf:"bytes,1,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	Items                 []*CartItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	CarrierId             string      `protobuf:"bytes,3,opt,name=carrier_id,json=carrierId,proto3" json:"carrier_id,omitempty"`
+	CarrierName           string      `protobuf:"bytes,4,opt,name=carrier_name,json=carrierName,proto3" json:"carrier_name,omitempty"`
+	CarrierTrackingNumber string      `protobuf:"bytes,5,opt,name=carrier_tracking_number,json=carrierTrackingNumber,proto3" json:"carrier_tracking_number,omitempty"`
+	// The latest estimated delivery date, as a YYYY-MM-DD date.
+	EstimatedDeliveryDate string   `protobuf:"bytes,6,opt,name=estimated_delivery_date,json=estimatedDeliveryDate,proto3" json:"estimated_delivery_date,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *ShippedParcel) Reset()         { *m = ShippedParcel{} }
+func (m *ShippedParcel) String() string { return proto.CompactTextString(m) }
+func (*ShippedParcel) ProtoMessage()    {}
+func (*ShippedParcel) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ca53982754088a9d, []int{20}
 }
 
-func (m *CurrencyConversionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CurrencyConversionRequest.Unmarshal(m, b)
+func (m *ShippedParcel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShippedParcel.Unmarshal(m, b)
 }
-func (m *CurrencyConversionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CurrencyConversionRequest.Marshal(b, m, deterministic)
+func (m *ShippedParcel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShippedParcel.Marshal(b, m, deterministic)
 }
-func (m *CurrencyConversionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CurrencyConversionRequest.Merge(m, src)
+func (m *ShippedParcel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShippedParcel.Merge(m, src)
 }
-func (m *CurrencyConversionRequest) XXX_Size() int {
-	return xxx_messageInfo_CurrencyConversionRequest.Size(m)
+func (m *ShippedParcel) XXX_Size() int {
+	return xxx_messageInfo_ShippedParcel.Size(m)
 }
-func (m *CurrencyConversionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CurrencyConversionRequest.DiscardUnknown(m)
+func (m *ShippedParcel) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShippedParcel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CurrencyConversionRequest proto.InternalMessageInfo
+var xxx_messageInfo_ShippedParcel proto.InternalMessageInfo
 
-func (m *CurrencyConversionRequest) GetFrom() *Money {
+func (m *ShippedParcel) GetTrackingId() string {
 	if m != nil {
-		return m.From
+		return m.TrackingId
+	}
+	return ""
+}
+
+func (m *ShippedParcel) GetItems() []*CartItem {
+	if m != nil {
+		return m.Items
 	}
 	return nil
 }
 
-func (m *CurrencyConversionRequest) GetToCode() string {
+func (m *ShippedParcel) GetCarrierId() string {
 	if m != nil {
-		return m.ToCode
+		return m.CarrierId
 	}
 	return ""
 }
 
-type CreditCardInfo struct {
-	CreditCardNumber          string   `protobuf:"bytes,1,opt,name=credit_card_number,json=creditCardNumber,proto3" json:"credit_card_number,omitempty"`
-	CreditCardCvv             int32    `protobuf:"varint,2,opt,name=credit_card_cvv,json=creditCardCvv,proto3" json:"credit_card_cvv,omitempty"`
-	CreditCardExpirationYear  int32    `protobuf:"varint,3,opt,name=credit_card_expiration_year,json=creditCardExpirationYear,proto3" json:"credit_card_expiration_year,omitempty"`
-	CreditCardExpirationMonth int32    `protobuf:"varint,4,opt,name=credit_card_expiration_month,json=creditCardExpirationMonth,proto3" json:"credit_card_expiration_month,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
-	XXX_unrecognized          []byte   `json:"-"`
-	XXX_sizecache             int32    `json:"-"`
+func (m *ShippedParcel) GetCarrierName() string {
+	if m != nil {
+		return m.CarrierName
+	}
+	return ""
 }
 
-func (m *CreditCardInfo) Reset()         { *m = CreditCardInfo{} }
-func (m *CreditCardInfo) String() string { return proto.CompactTextString(m) }
-func (*CreditCardInfo) ProtoMessage()    {}
-func (*CreditCardInfo) Descriptor() ([]byte, []int) {
+func (m *ShippedParcel) GetCarrierTrackingNumber() string {
+	if m != nil {
+		return m.CarrierTrackingNumber
+	}
+	return ""
+}
+
+func (m *ShippedParcel) GetEstimatedDeliveryDate() string {
+	if m != nil {
+		return m.EstimatedDeliveryDate
+	}
+	return ""
+}
+
+type ListShippingOptionsRequest struct {
+	Address *Address    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Items   []*CartItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// The ISO 4217 code of the currency to quote in. Defaults to USD.
+	CurrencyCode string `protobuf:"bytes,3,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// The order subtotal, promo code and carrier choice, as in GetQuoteRequest.
+	Subtotal             *Money       `protobuf:"bytes,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	PromoCode            string       `protobuf:"bytes,5,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	RateShopping         RateShopping `protobuf:"varint,6,opt,name=rate_shopping,json=rateShopping,proto3,enum=hipstershop.RateShopping" json:"rate_shopping,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListShippingOptionsRequest) Reset()         { *m = ListShippingOptionsRequest{} }
+func (m *ListShippingOptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListShippingOptionsRequest) ProtoMessage()    {}
+func (*ListShippingOptionsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ca53982754088a9d, []int{21}
 }
 
-func (m *CreditCardInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreditCardInfo.Unmarshal(m, b)
+func (m *ListShippingOptionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListShippingOptionsRequest.Unmarshal(m, b)
 }
-func (m *CreditCardInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreditCardInfo.Marshal(b, m, deterministic)
+func (m *ListShippingOptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListShippingOptionsRequest.Marshal(b, m, deterministic)
 }
-func (m *CreditCardInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreditCardInfo.Merge(m, src)
+func (m *ListShippingOptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListShippingOptionsRequest.Merge(m, src)
+}
+func (m *ListShippingOptionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListShippingOptionsRequest.Size(m)
+}
+func (m *ListShippingOptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListShippingOptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListShippingOptionsRequest proto.InternalMessageInfo
+
+func (m *ListShippingOptionsRequest) GetAddress() *Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *ListShippingOptionsRequest) GetItems() []*CartItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ListShippingOptionsRequest) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+func (m *ListShippingOptionsRequest) GetSubtotal() *Money {
+	if m != nil {
+		return m.Subtotal
+	}
+	return nil
+}
+
+func (m *ListShippingOptionsRequest) GetPromoCode() string {
+	if m != nil {
+		return m.PromoCode
+	}
+	return ""
+}
+
+func (m *ListShippingOptionsRequest) GetRateShopping() RateShopping {
+	if m != nil {
+		return m.RateShopping
+	}
+	return RateShopping_CHEAPEST
+}
+
+type ListShippingOptionsResponse struct {
+	Options              []*ShippingOption `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListShippingOptionsResponse) Reset()         { *m = ListShippingOptionsResponse{} }
+func (m *ListShippingOptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListShippingOptionsResponse) ProtoMessage()    {}
+func (*ListShippingOptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{22}
+}
+
+func (m *ListShippingOptionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListShippingOptionsResponse.Unmarshal(m, b)
+}
+func (m *ListShippingOptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListShippingOptionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListShippingOptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListShippingOptionsResponse.Merge(m, src)
+}
+func (m *ListShippingOptionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListShippingOptionsResponse.Size(m)
+}
+func (m *ListShippingOptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListShippingOptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListShippingOptionsResponse proto.InternalMessageInfo
+
+func (m *ListShippingOptionsResponse) GetOptions() []*ShippingOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type ShippingOption struct {
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CostUsd *Money `protobuf:"bytes,3,opt,name=cost_usd,json=costUsd,proto3" json:"cost_usd,omitempty"`
+	// The estimated delivery window, as YYYY-MM-DD dates.
+	EarliestDeliveryDate string `protobuf:"bytes,4,opt,name=earliest_delivery_date,json=earliestDeliveryDate,proto3" json:"earliest_delivery_date,omitempty"`
+	LatestDeliveryDate   string `protobuf:"bytes,5,opt,name=latest_delivery_date,json=latestDeliveryDate,proto3" json:"latest_delivery_date,omitempty"`
+	// The cost in the requested currency, rounded to its minor unit.
+	Cost *Money `protobuf:"bytes,6,opt,name=cost,proto3" json:"cost,omitempty"`
+	// The promotion included in the cost, if any.
+	Promotion *ShippingPromotion `protobuf:"bytes,7,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	// The carrier chosen to ship with this option.
+	CarrierId            string   `protobuf:"bytes,8,opt,name=carrier_id,json=carrierId,proto3" json:"carrier_id,omitempty"`
+	CarrierName          string   `protobuf:"bytes,9,opt,name=carrier_name,json=carrierName,proto3" json:"carrier_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShippingOption) Reset()         { *m = ShippingOption{} }
+func (m *ShippingOption) String() string { return proto.CompactTextString(m) }
+func (*ShippingOption) ProtoMessage()    {}
+func (*ShippingOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{23}
+}
+
+func (m *ShippingOption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShippingOption.Unmarshal(m, b)
+}
+func (m *ShippingOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShippingOption.Marshal(b, m, deterministic)
+}
+func (m *ShippingOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShippingOption.Merge(m, src)
+}
+func (m *ShippingOption) XXX_Size() int {
+	return xxx_messageInfo_ShippingOption.Size(m)
+}
+func (m *ShippingOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShippingOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShippingOption proto.InternalMessageInfo
+
+func (m *ShippingOption) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ShippingOption) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ShippingOption) GetCostUsd() *Money {
+	if m != nil {
+		return m.CostUsd
+	}
+	return nil
+}
+
+func (m *ShippingOption) GetEarliestDeliveryDate() string {
+	if m != nil {
+		return m.EarliestDeliveryDate
+	}
+	return ""
+}
+
+func (m *ShippingOption) GetLatestDeliveryDate() string {
+	if m != nil {
+		return m.LatestDeliveryDate
+	}
+	return ""
+}
+
+func (m *ShippingOption) GetCost() *Money {
+	if m != nil {
+		return m.Cost
+	}
+	return nil
+}
+
+func (m *ShippingOption) GetPromotion() *ShippingPromotion {
+	if m != nil {
+		return m.Promotion
+	}
+	return nil
+}
+
+func (m *ShippingOption) GetCarrierId() string {
+	if m != nil {
+		return m.CarrierId
+	}
+	return ""
+}
+
+func (m *ShippingOption) GetCarrierName() string {
+	if m != nil {
+		return m.CarrierName
+	}
+	return ""
+}
+
+type GetShipmentRequest struct {
+	TrackingId           string   `protobuf:"bytes,1,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetShipmentRequest) Reset()         { *m = GetShipmentRequest{} }
+func (m *GetShipmentRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShipmentRequest) ProtoMessage()    {}
+func (*GetShipmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{24}
+}
+
+func (m *GetShipmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShipmentRequest.Unmarshal(m, b)
+}
+func (m *GetShipmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShipmentRequest.Marshal(b, m, deterministic)
+}
+func (m *GetShipmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShipmentRequest.Merge(m, src)
+}
+func (m *GetShipmentRequest) XXX_Size() int {
+	return xxx_messageInfo_GetShipmentRequest.Size(m)
+}
+func (m *GetShipmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShipmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShipmentRequest proto.InternalMessageInfo
+
+func (m *GetShipmentRequest) GetTrackingId() string {
+	if m != nil {
+		return m.TrackingId
+	}
+	return ""
+}
+
+type CancelShipmentRequest struct {
+	// The shipment to cancel, or the order whose shipments are all canceled.
+	// One of the two is required.
+	TrackingId           string   `protobuf:"bytes,1,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	OrderId              string   `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelShipmentRequest) Reset()         { *m = CancelShipmentRequest{} }
+func (m *CancelShipmentRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelShipmentRequest) ProtoMessage()    {}
+func (*CancelShipmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{25}
+}
+
+func (m *CancelShipmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelShipmentRequest.Unmarshal(m, b)
+}
+func (m *CancelShipmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelShipmentRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelShipmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelShipmentRequest.Merge(m, src)
+}
+func (m *CancelShipmentRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelShipmentRequest.Size(m)
+}
+func (m *CancelShipmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelShipmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelShipmentRequest proto.InternalMessageInfo
+
+func (m *CancelShipmentRequest) GetTrackingId() string {
+	if m != nil {
+		return m.TrackingId
+	}
+	return ""
+}
+
+func (m *CancelShipmentRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+type CancelShipmentResponse struct {
+	// The tracking IDs of the shipments canceled.
+	TrackingIds          []string `protobuf:"bytes,1,rep,name=tracking_ids,json=trackingIds,proto3" json:"tracking_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelShipmentResponse) Reset()         { *m = CancelShipmentResponse{} }
+func (m *CancelShipmentResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelShipmentResponse) ProtoMessage()    {}
+func (*CancelShipmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{26}
+}
+
+func (m *CancelShipmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelShipmentResponse.Unmarshal(m, b)
+}
+func (m *CancelShipmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelShipmentResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelShipmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelShipmentResponse.Merge(m, src)
+}
+func (m *CancelShipmentResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelShipmentResponse.Size(m)
+}
+func (m *CancelShipmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelShipmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelShipmentResponse proto.InternalMessageInfo
+
+func (m *CancelShipmentResponse) GetTrackingIds() []string {
+	if m != nil {
+		return m.TrackingIds
+	}
+	return nil
+}
+
+type WatchShipmentRequest struct {
+	TrackingId           string   `protobuf:"bytes,1,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchShipmentRequest) Reset()         { *m = WatchShipmentRequest{} }
+func (m *WatchShipmentRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchShipmentRequest) ProtoMessage()    {}
+func (*WatchShipmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{27}
+}
+
+func (m *WatchShipmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchShipmentRequest.Unmarshal(m, b)
+}
+func (m *WatchShipmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchShipmentRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchShipmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchShipmentRequest.Merge(m, src)
+}
+func (m *WatchShipmentRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchShipmentRequest.Size(m)
+}
+func (m *WatchShipmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchShipmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchShipmentRequest proto.InternalMessageInfo
+
+func (m *WatchShipmentRequest) GetTrackingId() string {
+	if m != nil {
+		return m.TrackingId
+	}
+	return ""
+}
+
+type ShipmentEvent struct {
+	Status ShipmentStatus `protobuf:"varint,1,opt,name=status,proto3,enum=hipstershop.ShipmentStatus" json:"status,omitempty"`
+	// When the shipment reached the status, in RFC 3339 format.
+	Time                 string   `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShipmentEvent) Reset()         { *m = ShipmentEvent{} }
+func (m *ShipmentEvent) String() string { return proto.CompactTextString(m) }
+func (*ShipmentEvent) ProtoMessage()    {}
+func (*ShipmentEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{28}
+}
+
+func (m *ShipmentEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShipmentEvent.Unmarshal(m, b)
+}
+func (m *ShipmentEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShipmentEvent.Marshal(b, m, deterministic)
+}
+func (m *ShipmentEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShipmentEvent.Merge(m, src)
+}
+func (m *ShipmentEvent) XXX_Size() int {
+	return xxx_messageInfo_ShipmentEvent.Size(m)
+}
+func (m *ShipmentEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShipmentEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShipmentEvent proto.InternalMessageInfo
+
+func (m *ShipmentEvent) GetStatus() ShipmentStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ShipmentStatus_SHIPMENT_STATUS_UNSPECIFIED
+}
+
+func (m *ShipmentEvent) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+type Shipment struct {
+	TrackingId       string         `protobuf:"bytes,1,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	Status           ShipmentStatus `protobuf:"varint,2,opt,name=status,proto3,enum=hipstershop.ShipmentStatus" json:"status,omitempty"`
+	Address          *Address       `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Items            []*CartItem    `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	ShippingOptionId string         `protobuf:"bytes,5,opt,name=shipping_option_id,json=shippingOptionId,proto3" json:"shipping_option_id,omitempty"`
+	// The latest estimated delivery date, as a YYYY-MM-DD date.
+	EstimatedDeliveryDate string `protobuf:"bytes,6,opt,name=estimated_delivery_date,json=estimatedDeliveryDate,proto3" json:"estimated_delivery_date,omitempty"`
+	// The status changes of the shipment so far, oldest first.
+	Events []*ShipmentEvent `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
+	// The carrier delivering the shipment, and its own tracking number.
+	CarrierId             string `protobuf:"bytes,8,opt,name=carrier_id,json=carrierId,proto3" json:"carrier_id,omitempty"`
+	CarrierName           string `protobuf:"bytes,9,opt,name=carrier_name,json=carrierName,proto3" json:"carrier_name,omitempty"`
+	CarrierTrackingNumber string `protobuf:"bytes,10,opt,name=carrier_tracking_number,json=carrierTrackingNumber,proto3" json:"carrier_tracking_number,omitempty"`
+	// The order the parcel belongs to, if known.
+	OrderId string `protobuf:"bytes,11,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// The pickup point the parcel is shipped to, whose address is address.
+	PickupPointId        string   `protobuf:"bytes,12,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Shipment) Reset()         { *m = Shipment{} }
+func (m *Shipment) String() string { return proto.CompactTextString(m) }
+func (*Shipment) ProtoMessage()    {}
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{29}
+}
+
+func (m *Shipment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Shipment.Unmarshal(m, b)
+}
+func (m *Shipment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Shipment.Marshal(b, m, deterministic)
+}
+func (m *Shipment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Shipment.Merge(m, src)
+}
+func (m *Shipment) XXX_Size() int {
+	return xxx_messageInfo_Shipment.Size(m)
+}
+func (m *Shipment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Shipment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Shipment proto.InternalMessageInfo
+
+func (m *Shipment) GetTrackingId() string {
+	if m != nil {
+		return m.TrackingId
+	}
+	return ""
+}
+
+func (m *Shipment) GetStatus() ShipmentStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ShipmentStatus_SHIPMENT_STATUS_UNSPECIFIED
+}
+
+func (m *Shipment) GetAddress() *Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *Shipment) GetItems() []*CartItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Shipment) GetShippingOptionId() string {
+	if m != nil {
+		return m.ShippingOptionId
+	}
+	return ""
+}
+
+func (m *Shipment) GetEstimatedDeliveryDate() string {
+	if m != nil {
+		return m.EstimatedDeliveryDate
+	}
+	return ""
+}
+
+func (m *Shipment) GetEvents() []*ShipmentEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *Shipment) GetCarrierId() string {
+	if m != nil {
+		return m.CarrierId
+	}
+	return ""
+}
+
+func (m *Shipment) GetCarrierName() string {
+	if m != nil {
+		return m.CarrierName
+	}
+	return ""
+}
+
+func (m *Shipment) GetCarrierTrackingNumber() string {
+	if m != nil {
+		return m.CarrierTrackingNumber
+	}
+	return ""
+}
+
+func (m *Shipment) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *Shipment) GetPickupPointId() string {
+	if m != nil {
+		return m.PickupPointId
+	}
+	return ""
+}
+
+type ValidateAddressRequest struct {
+	Address              *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateAddressRequest) Reset()         { *m = ValidateAddressRequest{} }
+func (m *ValidateAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateAddressRequest) ProtoMessage()    {}
+func (*ValidateAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{30}
+}
+
+func (m *ValidateAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateAddressRequest.Unmarshal(m, b)
+}
+func (m *ValidateAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateAddressRequest.Merge(m, src)
+}
+func (m *ValidateAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateAddressRequest.Size(m)
+}
+func (m *ValidateAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateAddressRequest proto.InternalMessageInfo
+
+func (m *ValidateAddressRequest) GetAddress() *Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+type ValidateAddressResponse struct {
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The address with its country and state codes and its postal code in
+	// their canonical format. Only set when the address is valid.
+	NormalizedAddress    *Address             `protobuf:"bytes,2,opt,name=normalized_address,json=normalizedAddress,proto3" json:"normalized_address,omitempty"`
+	Errors               []*AddressFieldError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ValidateAddressResponse) Reset()         { *m = ValidateAddressResponse{} }
+func (m *ValidateAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateAddressResponse) ProtoMessage()    {}
+func (*ValidateAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{31}
+}
+
+func (m *ValidateAddressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateAddressResponse.Unmarshal(m, b)
+}
+func (m *ValidateAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateAddressResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateAddressResponse.Merge(m, src)
+}
+func (m *ValidateAddressResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateAddressResponse.Size(m)
+}
+func (m *ValidateAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateAddressResponse proto.InternalMessageInfo
+
+func (m *ValidateAddressResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ValidateAddressResponse) GetNormalizedAddress() *Address {
+	if m != nil {
+		return m.NormalizedAddress
+	}
+	return nil
+}
+
+func (m *ValidateAddressResponse) GetErrors() []*AddressFieldError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type AddressFieldError struct {
+	// The name of the Address field at fault, such as "postal_code".
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddressFieldError) Reset()         { *m = AddressFieldError{} }
+func (m *AddressFieldError) String() string { return proto.CompactTextString(m) }
+func (*AddressFieldError) ProtoMessage()    {}
+func (*AddressFieldError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{32}
+}
+
+func (m *AddressFieldError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressFieldError.Unmarshal(m, b)
+}
+func (m *AddressFieldError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressFieldError.Marshal(b, m, deterministic)
+}
+func (m *AddressFieldError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressFieldError.Merge(m, src)
+}
+func (m *AddressFieldError) XXX_Size() int {
+	return xxx_messageInfo_AddressFieldError.Size(m)
+}
+func (m *AddressFieldError) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressFieldError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressFieldError proto.InternalMessageInfo
+
+func (m *AddressFieldError) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *AddressFieldError) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type CreateReturnRequest struct {
+	// The shipment to return items from, or the order whose shipments hold
+	// them. One of the two is required.
+	TrackingId           string      `protobuf:"bytes,1,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	OrderId              string      `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items                []*CartItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Reason               string      `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *CreateReturnRequest) Reset()         { *m = CreateReturnRequest{} }
+func (m *CreateReturnRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateReturnRequest) ProtoMessage()    {}
+func (*CreateReturnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{33}
+}
+
+func (m *CreateReturnRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateReturnRequest.Unmarshal(m, b)
+}
+func (m *CreateReturnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateReturnRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateReturnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateReturnRequest.Merge(m, src)
+}
+func (m *CreateReturnRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateReturnRequest.Size(m)
+}
+func (m *CreateReturnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateReturnRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateReturnRequest proto.InternalMessageInfo
+
+func (m *CreateReturnRequest) GetTrackingId() string {
+	if m != nil {
+		return m.TrackingId
+	}
+	return ""
+}
+
+func (m *CreateReturnRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *CreateReturnRequest) GetItems() []*CartItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *CreateReturnRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type GetReturnRequest struct {
+	// The tracking ID of the return label.
+	TrackingId           string   `protobuf:"bytes,1,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReturnRequest) Reset()         { *m = GetReturnRequest{} }
+func (m *GetReturnRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReturnRequest) ProtoMessage()    {}
+func (*GetReturnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{34}
+}
+
+func (m *GetReturnRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReturnRequest.Unmarshal(m, b)
+}
+func (m *GetReturnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReturnRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReturnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReturnRequest.Merge(m, src)
+}
+func (m *GetReturnRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReturnRequest.Size(m)
+}
+func (m *GetReturnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReturnRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReturnRequest proto.InternalMessageInfo
+
+func (m *GetReturnRequest) GetTrackingId() string {
+	if m != nil {
+		return m.TrackingId
+	}
+	return ""
+}
+
+type ReturnEvent struct {
+	Status ReturnStatus `protobuf:"varint,1,opt,name=status,proto3,enum=hipstershop.ReturnStatus" json:"status,omitempty"`
+	// When the return reached the status, in RFC 3339 format.
+	Time                 string   `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReturnEvent) Reset()         { *m = ReturnEvent{} }
+func (m *ReturnEvent) String() string { return proto.CompactTextString(m) }
+func (*ReturnEvent) ProtoMessage()    {}
+func (*ReturnEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{35}
+}
+
+func (m *ReturnEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReturnEvent.Unmarshal(m, b)
+}
+func (m *ReturnEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReturnEvent.Marshal(b, m, deterministic)
+}
+func (m *ReturnEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReturnEvent.Merge(m, src)
+}
+func (m *ReturnEvent) XXX_Size() int {
+	return xxx_messageInfo_ReturnEvent.Size(m)
+}
+func (m *ReturnEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReturnEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReturnEvent proto.InternalMessageInfo
+
+func (m *ReturnEvent) GetStatus() ReturnStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ReturnStatus_RETURN_STATUS_UNSPECIFIED
+}
+
+func (m *ReturnEvent) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+// Return is items sent back by a customer with a return label.
+type Return struct {
+	// The tracking ID of the return label, which identifies the return.
+	TrackingId string `protobuf:"bytes,1,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	OrderId    string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// The shipments the items were delivered in.
+	ShipmentTrackingIds []string     `protobuf:"bytes,3,rep,name=shipment_tracking_ids,json=shipmentTrackingIds,proto3" json:"shipment_tracking_ids,omitempty"`
+	Items               []*CartItem  `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Reason              string       `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status              ReturnStatus `protobuf:"varint,6,opt,name=status,proto3,enum=hipstershop.ReturnStatus" json:"status,omitempty"`
+	// The status changes of the return so far, oldest first.
+	Events []*ReturnEvent `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
+	// The carrier bringing the items back, and its own tracking number.
+	CarrierId             string `protobuf:"bytes,8,opt,name=carrier_id,json=carrierId,proto3" json:"carrier_id,omitempty"`
+	CarrierName           string `protobuf:"bytes,9,opt,name=carrier_name,json=carrierName,proto3" json:"carrier_name,omitempty"`
+	CarrierTrackingNumber string `protobuf:"bytes,10,opt,name=carrier_tracking_number,json=carrierTrackingNumber,proto3" json:"carrier_tracking_number,omitempty"`
+	// The refund issued once the return was received.
+	RefundId             string   `protobuf:"bytes,11,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Return) Reset()         { *m = Return{} }
+func (m *Return) String() string { return proto.CompactTextString(m) }
+func (*Return) ProtoMessage()    {}
+func (*Return) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{36}
+}
+
+func (m *Return) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Return.Unmarshal(m, b)
+}
+func (m *Return) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Return.Marshal(b, m, deterministic)
+}
+func (m *Return) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Return.Merge(m, src)
+}
+func (m *Return) XXX_Size() int {
+	return xxx_messageInfo_Return.Size(m)
+}
+func (m *Return) XXX_DiscardUnknown() {
+	xxx_messageInfo_Return.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Return proto.InternalMessageInfo
+
+func (m *Return) GetTrackingId() string {
+	if m != nil {
+		return m.TrackingId
+	}
+	return ""
+}
+
+func (m *Return) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *Return) GetShipmentTrackingIds() []string {
+	if m != nil {
+		return m.ShipmentTrackingIds
+	}
+	return nil
+}
+
+func (m *Return) GetItems() []*CartItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Return) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Return) GetStatus() ReturnStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ReturnStatus_RETURN_STATUS_UNSPECIFIED
+}
+
+func (m *Return) GetEvents() []*ReturnEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *Return) GetCarrierId() string {
+	if m != nil {
+		return m.CarrierId
+	}
+	return ""
+}
+
+func (m *Return) GetCarrierName() string {
+	if m != nil {
+		return m.CarrierName
+	}
+	return ""
+}
+
+func (m *Return) GetCarrierTrackingNumber() string {
+	if m != nil {
+		return m.CarrierTrackingNumber
+	}
+	return ""
+}
+
+func (m *Return) GetRefundId() string {
+	if m != nil {
+		return m.RefundId
+	}
+	return ""
+}
+
+type ListPickupPointsRequest struct {
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// How far from the address to look, in kilometres. Defaults to the
+	// service's default radius.
+	RadiusKm             float64  `protobuf:"fixed64,2,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPickupPointsRequest) Reset()         { *m = ListPickupPointsRequest{} }
+func (m *ListPickupPointsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPickupPointsRequest) ProtoMessage()    {}
+func (*ListPickupPointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{37}
+}
+
+func (m *ListPickupPointsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPickupPointsRequest.Unmarshal(m, b)
+}
+func (m *ListPickupPointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPickupPointsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPickupPointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPickupPointsRequest.Merge(m, src)
+}
+func (m *ListPickupPointsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPickupPointsRequest.Size(m)
+}
+func (m *ListPickupPointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPickupPointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPickupPointsRequest proto.InternalMessageInfo
+
+func (m *ListPickupPointsRequest) GetAddress() *Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *ListPickupPointsRequest) GetRadiusKm() float64 {
+	if m != nil {
+		return m.RadiusKm
+	}
+	return 0
+}
+
+type ListPickupPointsResponse struct {
+	// The pickup points within the radius, nearest first.
+	Points               []*PickupPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListPickupPointsResponse) Reset()         { *m = ListPickupPointsResponse{} }
+func (m *ListPickupPointsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPickupPointsResponse) ProtoMessage()    {}
+func (*ListPickupPointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{38}
+}
+
+func (m *ListPickupPointsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPickupPointsResponse.Unmarshal(m, b)
+}
+func (m *ListPickupPointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPickupPointsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPickupPointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPickupPointsResponse.Merge(m, src)
+}
+func (m *ListPickupPointsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPickupPointsResponse.Size(m)
+}
+func (m *ListPickupPointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPickupPointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPickupPointsResponse proto.InternalMessageInfo
+
+func (m *ListPickupPointsResponse) GetPoints() []*PickupPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+type GetPickupPointRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPickupPointRequest) Reset()         { *m = GetPickupPointRequest{} }
+func (m *GetPickupPointRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPickupPointRequest) ProtoMessage()    {}
+func (*GetPickupPointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{39}
+}
+
+func (m *GetPickupPointRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPickupPointRequest.Unmarshal(m, b)
+}
+func (m *GetPickupPointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPickupPointRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPickupPointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPickupPointRequest.Merge(m, src)
+}
+func (m *GetPickupPointRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPickupPointRequest.Size(m)
+}
+func (m *GetPickupPointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPickupPointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPickupPointRequest proto.InternalMessageInfo
+
+func (m *GetPickupPointRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// PickupPoint is a place where customers collect their orders.
+type PickupPoint struct {
+	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address   *Address `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Latitude  float64  `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64  `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// The distance from the address searched, when listed.
+	DistanceKm           float64  `protobuf:"fixed64,6,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	OpeningHours         string   `protobuf:"bytes,7,opt,name=opening_hours,json=openingHours,proto3" json:"opening_hours,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PickupPoint) Reset()         { *m = PickupPoint{} }
+func (m *PickupPoint) String() string { return proto.CompactTextString(m) }
+func (*PickupPoint) ProtoMessage()    {}
+func (*PickupPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{40}
+}
+
+func (m *PickupPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PickupPoint.Unmarshal(m, b)
+}
+func (m *PickupPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PickupPoint.Marshal(b, m, deterministic)
+}
+func (m *PickupPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PickupPoint.Merge(m, src)
+}
+func (m *PickupPoint) XXX_Size() int {
+	return xxx_messageInfo_PickupPoint.Size(m)
+}
+func (m *PickupPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_PickupPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PickupPoint proto.InternalMessageInfo
+
+func (m *PickupPoint) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PickupPoint) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PickupPoint) GetAddress() *Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *PickupPoint) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *PickupPoint) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *PickupPoint) GetDistanceKm() float64 {
+	if m != nil {
+		return m.DistanceKm
+	}
+	return 0
+}
+
+func (m *PickupPoint) GetOpeningHours() string {
+	if m != nil {
+		return m.OpeningHours
+	}
+	return ""
+}
+
+type Address struct {
+	StreetAddress string `protobuf:"bytes,1,opt,name=street_address,json=streetAddress,proto3" json:"street_address,omitempty"`
+	City          string `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	State         string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Country       string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	ZipCode       int32  `protobuf:"varint,5,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty"`
+	// The postal code as entered, which may contain letters. Takes
+	// precedence over zip_code when set.
+	PostalCode           string   `protobuf:"bytes,6,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Address) Reset()         { *m = Address{} }
+func (m *Address) String() string { return proto.CompactTextString(m) }
+func (*Address) ProtoMessage()    {}
+func (*Address) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{41}
+}
+
+func (m *Address) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Address.Unmarshal(m, b)
+}
+func (m *Address) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Address.Marshal(b, m, deterministic)
+}
+func (m *Address) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Address.Merge(m, src)
+}
+func (m *Address) XXX_Size() int {
+	return xxx_messageInfo_Address.Size(m)
+}
+func (m *Address) XXX_DiscardUnknown() {
+	xxx_messageInfo_Address.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Address proto.InternalMessageInfo
+
+func (m *Address) GetStreetAddress() string {
+	if m != nil {
+		return m.StreetAddress
+	}
+	return ""
+}
+
+func (m *Address) GetCity() string {
+	if m != nil {
+		return m.City
+	}
+	return ""
+}
+
+func (m *Address) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Address) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *Address) GetZipCode() int32 {
+	if m != nil {
+		return m.ZipCode
+	}
+	return 0
+}
+
+func (m *Address) GetPostalCode() string {
+	if m != nil {
+		return m.PostalCode
+	}
+	return ""
+}
+
+// Represents an amount of money with its currency type.
+type Money struct {
+	// The 3-letter currency code defined in ISO 4217.
+	CurrencyCode string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// The whole units of the amount.
+	// For example if `currencyCode` is `"USD"`, then 1 unit is one US dollar.
+	Units int64 `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	// Number of nano (10^-9) units of the amount.
+	// The value must be between -999,999,999 and +999,999,999 inclusive.
+	// If `units` is positive, `nanos` must be positive or zero.
+	// If `units` is zero, `nanos` can be positive, zero, or negative.
+	// If `units` is negative, `nanos` must be negative or zero.
+	// For example $-1.75 is represented as `units`=-1 and `nanos`=-750,000,000.
+	Nanos                int32    `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Money) Reset()         { *m = Money{} }
+func (m *Money) String() string { return proto.CompactTextString(m) }
+func (*Money) ProtoMessage()    {}
+func (*Money) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{42}
+}
+
+func (m *Money) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Money.Unmarshal(m, b)
+}
+func (m *Money) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Money.Marshal(b, m, deterministic)
+}
+func (m *Money) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Money.Merge(m, src)
+}
+func (m *Money) XXX_Size() int {
+	return xxx_messageInfo_Money.Size(m)
+}
+func (m *Money) XXX_DiscardUnknown() {
+	xxx_messageInfo_Money.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Money proto.InternalMessageInfo
+
+func (m *Money) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+func (m *Money) GetUnits() int64 {
+	if m != nil {
+		return m.Units
+	}
+	return 0
+}
+
+func (m *Money) GetNanos() int32 {
+	if m != nil {
+		return m.Nanos
+	}
+	return 0
+}
+
+type GetSupportedCurrenciesResponse struct {
+	// The 3-letter currency code defined in ISO 4217.
+	CurrencyCodes        []string `protobuf:"bytes,1,rep,name=currency_codes,json=currencyCodes,proto3" json:"currency_codes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSupportedCurrenciesResponse) Reset()         { *m = GetSupportedCurrenciesResponse{} }
+func (m *GetSupportedCurrenciesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSupportedCurrenciesResponse) ProtoMessage()    {}
+func (*GetSupportedCurrenciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{43}
+}
+
+func (m *GetSupportedCurrenciesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSupportedCurrenciesResponse.Unmarshal(m, b)
+}
+func (m *GetSupportedCurrenciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSupportedCurrenciesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSupportedCurrenciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSupportedCurrenciesResponse.Merge(m, src)
+}
+func (m *GetSupportedCurrenciesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSupportedCurrenciesResponse.Size(m)
+}
+func (m *GetSupportedCurrenciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSupportedCurrenciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSupportedCurrenciesResponse proto.InternalMessageInfo
+
+func (m *GetSupportedCurrenciesResponse) GetCurrencyCodes() []string {
+	if m != nil {
+		return m.CurrencyCodes
+	}
+	return nil
+}
+
+type CurrencyConversionRequest struct {
+	From *Money `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// The 3-letter currency code defined in ISO 4217.
+	ToCode               string   `protobuf:"bytes,2,opt,name=to_code,json=toCode,proto3" json:"to_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CurrencyConversionRequest) Reset()         { *m = CurrencyConversionRequest{} }
+func (m *CurrencyConversionRequest) String() string { return proto.CompactTextString(m) }
+func (*CurrencyConversionRequest) ProtoMessage()    {}
+func (*CurrencyConversionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{44}
+}
+
+func (m *CurrencyConversionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrencyConversionRequest.Unmarshal(m, b)
+}
+func (m *CurrencyConversionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrencyConversionRequest.Marshal(b, m, deterministic)
+}
+func (m *CurrencyConversionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyConversionRequest.Merge(m, src)
+}
+func (m *CurrencyConversionRequest) XXX_Size() int {
+	return xxx_messageInfo_CurrencyConversionRequest.Size(m)
+}
+func (m *CurrencyConversionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyConversionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyConversionRequest proto.InternalMessageInfo
+
+func (m *CurrencyConversionRequest) GetFrom() *Money {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *CurrencyConversionRequest) GetToCode() string {
+	if m != nil {
+		return m.ToCode
+	}
+	return ""
+}
+
+type CreditCardInfo struct {
+	CreditCardNumber          string   `protobuf:"bytes,1,opt,name=credit_card_number,json=creditCardNumber,proto3" json:"credit_card_number,omitempty"`
+	CreditCardCvv             int32    `protobuf:"varint,2,opt,name=credit_card_cvv,json=creditCardCvv,proto3" json:"credit_card_cvv,omitempty"`
+	CreditCardExpirationYear  int32    `protobuf:"varint,3,opt,name=credit_card_expiration_year,json=creditCardExpirationYear,proto3" json:"credit_card_expiration_year,omitempty"`
+	CreditCardExpirationMonth int32    `protobuf:"varint,4,opt,name=credit_card_expiration_month,json=creditCardExpirationMonth,proto3" json:"credit_card_expiration_month,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *CreditCardInfo) Reset()         { *m = CreditCardInfo{} }
+func (m *CreditCardInfo) String() string { return proto.CompactTextString(m) }
+func (*CreditCardInfo) ProtoMessage()    {}
+func (*CreditCardInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{45}
+}
+
+func (m *CreditCardInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreditCardInfo.Unmarshal(m, b)
+}
+func (m *CreditCardInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreditCardInfo.Marshal(b, m, deterministic)
+}
+func (m *CreditCardInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditCardInfo.Merge(m, src)
+}
+func (m *CreditCardInfo) XXX_Size() int {
+	return xxx_messageInfo_CreditCardInfo.Size(m)
+}
+func (m *CreditCardInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditCardInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditCardInfo proto.InternalMessageInfo
+
+func (m *CreditCardInfo) GetCreditCardNumber() string {
+	if m != nil {
+		return m.CreditCardNumber
+	}
+	return ""
+}
+
+func (m *CreditCardInfo) GetCreditCardCvv() int32 {
+	if m != nil {
+		return m.CreditCardCvv
+	}
+	return 0
+}
+
+func (m *CreditCardInfo) GetCreditCardExpirationYear() int32 {
+	if m != nil {
+		return m.CreditCardExpirationYear
+	}
+	return 0
+}
+
+func (m *CreditCardInfo) GetCreditCardExpirationMonth() int32 {
+	if m != nil {
+		return m.CreditCardExpirationMonth
+	}
+	return 0
+}
+
+type ChargeRequest struct {
+	Amount               *Money          `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreditCard           *CreditCardInfo `protobuf:"bytes,2,opt,name=credit_card,json=creditCard,proto3" json:"credit_card,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ChargeRequest) Reset()         { *m = ChargeRequest{} }
+func (m *ChargeRequest) String() string { return proto.CompactTextString(m) }
+func (*ChargeRequest) ProtoMessage()    {}
+func (*ChargeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{46}
+}
+
+func (m *ChargeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChargeRequest.Unmarshal(m, b)
+}
+func (m *ChargeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChargeRequest.Marshal(b, m, deterministic)
+}
+func (m *ChargeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChargeRequest.Merge(m, src)
+}
+func (m *ChargeRequest) XXX_Size() int {
+	return xxx_messageInfo_ChargeRequest.Size(m)
+}
+func (m *ChargeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChargeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChargeRequest proto.InternalMessageInfo
+
+func (m *ChargeRequest) GetAmount() *Money {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *ChargeRequest) GetCreditCard() *CreditCardInfo {
+	if m != nil {
+		return m.CreditCard
+	}
+	return nil
+}
+
+type ChargeResponse struct {
+	TransactionId        string   `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChargeResponse) Reset()         { *m = ChargeResponse{} }
+func (m *ChargeResponse) String() string { return proto.CompactTextString(m) }
+func (*ChargeResponse) ProtoMessage()    {}
+func (*ChargeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{47}
+}
+
+func (m *ChargeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChargeResponse.Unmarshal(m, b)
+}
+func (m *ChargeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChargeResponse.Marshal(b, m, deterministic)
+}
+func (m *ChargeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChargeResponse.Merge(m, src)
+}
+func (m *ChargeResponse) XXX_Size() int {
+	return xxx_messageInfo_ChargeResponse.Size(m)
+}
+func (m *ChargeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChargeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChargeResponse proto.InternalMessageInfo
+
+func (m *ChargeResponse) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+type RefundRequest struct {
+	// The transaction of the charge to refund, in part or in full.
+	TransactionId        string   `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount               *Money   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefundRequest) Reset()         { *m = RefundRequest{} }
+func (m *RefundRequest) String() string { return proto.CompactTextString(m) }
+func (*RefundRequest) ProtoMessage()    {}
+func (*RefundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{48}
+}
+
+func (m *RefundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefundRequest.Unmarshal(m, b)
+}
+func (m *RefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefundRequest.Marshal(b, m, deterministic)
+}
+func (m *RefundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundRequest.Merge(m, src)
+}
+func (m *RefundRequest) XXX_Size() int {
+	return xxx_messageInfo_RefundRequest.Size(m)
+}
+func (m *RefundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundRequest proto.InternalMessageInfo
+
+func (m *RefundRequest) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+func (m *RefundRequest) GetAmount() *Money {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type RefundResponse struct {
+	RefundId             string   `protobuf:"bytes,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefundResponse) Reset()         { *m = RefundResponse{} }
+func (m *RefundResponse) String() string { return proto.CompactTextString(m) }
+func (*RefundResponse) ProtoMessage()    {}
+func (*RefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{49}
+}
+
+func (m *RefundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefundResponse.Unmarshal(m, b)
+}
+func (m *RefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefundResponse.Marshal(b, m, deterministic)
+}
+func (m *RefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundResponse.Merge(m, src)
+}
+func (m *RefundResponse) XXX_Size() int {
+	return xxx_messageInfo_RefundResponse.Size(m)
+}
+func (m *RefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundResponse proto.InternalMessageInfo
+
+func (m *RefundResponse) GetRefundId() string {
+	if m != nil {
+		return m.RefundId
+	}
+	return ""
+}
+
+type OrderItem struct {
+	Item                 *CartItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Cost                 *Money    `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *OrderItem) Reset()         { *m = OrderItem{} }
+func (m *OrderItem) String() string { return proto.CompactTextString(m) }
+func (*OrderItem) ProtoMessage()    {}
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{50}
+}
+
+func (m *OrderItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderItem.Unmarshal(m, b)
+}
+func (m *OrderItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderItem.Marshal(b, m, deterministic)
+}
+func (m *OrderItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderItem.Merge(m, src)
+}
+func (m *OrderItem) XXX_Size() int {
+	return xxx_messageInfo_OrderItem.Size(m)
+}
+func (m *OrderItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderItem proto.InternalMessageInfo
+
+func (m *OrderItem) GetItem() *CartItem {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *OrderItem) GetCost() *Money {
+	if m != nil {
+		return m.Cost
+	}
+	return nil
+}
+
+type OrderResult struct {
+	OrderId            string       `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ShippingTrackingId string       `protobuf:"bytes,2,opt,name=shipping_tracking_id,json=shippingTrackingId,proto3" json:"shipping_tracking_id,omitempty"`
+	ShippingCost       *Money       `protobuf:"bytes,3,opt,name=shipping_cost,json=shippingCost,proto3" json:"shipping_cost,omitempty"`
+	ShippingAddress    *Address     `protobuf:"bytes,4,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	Items              []*OrderItem `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	// The promotion included in the shipping cost, if any.
+	ShippingPromotion *ShippingPromotion `protobuf:"bytes,6,opt,name=shipping_promotion,json=shippingPromotion,proto3" json:"shipping_promotion,omitempty"`
+	// The parcels the order was shipped in. shipping_tracking_id is the
+	// tracking ID of the first one.
+	Parcels []*ShippedParcel `protobuf:"bytes,7,rep,name=parcels,proto3" json:"parcels,omitempty"`
+	// The import duties and taxes of a cross-border order, and whether they
+	// were paid with the order or are due on delivery.
+	Duties        *DutiesEstimate `protobuf:"bytes,8,opt,name=duties,proto3" json:"duties,omitempty"`
+	DutiesPrepaid bool            `protobuf:"varint,9,opt,name=duties_prepaid,json=dutiesPrepaid,proto3" json:"duties_prepaid,omitempty"`
+	// The pickup point the order was shipped to, at shipping_address. Empty
+	// for home delivery.
+	PickupPoint *PickupPoint `protobuf:"bytes,10,opt,name=pickup_point,json=pickupPoint,proto3" json:"pickup_point,omitempty"`
+	// The promotions taken off the items, in the order they were applied.
+	Discounts []*Discount `protobuf:"bytes,11,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	// The sales taxes and VAT of the order. Taxes that are not included in
+	// the prices are added to the total.
+	Taxes                []*TaxLine `protobuf:"bytes,12,rep,name=taxes,proto3" json:"taxes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *OrderResult) Reset()         { *m = OrderResult{} }
+func (m *OrderResult) String() string { return proto.CompactTextString(m) }
+func (*OrderResult) ProtoMessage()    {}
+func (*OrderResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{51}
+}
+
+func (m *OrderResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderResult.Unmarshal(m, b)
+}
+func (m *OrderResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderResult.Marshal(b, m, deterministic)
+}
+func (m *OrderResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderResult.Merge(m, src)
+}
+func (m *OrderResult) XXX_Size() int {
+	return xxx_messageInfo_OrderResult.Size(m)
+}
+func (m *OrderResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderResult proto.InternalMessageInfo
+
+func (m *OrderResult) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *OrderResult) GetShippingTrackingId() string {
+	if m != nil {
+		return m.ShippingTrackingId
+	}
+	return ""
+}
+
+func (m *OrderResult) GetShippingCost() *Money {
+	if m != nil {
+		return m.ShippingCost
+	}
+	return nil
+}
+
+func (m *OrderResult) GetShippingAddress() *Address {
+	if m != nil {
+		return m.ShippingAddress
+	}
+	return nil
+}
+
+func (m *OrderResult) GetItems() []*OrderItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *OrderResult) GetShippingPromotion() *ShippingPromotion {
+	if m != nil {
+		return m.ShippingPromotion
+	}
+	return nil
+}
+
+func (m *OrderResult) GetParcels() []*ShippedParcel {
+	if m != nil {
+		return m.Parcels
+	}
+	return nil
+}
+
+func (m *OrderResult) GetDuties() *DutiesEstimate {
+	if m != nil {
+		return m.Duties
+	}
+	return nil
+}
+
+func (m *OrderResult) GetDutiesPrepaid() bool {
+	if m != nil {
+		return m.DutiesPrepaid
+	}
+	return false
+}
+
+func (m *OrderResult) GetPickupPoint() *PickupPoint {
+	if m != nil {
+		return m.PickupPoint
+	}
+	return nil
+}
+
+func (m *OrderResult) GetDiscounts() []*Discount {
+	if m != nil {
+		return m.Discounts
+	}
+	return nil
+}
+
+func (m *OrderResult) GetTaxes() []*TaxLine {
+	if m != nil {
+		return m.Taxes
+	}
+	return nil
+}
+
+// Discount is a promotion taken off the items of an order.
+type Discount struct {
+	PromotionId string `protobuf:"bytes,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The amount taken off, which is positive.
+	Amount               *Money   `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Discount) Reset()         { *m = Discount{} }
+func (m *Discount) String() string { return proto.CompactTextString(m) }
+func (*Discount) ProtoMessage()    {}
+func (*Discount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{52}
+}
+
+func (m *Discount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Discount.Unmarshal(m, b)
+}
+func (m *Discount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Discount.Marshal(b, m, deterministic)
+}
+func (m *Discount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Discount.Merge(m, src)
+}
+func (m *Discount) XXX_Size() int {
+	return xxx_messageInfo_Discount.Size(m)
+}
+func (m *Discount) XXX_DiscardUnknown() {
+	xxx_messageInfo_Discount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Discount proto.InternalMessageInfo
+
+func (m *Discount) GetPromotionId() string {
+	if m != nil {
+		return m.PromotionId
+	}
+	return ""
+}
+
+func (m *Discount) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Discount) GetAmount() *Money {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// TaxLine is a tax of a jurisdiction, at one rate, on part of an order.
+type TaxLine struct {
+	JurisdictionId string `protobuf:"bytes,1,opt,name=jurisdiction_id,json=jurisdictionId,proto3" json:"jurisdiction_id,omitempty"`
+	Description    string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The rate in percent, such as "7.25".
+	Rate string `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	// The amount the rate applies to, before tax.
+	TaxableAmount *Money `protobuf:"bytes,4,opt,name=taxable_amount,json=taxableAmount,proto3" json:"taxable_amount,omitempty"`
+	Amount        *Money `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Whether the tax is included in the prices, as VAT usually is, rather
+	// than added to the total.
+	Included             bool     `protobuf:"varint,6,opt,name=included,proto3" json:"included,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaxLine) Reset()         { *m = TaxLine{} }
+func (m *TaxLine) String() string { return proto.CompactTextString(m) }
+func (*TaxLine) ProtoMessage()    {}
+func (*TaxLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{53}
+}
+
+func (m *TaxLine) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TaxLine.Unmarshal(m, b)
+}
+func (m *TaxLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TaxLine.Marshal(b, m, deterministic)
+}
+func (m *TaxLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxLine.Merge(m, src)
+}
+func (m *TaxLine) XXX_Size() int {
+	return xxx_messageInfo_TaxLine.Size(m)
+}
+func (m *TaxLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxLine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxLine proto.InternalMessageInfo
+
+func (m *TaxLine) GetJurisdictionId() string {
+	if m != nil {
+		return m.JurisdictionId
+	}
+	return ""
+}
+
+func (m *TaxLine) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *TaxLine) GetRate() string {
+	if m != nil {
+		return m.Rate
+	}
+	return ""
+}
+
+func (m *TaxLine) GetTaxableAmount() *Money {
+	if m != nil {
+		return m.TaxableAmount
+	}
+	return nil
+}
+
+func (m *TaxLine) GetAmount() *Money {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *TaxLine) GetIncluded() bool {
+	if m != nil {
+		return m.Included
+	}
+	return false
+}
+
+type SendOrderConfirmationRequest struct {
+	Email                string       `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Order                *OrderResult `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SendOrderConfirmationRequest) Reset()         { *m = SendOrderConfirmationRequest{} }
+func (m *SendOrderConfirmationRequest) String() string { return proto.CompactTextString(m) }
+func (*SendOrderConfirmationRequest) ProtoMessage()    {}
+func (*SendOrderConfirmationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{54}
+}
+
+func (m *SendOrderConfirmationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendOrderConfirmationRequest.Unmarshal(m, b)
+}
+func (m *SendOrderConfirmationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendOrderConfirmationRequest.Marshal(b, m, deterministic)
+}
+func (m *SendOrderConfirmationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendOrderConfirmationRequest.Merge(m, src)
+}
+func (m *SendOrderConfirmationRequest) XXX_Size() int {
+	return xxx_messageInfo_SendOrderConfirmationRequest.Size(m)
+}
+func (m *SendOrderConfirmationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendOrderConfirmationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendOrderConfirmationRequest proto.InternalMessageInfo
+
+func (m *SendOrderConfirmationRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *SendOrderConfirmationRequest) GetOrder() *OrderResult {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+type PlaceOrderRequest struct {
+	UserId       string          `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserCurrency string          `protobuf:"bytes,2,opt,name=user_currency,json=userCurrency,proto3" json:"user_currency,omitempty"`
+	Address      *Address        `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Email        string          `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	CreditCard   *CreditCardInfo `protobuf:"bytes,6,opt,name=credit_card,json=creditCard,proto3" json:"credit_card,omitempty"`
+	// The shipping option chosen by the user. Defaults to "standard".
+	ShippingOptionId string `protobuf:"bytes,7,opt,name=shipping_option_id,json=shippingOptionId,proto3" json:"shipping_option_id,omitempty"`
+	// A promo code to discount shipping.
+	ShippingPromoCode string `protobuf:"bytes,8,opt,name=shipping_promo_code,json=shippingPromoCode,proto3" json:"shipping_promo_code,omitempty"`
+	// Whether to pay the import duties and taxes of a cross-border order with
+	// the order (DDP), rather than on delivery (DDU).
+	PrepayDuties bool `protobuf:"varint,9,opt,name=prepay_duties,json=prepayDuties,proto3" json:"prepay_duties,omitempty"`
+	// The pickup point to ship the order to, instead of the address.
+	PickupPointId string `protobuf:"bytes,10,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	// A key chosen by the client for this order, so that a retried request
+	// returns the order already placed instead of placing it again.
+	IdempotencyKey string `protobuf:"bytes,11,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// A promo code to discount the items.
+	PromoCode            string   `protobuf:"bytes,12,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlaceOrderRequest) Reset()         { *m = PlaceOrderRequest{} }
+func (m *PlaceOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*PlaceOrderRequest) ProtoMessage()    {}
+func (*PlaceOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{55}
+}
+
+func (m *PlaceOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaceOrderRequest.Unmarshal(m, b)
+}
+func (m *PlaceOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaceOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *PlaceOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaceOrderRequest.Merge(m, src)
+}
+func (m *PlaceOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_PlaceOrderRequest.Size(m)
+}
+func (m *PlaceOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaceOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaceOrderRequest proto.InternalMessageInfo
+
+func (m *PlaceOrderRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *PlaceOrderRequest) GetUserCurrency() string {
+	if m != nil {
+		return m.UserCurrency
+	}
+	return ""
+}
+
+func (m *PlaceOrderRequest) GetAddress() *Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *PlaceOrderRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *PlaceOrderRequest) GetCreditCard() *CreditCardInfo {
+	if m != nil {
+		return m.CreditCard
+	}
+	return nil
+}
+
+func (m *PlaceOrderRequest) GetShippingOptionId() string {
+	if m != nil {
+		return m.ShippingOptionId
+	}
+	return ""
+}
+
+func (m *PlaceOrderRequest) GetShippingPromoCode() string {
+	if m != nil {
+		return m.ShippingPromoCode
+	}
+	return ""
+}
+
+func (m *PlaceOrderRequest) GetPrepayDuties() bool {
+	if m != nil {
+		return m.PrepayDuties
+	}
+	return false
+}
+
+func (m *PlaceOrderRequest) GetPickupPointId() string {
+	if m != nil {
+		return m.PickupPointId
+	}
+	return ""
+}
+
+func (m *PlaceOrderRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+func (m *PlaceOrderRequest) GetPromoCode() string {
+	if m != nil {
+		return m.PromoCode
+	}
+	return ""
+}
+
+type PlaceOrderResponse struct {
+	Order                *OrderResult `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PlaceOrderResponse) Reset()         { *m = PlaceOrderResponse{} }
+func (m *PlaceOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*PlaceOrderResponse) ProtoMessage()    {}
+func (*PlaceOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{56}
+}
+
+func (m *PlaceOrderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaceOrderResponse.Unmarshal(m, b)
+}
+func (m *PlaceOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaceOrderResponse.Marshal(b, m, deterministic)
+}
+func (m *PlaceOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaceOrderResponse.Merge(m, src)
+}
+func (m *PlaceOrderResponse) XXX_Size() int {
+	return xxx_messageInfo_PlaceOrderResponse.Size(m)
+}
+func (m *PlaceOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaceOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaceOrderResponse proto.InternalMessageInfo
+
+func (m *PlaceOrderResponse) GetOrder() *OrderResult {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+// RefundReturnRequest is sent by the shipping service when the items of a
+// return are received.
+type RefundReturnRequest struct {
+	// The tracking ID of the return label. Refunds are issued once per return.
+	ReturnId             string      `protobuf:"bytes,1,opt,name=return_id,json=returnId,proto3" json:"return_id,omitempty"`
+	OrderId              string      `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items                []*CartItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RefundReturnRequest) Reset()         { *m = RefundReturnRequest{} }
+func (m *RefundReturnRequest) String() string { return proto.CompactTextString(m) }
+func (*RefundReturnRequest) ProtoMessage()    {}
+func (*RefundReturnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{57}
+}
+
+func (m *RefundReturnRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefundReturnRequest.Unmarshal(m, b)
+}
+func (m *RefundReturnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefundReturnRequest.Marshal(b, m, deterministic)
+}
+func (m *RefundReturnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundReturnRequest.Merge(m, src)
 }
-func (m *CreditCardInfo) XXX_Size() int {
-	return xxx_messageInfo_CreditCardInfo.Size(m)
+func (m *RefundReturnRequest) XXX_Size() int {
+	return xxx_messageInfo_RefundReturnRequest.Size(m)
 }
-func (m *CreditCardInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreditCardInfo.DiscardUnknown(m)
+func (m *RefundReturnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundReturnRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreditCardInfo proto.InternalMessageInfo
+var xxx_messageInfo_RefundReturnRequest proto.InternalMessageInfo
 
-func (m *CreditCardInfo) GetCreditCardNumber() string {
+func (m *RefundReturnRequest) GetReturnId() string {
 	if m != nil {
-		return m.CreditCardNumber
+		return m.ReturnId
 	}
 	return ""
 }
 
-func (m *CreditCardInfo) GetCreditCardCvv() int32 {
-	if m != nil {
-		return m.CreditCardCvv
-	}
-	return 0
-}
-
-func (m *CreditCardInfo) GetCreditCardExpirationYear() int32 {
+func (m *RefundReturnRequest) GetOrderId() string {
 	if m != nil {
-		return m.CreditCardExpirationYear
+		return m.OrderId
 	}
-	return 0
+	return ""
 }
 
-func (m *CreditCardInfo) GetCreditCardExpirationMonth() int32 {
+func (m *RefundReturnRequest) GetItems() []*CartItem {
 	if m != nil {
-		return m.CreditCardExpirationMonth
+		return m.Items
 	}
-	return 0
+	return nil
 }
 
-type ChargeRequest struct {
-	Amount               *Money          `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	CreditCard           *CreditCardInfo `protobuf:"bytes,2,opt,name=credit_card,json=creditCard,proto3" json:"credit_card,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type RefundReturnResponse struct {
+	RefundId             string   `protobuf:"bytes,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	Amount               *Money   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChargeRequest) Reset()         { *m = ChargeRequest{} }
-func (m *ChargeRequest) String() string { return proto.CompactTextString(m) }
-func (*ChargeRequest) ProtoMessage()    {}
-func (*ChargeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{22}
+func (m *RefundReturnResponse) Reset()         { *m = RefundReturnResponse{} }
+func (m *RefundReturnResponse) String() string { return proto.CompactTextString(m) }
+func (*RefundReturnResponse) ProtoMessage()    {}
+func (*RefundReturnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{58}
 }
 
-func (m *ChargeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChargeRequest.Unmarshal(m, b)
+func (m *RefundReturnResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefundReturnResponse.Unmarshal(m, b)
 }
-func (m *ChargeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChargeRequest.Marshal(b, m, deterministic)
+func (m *RefundReturnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefundReturnResponse.Marshal(b, m, deterministic)
 }
-func (m *ChargeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChargeRequest.Merge(m, src)
+func (m *RefundReturnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundReturnResponse.Merge(m, src)
 }
-func (m *ChargeRequest) XXX_Size() int {
-	return xxx_messageInfo_ChargeRequest.Size(m)
+func (m *RefundReturnResponse) XXX_Size() int {
+	return xxx_messageInfo_RefundReturnResponse.Size(m)
 }
-func (m *ChargeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChargeRequest.DiscardUnknown(m)
+func (m *RefundReturnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundReturnResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChargeRequest proto.InternalMessageInfo
+var xxx_messageInfo_RefundReturnResponse proto.InternalMessageInfo
 
-func (m *ChargeRequest) GetAmount() *Money {
+func (m *RefundReturnResponse) GetRefundId() string {
 	if m != nil {
-		return m.Amount
+		return m.RefundId
 	}
-	return nil
+	return ""
 }
 
-func (m *ChargeRequest) GetCreditCard() *CreditCardInfo {
+func (m *RefundReturnResponse) GetAmount() *Money {
 	if m != nil {
-		return m.CreditCard
+		return m.Amount
 	}
 	return nil
 }
 
-type ChargeResponse struct {
-	TransactionId        string   `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+// OrderStep is a step of placing an order, or of undoing it.
+type OrderStep struct {
+	Step string `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+	// done, failed, compensated or compensation_failed.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// When the step ended, in RFC 3339 format.
+	Time                 string   `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChargeResponse) Reset()         { *m = ChargeResponse{} }
-func (m *ChargeResponse) String() string { return proto.CompactTextString(m) }
-func (*ChargeResponse) ProtoMessage()    {}
-func (*ChargeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{23}
+func (m *OrderStep) Reset()         { *m = OrderStep{} }
+func (m *OrderStep) String() string { return proto.CompactTextString(m) }
+func (*OrderStep) ProtoMessage()    {}
+func (*OrderStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{59}
 }
 
-func (m *ChargeResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChargeResponse.Unmarshal(m, b)
+func (m *OrderStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderStep.Unmarshal(m, b)
 }
-func (m *ChargeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChargeResponse.Marshal(b, m, deterministic)
+func (m *OrderStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderStep.Marshal(b, m, deterministic)
 }
-func (m *ChargeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChargeResponse.Merge(m, src)
+func (m *OrderStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderStep.Merge(m, src)
 }
-func (m *ChargeResponse) XXX_Size() int {
-	return xxx_messageInfo_ChargeResponse.Size(m)
+func (m *OrderStep) XXX_Size() int {
+	return xxx_messageInfo_OrderStep.Size(m)
 }
-func (m *ChargeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChargeResponse.DiscardUnknown(m)
+func (m *OrderStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderStep.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChargeResponse proto.InternalMessageInfo
+var xxx_messageInfo_OrderStep proto.InternalMessageInfo
 
-func (m *ChargeResponse) GetTransactionId() string {
+func (m *OrderStep) GetStep() string {
 	if m != nil {
-		return m.TransactionId
+		return m.Step
 	}
 	return ""
 }
 
-type OrderItem struct {
-	Item                 *CartItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
-	Cost                 *Money    `protobuf:"bytes,2,opt,name=cost,proto3" json:"cost,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
-}
-
-func (m *OrderItem) Reset()         { *m = OrderItem{} }
-func (m *OrderItem) String() string { return proto.CompactTextString(m) }
-func (*OrderItem) ProtoMessage()    {}
-func (*OrderItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{24}
-}
-
-func (m *OrderItem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OrderItem.Unmarshal(m, b)
-}
-func (m *OrderItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OrderItem.Marshal(b, m, deterministic)
-}
-func (m *OrderItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderItem.Merge(m, src)
-}
-func (m *OrderItem) XXX_Size() int {
-	return xxx_messageInfo_OrderItem.Size(m)
-}
-func (m *OrderItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderItem.DiscardUnknown(m)
+func (m *OrderStep) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-var xxx_messageInfo_OrderItem proto.InternalMessageInfo
-
-func (m *OrderItem) GetItem() *CartItem {
+func (m *OrderStep) GetError() string {
 	if m != nil {
-		return m.Item
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderItem) GetCost() *Money {
+func (m *OrderStep) GetTime() string {
 	if m != nil {
-		return m.Cost
+		return m.Time
 	}
-	return nil
+	return ""
 }
 
-type OrderResult struct {
-	OrderId              string       `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	ShippingTrackingId   string       `protobuf:"bytes,2,opt,name=shipping_tracking_id,json=shippingTrackingId,proto3" json:"shipping_tracking_id,omitempty"`
-	ShippingCost         *Money       `protobuf:"bytes,3,opt,name=shipping_cost,json=shippingCost,proto3" json:"shipping_cost,omitempty"`
-	ShippingAddress      *Address     `protobuf:"bytes,4,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
-	Items                []*OrderItem `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+// Order is an order as persisted by the checkout service.
+type Order struct {
+	OrderId string      `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId  string      `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email   string      `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Status  OrderStatus `protobuf:"varint,4,opt,name=status,proto3,enum=hipstershop.OrderStatus" json:"status,omitempty"`
+	// Set once the order is placed.
+	Result    *OrderResult `protobuf:"bytes,5,opt,name=result,proto3" json:"result,omitempty"`
+	TotalPaid *Money       `protobuf:"bytes,6,opt,name=total_paid,json=totalPaid,proto3" json:"total_paid,omitempty"`
+	// When the order was submitted, in RFC 3339 format.
+	CreatedAt            string       `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Steps                []*OrderStep `protobuf:"bytes,8,rep,name=steps,proto3" json:"steps,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *OrderResult) Reset()         { *m = OrderResult{} }
-func (m *OrderResult) String() string { return proto.CompactTextString(m) }
-func (*OrderResult) ProtoMessage()    {}
-func (*OrderResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{25}
+func (m *Order) Reset()         { *m = Order{} }
+func (m *Order) String() string { return proto.CompactTextString(m) }
+func (*Order) ProtoMessage()    {}
+func (*Order) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{60}
 }
 
-func (m *OrderResult) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OrderResult.Unmarshal(m, b)
+func (m *Order) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Order.Unmarshal(m, b)
 }
-func (m *OrderResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OrderResult.Marshal(b, m, deterministic)
+func (m *Order) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Order.Marshal(b, m, deterministic)
 }
-func (m *OrderResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderResult.Merge(m, src)
+func (m *Order) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order.Merge(m, src)
 }
-func (m *OrderResult) XXX_Size() int {
-	return xxx_messageInfo_OrderResult.Size(m)
+func (m *Order) XXX_Size() int {
+	return xxx_messageInfo_Order.Size(m)
 }
-func (m *OrderResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderResult.DiscardUnknown(m)
+func (m *Order) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OrderResult proto.InternalMessageInfo
+var xxx_messageInfo_Order proto.InternalMessageInfo
 
-func (m *OrderResult) GetOrderId() string {
+func (m *Order) GetOrderId() string {
 	if m != nil {
 		return m.OrderId
 	}
 	return ""
 }
 
-func (m *OrderResult) GetShippingTrackingId() string {
+func (m *Order) GetUserId() string {
 	if m != nil {
-		return m.ShippingTrackingId
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *OrderResult) GetShippingCost() *Money {
+func (m *Order) GetEmail() string {
 	if m != nil {
-		return m.ShippingCost
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Order) GetStatus() OrderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (m *Order) GetResult() *OrderResult {
+	if m != nil {
+		return m.Result
 	}
 	return nil
 }
 
-func (m *OrderResult) GetShippingAddress() *Address {
+func (m *Order) GetTotalPaid() *Money {
 	if m != nil {
-		return m.ShippingAddress
+		return m.TotalPaid
 	}
 	return nil
 }
 
-func (m *OrderResult) GetItems() []*OrderItem {
+func (m *Order) GetCreatedAt() string {
 	if m != nil {
-		return m.Items
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *Order) GetSteps() []*OrderStep {
+	if m != nil {
+		return m.Steps
 	}
 	return nil
 }
 
-type SendOrderConfirmationRequest struct {
-	Email                string       `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Order                *OrderResult `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type GetOrderRequest struct {
+	OrderId              string   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SendOrderConfirmationRequest) Reset()         { *m = SendOrderConfirmationRequest{} }
-func (m *SendOrderConfirmationRequest) String() string { return proto.CompactTextString(m) }
-func (*SendOrderConfirmationRequest) ProtoMessage()    {}
-func (*SendOrderConfirmationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{26}
+func (m *GetOrderRequest) Reset()         { *m = GetOrderRequest{} }
+func (m *GetOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrderRequest) ProtoMessage()    {}
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{61}
 }
 
-func (m *SendOrderConfirmationRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SendOrderConfirmationRequest.Unmarshal(m, b)
+func (m *GetOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderRequest.Unmarshal(m, b)
 }
-func (m *SendOrderConfirmationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SendOrderConfirmationRequest.Marshal(b, m, deterministic)
+func (m *GetOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderRequest.Marshal(b, m, deterministic)
 }
-func (m *SendOrderConfirmationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SendOrderConfirmationRequest.Merge(m, src)
+func (m *GetOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderRequest.Merge(m, src)
 }
-func (m *SendOrderConfirmationRequest) XXX_Size() int {
-	return xxx_messageInfo_SendOrderConfirmationRequest.Size(m)
+func (m *GetOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrderRequest.Size(m)
 }
-func (m *SendOrderConfirmationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SendOrderConfirmationRequest.DiscardUnknown(m)
+func (m *GetOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SendOrderConfirmationRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetOrderRequest proto.InternalMessageInfo
 
-func (m *SendOrderConfirmationRequest) GetEmail() string {
+func (m *GetOrderRequest) GetOrderId() string {
 	if m != nil {
-		return m.Email
+		return m.OrderId
 	}
 	return ""
 }
 
-func (m *SendOrderConfirmationRequest) GetOrder() *OrderResult {
+type ListOrdersRequest struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The maximum number of orders to return. Defaults to 10, and is at
+	// most 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous page, if any.
+	PageToken            string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListOrdersRequest) Reset()         { *m = ListOrdersRequest{} }
+func (m *ListOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOrdersRequest) ProtoMessage()    {}
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{62}
+}
+
+func (m *ListOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrdersRequest.Unmarshal(m, b)
+}
+func (m *ListOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrdersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrdersRequest.Merge(m, src)
+}
+func (m *ListOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListOrdersRequest.Size(m)
+}
+func (m *ListOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrdersRequest proto.InternalMessageInfo
+
+func (m *ListOrdersRequest) GetUserId() string {
 	if m != nil {
-		return m.Order
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ListOrdersRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListOrdersRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListOrdersResponse struct {
+	// The orders placed by the user, newest first.
+	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// Set when there are more orders.
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListOrdersResponse) Reset()         { *m = ListOrdersResponse{} }
+func (m *ListOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOrdersResponse) ProtoMessage()    {}
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{63}
+}
+
+func (m *ListOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrdersResponse.Unmarshal(m, b)
+}
+func (m *ListOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrdersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrdersResponse.Merge(m, src)
+}
+func (m *ListOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListOrdersResponse.Size(m)
+}
+func (m *ListOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrdersResponse proto.InternalMessageInfo
+
+func (m *ListOrdersResponse) GetOrders() []*Order {
+	if m != nil {
+		return m.Orders
 	}
 	return nil
 }
 
-type PlaceOrderRequest struct {
-	UserId               string          `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserCurrency         string          `protobuf:"bytes,2,opt,name=user_currency,json=userCurrency,proto3" json:"user_currency,omitempty"`
-	Address              *Address        `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Email                string          `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
-	CreditCard           *CreditCardInfo `protobuf:"bytes,6,opt,name=credit_card,json=creditCard,proto3" json:"credit_card,omitempty"`
+func (m *ListOrdersResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// QuoteTaxesRequest asks for the taxes of a cart, before it is ordered.
+type QuoteTaxesRequest struct {
+	Address      *Address    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	UserCurrency string      `protobuf:"bytes,2,opt,name=user_currency,json=userCurrency,proto3" json:"user_currency,omitempty"`
+	Items        []*CartItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// The shipping quote of the cart. Orders that pay import taxes with
+	// their duties pay no sales tax or VAT.
+	ShippingCost         *Money          `protobuf:"bytes,4,opt,name=shipping_cost,json=shippingCost,proto3" json:"shipping_cost,omitempty"`
+	Duties               *DutiesEstimate `protobuf:"bytes,5,opt,name=duties,proto3" json:"duties,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *PlaceOrderRequest) Reset()         { *m = PlaceOrderRequest{} }
-func (m *PlaceOrderRequest) String() string { return proto.CompactTextString(m) }
-func (*PlaceOrderRequest) ProtoMessage()    {}
-func (*PlaceOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{27}
+func (m *QuoteTaxesRequest) Reset()         { *m = QuoteTaxesRequest{} }
+func (m *QuoteTaxesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuoteTaxesRequest) ProtoMessage()    {}
+func (*QuoteTaxesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{64}
 }
 
-func (m *PlaceOrderRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PlaceOrderRequest.Unmarshal(m, b)
+func (m *QuoteTaxesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuoteTaxesRequest.Unmarshal(m, b)
 }
-func (m *PlaceOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PlaceOrderRequest.Marshal(b, m, deterministic)
+func (m *QuoteTaxesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuoteTaxesRequest.Marshal(b, m, deterministic)
 }
-func (m *PlaceOrderRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlaceOrderRequest.Merge(m, src)
+func (m *QuoteTaxesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteTaxesRequest.Merge(m, src)
 }
-func (m *PlaceOrderRequest) XXX_Size() int {
-	return xxx_messageInfo_PlaceOrderRequest.Size(m)
+func (m *QuoteTaxesRequest) XXX_Size() int {
+	return xxx_messageInfo_QuoteTaxesRequest.Size(m)
 }
-func (m *PlaceOrderRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlaceOrderRequest.DiscardUnknown(m)
+func (m *QuoteTaxesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteTaxesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PlaceOrderRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuoteTaxesRequest proto.InternalMessageInfo
 
-func (m *PlaceOrderRequest) GetUserId() string {
+func (m *QuoteTaxesRequest) GetAddress() *Address {
 	if m != nil {
-		return m.UserId
+		return m.Address
 	}
-	return ""
+	return nil
 }
 
-func (m *PlaceOrderRequest) GetUserCurrency() string {
+func (m *QuoteTaxesRequest) GetUserCurrency() string {
 	if m != nil {
 		return m.UserCurrency
 	}
 	return ""
 }
 
-func (m *PlaceOrderRequest) GetAddress() *Address {
+func (m *QuoteTaxesRequest) GetItems() []*CartItem {
 	if m != nil {
-		return m.Address
+		return m.Items
 	}
 	return nil
 }
 
-func (m *PlaceOrderRequest) GetEmail() string {
+func (m *QuoteTaxesRequest) GetShippingCost() *Money {
 	if m != nil {
-		return m.Email
+		return m.ShippingCost
 	}
-	return ""
+	return nil
 }
 
-func (m *PlaceOrderRequest) GetCreditCard() *CreditCardInfo {
+func (m *QuoteTaxesRequest) GetDuties() *DutiesEstimate {
 	if m != nil {
-		return m.CreditCard
+		return m.Duties
 	}
 	return nil
 }
 
-type PlaceOrderResponse struct {
-	Order                *OrderResult `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type QuoteTaxesResponse struct {
+	Taxes                []*TaxLine `protobuf:"bytes,1,rep,name=taxes,proto3" json:"taxes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *PlaceOrderResponse) Reset()         { *m = PlaceOrderResponse{} }
-func (m *PlaceOrderResponse) String() string { return proto.CompactTextString(m) }
-func (*PlaceOrderResponse) ProtoMessage()    {}
-func (*PlaceOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{28}
+func (m *QuoteTaxesResponse) Reset()         { *m = QuoteTaxesResponse{} }
+func (m *QuoteTaxesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuoteTaxesResponse) ProtoMessage()    {}
+func (*QuoteTaxesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca53982754088a9d, []int{65}
 }
 
-func (m *PlaceOrderResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PlaceOrderResponse.Unmarshal(m, b)
+func (m *QuoteTaxesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuoteTaxesResponse.Unmarshal(m, b)
 }
-func (m *PlaceOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PlaceOrderResponse.Marshal(b, m, deterministic)
+func (m *QuoteTaxesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuoteTaxesResponse.Marshal(b, m, deterministic)
 }
-func (m *PlaceOrderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlaceOrderResponse.Merge(m, src)
+func (m *QuoteTaxesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteTaxesResponse.Merge(m, src)
 }
-func (m *PlaceOrderResponse) XXX_Size() int {
-	return xxx_messageInfo_PlaceOrderResponse.Size(m)
+func (m *QuoteTaxesResponse) XXX_Size() int {
+	return xxx_messageInfo_QuoteTaxesResponse.Size(m)
 }
-func (m *PlaceOrderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlaceOrderResponse.DiscardUnknown(m)
+func (m *QuoteTaxesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteTaxesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PlaceOrderResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuoteTaxesResponse proto.InternalMessageInfo
 
-func (m *PlaceOrderResponse) GetOrder() *OrderResult {
+func (m *QuoteTaxesResponse) GetTaxes() []*TaxLine {
 	if m != nil {
-		return m.Order
+		return m.Taxes
 	}
 	return nil
 }
@@ -1430,7 +4095,7 @@ func (m *AdRequest) Reset()         { *m = AdRequest{} }
 func (m *AdRequest) String() string { return proto.CompactTextString(m) }
 func (*AdRequest) ProtoMessage()    {}
 func (*AdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{29}
+	return fileDescriptor_ca53982754088a9d, []int{66}
 }
 
 func (m *AdRequest) XXX_Unmarshal(b []byte) error {
@@ -1469,7 +4134,7 @@ func (m *AdResponse) Reset()         { *m = AdResponse{} }
 func (m *AdResponse) String() string { return proto.CompactTextString(m) }
 func (*AdResponse) ProtoMessage()    {}
 func (*AdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{30}
+	return fileDescriptor_ca53982754088a9d, []int{67}
 }
 
 func (m *AdResponse) XXX_Unmarshal(b []byte) error {
@@ -1511,7 +4176,7 @@ func (m *Ad) Reset()         { *m = Ad{} }
 func (m *Ad) String() string { return proto.CompactTextString(m) }
 func (*Ad) ProtoMessage()    {}
 func (*Ad) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca53982754088a9d, []int{31}
+	return fileDescriptor_ca53982754088a9d, []int{68}
 }
 
 func (m *Ad) XXX_Unmarshal(b []byte) error {
@@ -1547,6 +4212,10 @@ func (m *Ad) GetText() string {
 }
 
 func init() {
+	proto.RegisterEnum("hipstershop.RateShopping", RateShopping_name, RateShopping_value)
+	proto.RegisterEnum("hipstershop.ShipmentStatus", ShipmentStatus_name, ShipmentStatus_value)
+	proto.RegisterEnum("hipstershop.ReturnStatus", ReturnStatus_name, ReturnStatus_value)
+	proto.RegisterEnum("hipstershop.OrderStatus", OrderStatus_name, OrderStatus_value)
 	proto.RegisterType((*CartItem)(nil), "hipstershop.CartItem")
 	proto.RegisterType((*AddItemRequest)(nil), "hipstershop.AddItemRequest")
 	proto.RegisterType((*EmptyCartRequest)(nil), "hipstershop.EmptyCartRequest")
@@ -1556,14 +4225,38 @@ func init() {
 	proto.RegisterType((*ListRecommendationsRequest)(nil), "hipstershop.ListRecommendationsRequest")
 	proto.RegisterType((*ListRecommendationsResponse)(nil), "hipstershop.ListRecommendationsResponse")
 	proto.RegisterType((*Product)(nil), "hipstershop.Product")
+	proto.RegisterType((*PackageDimensions)(nil), "hipstershop.PackageDimensions")
 	proto.RegisterType((*ListProductsResponse)(nil), "hipstershop.ListProductsResponse")
 	proto.RegisterType((*GetProductRequest)(nil), "hipstershop.GetProductRequest")
 	proto.RegisterType((*SearchProductsRequest)(nil), "hipstershop.SearchProductsRequest")
 	proto.RegisterType((*SearchProductsResponse)(nil), "hipstershop.SearchProductsResponse")
 	proto.RegisterType((*GetQuoteRequest)(nil), "hipstershop.GetQuoteRequest")
 	proto.RegisterType((*GetQuoteResponse)(nil), "hipstershop.GetQuoteResponse")
+	proto.RegisterType((*DutiesEstimate)(nil), "hipstershop.DutiesEstimate")
+	proto.RegisterType((*ShippingPromotion)(nil), "hipstershop.ShippingPromotion")
 	proto.RegisterType((*ShipOrderRequest)(nil), "hipstershop.ShipOrderRequest")
 	proto.RegisterType((*ShipOrderResponse)(nil), "hipstershop.ShipOrderResponse")
+	proto.RegisterType((*ShippedParcel)(nil), "hipstershop.ShippedParcel")
+	proto.RegisterType((*ListShippingOptionsRequest)(nil), "hipstershop.ListShippingOptionsRequest")
+	proto.RegisterType((*ListShippingOptionsResponse)(nil), "hipstershop.ListShippingOptionsResponse")
+	proto.RegisterType((*ShippingOption)(nil), "hipstershop.ShippingOption")
+	proto.RegisterType((*GetShipmentRequest)(nil), "hipstershop.GetShipmentRequest")
+	proto.RegisterType((*CancelShipmentRequest)(nil), "hipstershop.CancelShipmentRequest")
+	proto.RegisterType((*CancelShipmentResponse)(nil), "hipstershop.CancelShipmentResponse")
+	proto.RegisterType((*WatchShipmentRequest)(nil), "hipstershop.WatchShipmentRequest")
+	proto.RegisterType((*ShipmentEvent)(nil), "hipstershop.ShipmentEvent")
+	proto.RegisterType((*Shipment)(nil), "hipstershop.Shipment")
+	proto.RegisterType((*ValidateAddressRequest)(nil), "hipstershop.ValidateAddressRequest")
+	proto.RegisterType((*ValidateAddressResponse)(nil), "hipstershop.ValidateAddressResponse")
+	proto.RegisterType((*AddressFieldError)(nil), "hipstershop.AddressFieldError")
+	proto.RegisterType((*CreateReturnRequest)(nil), "hipstershop.CreateReturnRequest")
+	proto.RegisterType((*GetReturnRequest)(nil), "hipstershop.GetReturnRequest")
+	proto.RegisterType((*ReturnEvent)(nil), "hipstershop.ReturnEvent")
+	proto.RegisterType((*Return)(nil), "hipstershop.Return")
+	proto.RegisterType((*ListPickupPointsRequest)(nil), "hipstershop.ListPickupPointsRequest")
+	proto.RegisterType((*ListPickupPointsResponse)(nil), "hipstershop.ListPickupPointsResponse")
+	proto.RegisterType((*GetPickupPointRequest)(nil), "hipstershop.GetPickupPointRequest")
+	proto.RegisterType((*PickupPoint)(nil), "hipstershop.PickupPoint")
 	proto.RegisterType((*Address)(nil), "hipstershop.Address")
 	proto.RegisterType((*Money)(nil), "hipstershop.Money")
 	proto.RegisterType((*GetSupportedCurrenciesResponse)(nil), "hipstershop.GetSupportedCurrenciesResponse")
@@ -1571,11 +4264,24 @@ func init() {
 	proto.RegisterType((*CreditCardInfo)(nil), "hipstershop.CreditCardInfo")
 	proto.RegisterType((*ChargeRequest)(nil), "hipstershop.ChargeRequest")
 	proto.RegisterType((*ChargeResponse)(nil), "hipstershop.ChargeResponse")
+	proto.RegisterType((*RefundRequest)(nil), "hipstershop.RefundRequest")
+	proto.RegisterType((*RefundResponse)(nil), "hipstershop.RefundResponse")
 	proto.RegisterType((*OrderItem)(nil), "hipstershop.OrderItem")
 	proto.RegisterType((*OrderResult)(nil), "hipstershop.OrderResult")
+	proto.RegisterType((*Discount)(nil), "hipstershop.Discount")
+	proto.RegisterType((*TaxLine)(nil), "hipstershop.TaxLine")
 	proto.RegisterType((*SendOrderConfirmationRequest)(nil), "hipstershop.SendOrderConfirmationRequest")
 	proto.RegisterType((*PlaceOrderRequest)(nil), "hipstershop.PlaceOrderRequest")
 	proto.RegisterType((*PlaceOrderResponse)(nil), "hipstershop.PlaceOrderResponse")
+	proto.RegisterType((*RefundReturnRequest)(nil), "hipstershop.RefundReturnRequest")
+	proto.RegisterType((*RefundReturnResponse)(nil), "hipstershop.RefundReturnResponse")
+	proto.RegisterType((*OrderStep)(nil), "hipstershop.OrderStep")
+	proto.RegisterType((*Order)(nil), "hipstershop.Order")
+	proto.RegisterType((*GetOrderRequest)(nil), "hipstershop.GetOrderRequest")
+	proto.RegisterType((*ListOrdersRequest)(nil), "hipstershop.ListOrdersRequest")
+	proto.RegisterType((*ListOrdersResponse)(nil), "hipstershop.ListOrdersResponse")
+	proto.RegisterType((*QuoteTaxesRequest)(nil), "hipstershop.QuoteTaxesRequest")
+	proto.RegisterType((*QuoteTaxesResponse)(nil), "hipstershop.QuoteTaxesResponse")
 	proto.RegisterType((*AdRequest)(nil), "hipstershop.AdRequest")
 	proto.RegisterType((*AdResponse)(nil), "hipstershop.AdResponse")
 	proto.RegisterType((*Ad)(nil), "hipstershop.Ad")
@@ -1824,171 +4530,462 @@ func (c *productCatalogServiceClient) SearchProducts(ctx context.Context, in *Se
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// ProductCatalogServiceServer is the server API for ProductCatalogService service.
-type ProductCatalogServiceServer interface {
-	ListProducts(context.Context, *Empty) (*ListProductsResponse, error)
-	GetProduct(context.Context, *GetProductRequest) (*Product, error)
-	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
-}
-
-func RegisterProductCatalogServiceServer(s *grpc.Server, srv ProductCatalogServiceServer) {
-	s.RegisterService(&_ProductCatalogService_serviceDesc, srv)
+	return out, nil
+}
+
+// ProductCatalogServiceServer is the server API for ProductCatalogService service.
+type ProductCatalogServiceServer interface {
+	ListProducts(context.Context, *Empty) (*ListProductsResponse, error)
+	GetProduct(context.Context, *GetProductRequest) (*Product, error)
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
+}
+
+func RegisterProductCatalogServiceServer(s *grpc.Server, srv ProductCatalogServiceServer) {
+	s.RegisterService(&_ProductCatalogService_serviceDesc, srv)
+}
+
+func _ProductCatalogService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCatalogServiceServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hipstershop.ProductCatalogService/ListProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCatalogServiceServer).ListProducts(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCatalogService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCatalogServiceServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hipstershop.ProductCatalogService/GetProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCatalogServiceServer).GetProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCatalogService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCatalogServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hipstershop.ProductCatalogService/SearchProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCatalogServiceServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProductCatalogService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hipstershop.ProductCatalogService",
+	HandlerType: (*ProductCatalogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListProducts",
+			Handler:    _ProductCatalogService_ListProducts_Handler,
+		},
+		{
+			MethodName: "GetProduct",
+			Handler:    _ProductCatalogService_GetProduct_Handler,
+		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductCatalogService_SearchProducts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "demo.proto",
+}
+
+// ShippingServiceClient is the client API for ShippingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ShippingServiceClient interface {
+	GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error)
+	ShipOrder(ctx context.Context, in *ShipOrderRequest, opts ...grpc.CallOption) (*ShipOrderResponse, error)
+	ListShippingOptions(ctx context.Context, in *ListShippingOptionsRequest, opts ...grpc.CallOption) (*ListShippingOptionsResponse, error)
+	GetShipment(ctx context.Context, in *GetShipmentRequest, opts ...grpc.CallOption) (*Shipment, error)
+	// WatchShipment streams the status changes of a shipment, starting with
+	// those so far, until it is delivered.
+	WatchShipment(ctx context.Context, in *WatchShipmentRequest, opts ...grpc.CallOption) (ShippingService_WatchShipmentClient, error)
+	CancelShipment(ctx context.Context, in *CancelShipmentRequest, opts ...grpc.CallOption) (*CancelShipmentResponse, error)
+	ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error)
+	CreateReturn(ctx context.Context, in *CreateReturnRequest, opts ...grpc.CallOption) (*Return, error)
+	GetReturn(ctx context.Context, in *GetReturnRequest, opts ...grpc.CallOption) (*Return, error)
+	ListPickupPoints(ctx context.Context, in *ListPickupPointsRequest, opts ...grpc.CallOption) (*ListPickupPointsResponse, error)
+	GetPickupPoint(ctx context.Context, in *GetPickupPointRequest, opts ...grpc.CallOption) (*PickupPoint, error)
+}
+
+type shippingServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewShippingServiceClient(cc *grpc.ClientConn) ShippingServiceClient {
+	return &shippingServiceClient{cc}
+}
+
+func (c *shippingServiceClient) GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error) {
+	out := new(GetQuoteResponse)
+	err := c.cc.Invoke(ctx, "/hipstershop.ShippingService/GetQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) ShipOrder(ctx context.Context, in *ShipOrderRequest, opts ...grpc.CallOption) (*ShipOrderResponse, error) {
+	out := new(ShipOrderResponse)
+	err := c.cc.Invoke(ctx, "/hipstershop.ShippingService/ShipOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) ListShippingOptions(ctx context.Context, in *ListShippingOptionsRequest, opts ...grpc.CallOption) (*ListShippingOptionsResponse, error) {
+	out := new(ListShippingOptionsResponse)
+	err := c.cc.Invoke(ctx, "/hipstershop.ShippingService/ListShippingOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) GetShipment(ctx context.Context, in *GetShipmentRequest, opts ...grpc.CallOption) (*Shipment, error) {
+	out := new(Shipment)
+	err := c.cc.Invoke(ctx, "/hipstershop.ShippingService/GetShipment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) WatchShipment(ctx context.Context, in *WatchShipmentRequest, opts ...grpc.CallOption) (ShippingService_WatchShipmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ShippingService_serviceDesc.Streams[0], "/hipstershop.ShippingService/WatchShipment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &shippingServiceWatchShipmentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ShippingService_WatchShipmentClient interface {
+	Recv() (*ShipmentEvent, error)
+	grpc.ClientStream
+}
+
+type shippingServiceWatchShipmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *shippingServiceWatchShipmentClient) Recv() (*ShipmentEvent, error) {
+	m := new(ShipmentEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *shippingServiceClient) CancelShipment(ctx context.Context, in *CancelShipmentRequest, opts ...grpc.CallOption) (*CancelShipmentResponse, error) {
+	out := new(CancelShipmentResponse)
+	err := c.cc.Invoke(ctx, "/hipstershop.ShippingService/CancelShipment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error) {
+	out := new(ValidateAddressResponse)
+	err := c.cc.Invoke(ctx, "/hipstershop.ShippingService/ValidateAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) CreateReturn(ctx context.Context, in *CreateReturnRequest, opts ...grpc.CallOption) (*Return, error) {
+	out := new(Return)
+	err := c.cc.Invoke(ctx, "/hipstershop.ShippingService/CreateReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) GetReturn(ctx context.Context, in *GetReturnRequest, opts ...grpc.CallOption) (*Return, error) {
+	out := new(Return)
+	err := c.cc.Invoke(ctx, "/hipstershop.ShippingService/GetReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) ListPickupPoints(ctx context.Context, in *ListPickupPointsRequest, opts ...grpc.CallOption) (*ListPickupPointsResponse, error) {
+	out := new(ListPickupPointsResponse)
+	err := c.cc.Invoke(ctx, "/hipstershop.ShippingService/ListPickupPoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) GetPickupPoint(ctx context.Context, in *GetPickupPointRequest, opts ...grpc.CallOption) (*PickupPoint, error) {
+	out := new(PickupPoint)
+	err := c.cc.Invoke(ctx, "/hipstershop.ShippingService/GetPickupPoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShippingServiceServer is the server API for ShippingService service.
+type ShippingServiceServer interface {
+	GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error)
+	ShipOrder(context.Context, *ShipOrderRequest) (*ShipOrderResponse, error)
+	ListShippingOptions(context.Context, *ListShippingOptionsRequest) (*ListShippingOptionsResponse, error)
+	GetShipment(context.Context, *GetShipmentRequest) (*Shipment, error)
+	// WatchShipment streams the status changes of a shipment, starting with
+	// those so far, until it is delivered.
+	WatchShipment(*WatchShipmentRequest, ShippingService_WatchShipmentServer) error
+	CancelShipment(context.Context, *CancelShipmentRequest) (*CancelShipmentResponse, error)
+	ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error)
+	CreateReturn(context.Context, *CreateReturnRequest) (*Return, error)
+	GetReturn(context.Context, *GetReturnRequest) (*Return, error)
+	ListPickupPoints(context.Context, *ListPickupPointsRequest) (*ListPickupPointsResponse, error)
+	GetPickupPoint(context.Context, *GetPickupPointRequest) (*PickupPoint, error)
+}
+
+func RegisterShippingServiceServer(s *grpc.Server, srv ShippingServiceServer) {
+	s.RegisterService(&_ShippingService_serviceDesc, srv)
+}
+
+func _ShippingService_GetQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).GetQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hipstershop.ShippingService/GetQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).GetQuote(ctx, req.(*GetQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCatalogService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _ShippingService_ShipOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCatalogServiceServer).ListProducts(ctx, in)
+		return srv.(ShippingServiceServer).ShipOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hipstershop.ProductCatalogService/ListProducts",
+		FullMethod: "/hipstershop.ShippingService/ShipOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCatalogServiceServer).ListProducts(ctx, req.(*Empty))
+		return srv.(ShippingServiceServer).ShipOrder(ctx, req.(*ShipOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCatalogService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductRequest)
+func _ShippingService_ListShippingOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShippingOptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCatalogServiceServer).GetProduct(ctx, in)
+		return srv.(ShippingServiceServer).ListShippingOptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hipstershop.ProductCatalogService/GetProduct",
+		FullMethod: "/hipstershop.ShippingService/ListShippingOptions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCatalogServiceServer).GetProduct(ctx, req.(*GetProductRequest))
+		return srv.(ShippingServiceServer).ListShippingOptions(ctx, req.(*ListShippingOptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCatalogService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchProductsRequest)
+func _ShippingService_GetShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShipmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCatalogServiceServer).SearchProducts(ctx, in)
+		return srv.(ShippingServiceServer).GetShipment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hipstershop.ProductCatalogService/SearchProducts",
+		FullMethod: "/hipstershop.ShippingService/GetShipment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCatalogServiceServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+		return srv.(ShippingServiceServer).GetShipment(ctx, req.(*GetShipmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _ProductCatalogService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "hipstershop.ProductCatalogService",
-	HandlerType: (*ProductCatalogServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListProducts",
-			Handler:    _ProductCatalogService_ListProducts_Handler,
-		},
-		{
-			MethodName: "GetProduct",
-			Handler:    _ProductCatalogService_GetProduct_Handler,
-		},
-		{
-			MethodName: "SearchProducts",
-			Handler:    _ProductCatalogService_SearchProducts_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "demo.proto",
+func _ShippingService_WatchShipment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchShipmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ShippingServiceServer).WatchShipment(m, &shippingServiceWatchShipmentServer{stream})
 }
 
-// ShippingServiceClient is the client API for ShippingService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ShippingServiceClient interface {
-	GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error)
-	ShipOrder(ctx context.Context, in *ShipOrderRequest, opts ...grpc.CallOption) (*ShipOrderResponse, error)
+type ShippingService_WatchShipmentServer interface {
+	Send(*ShipmentEvent) error
+	grpc.ServerStream
 }
 
-type shippingServiceClient struct {
-	cc *grpc.ClientConn
+type shippingServiceWatchShipmentServer struct {
+	grpc.ServerStream
 }
 
-func NewShippingServiceClient(cc *grpc.ClientConn) ShippingServiceClient {
-	return &shippingServiceClient{cc}
+func (x *shippingServiceWatchShipmentServer) Send(m *ShipmentEvent) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *shippingServiceClient) GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error) {
-	out := new(GetQuoteResponse)
-	err := c.cc.Invoke(ctx, "/hipstershop.ShippingService/GetQuote", in, out, opts...)
-	if err != nil {
+func _ShippingService_CancelShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelShipmentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).CancelShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hipstershop.ShippingService/CancelShipment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).CancelShipment(ctx, req.(*CancelShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *shippingServiceClient) ShipOrder(ctx context.Context, in *ShipOrderRequest, opts ...grpc.CallOption) (*ShipOrderResponse, error) {
-	out := new(ShipOrderResponse)
-	err := c.cc.Invoke(ctx, "/hipstershop.ShippingService/ShipOrder", in, out, opts...)
-	if err != nil {
+func _ShippingService_ValidateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateAddressRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).ValidateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hipstershop.ShippingService/ValidateAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).ValidateAddress(ctx, req.(*ValidateAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// ShippingServiceServer is the server API for ShippingService service.
-type ShippingServiceServer interface {
-	GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error)
-	ShipOrder(context.Context, *ShipOrderRequest) (*ShipOrderResponse, error)
+func _ShippingService_CreateReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).CreateReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hipstershop.ShippingService/CreateReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).CreateReturn(ctx, req.(*CreateReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterShippingServiceServer(s *grpc.Server, srv ShippingServiceServer) {
-	s.RegisterService(&_ShippingService_serviceDesc, srv)
+func _ShippingService_GetReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).GetReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hipstershop.ShippingService/GetReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).GetReturn(ctx, req.(*GetReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ShippingService_GetQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetQuoteRequest)
+func _ShippingService_ListPickupPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPickupPointsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShippingServiceServer).GetQuote(ctx, in)
+		return srv.(ShippingServiceServer).ListPickupPoints(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hipstershop.ShippingService/GetQuote",
+		FullMethod: "/hipstershop.ShippingService/ListPickupPoints",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShippingServiceServer).GetQuote(ctx, req.(*GetQuoteRequest))
+		return srv.(ShippingServiceServer).ListPickupPoints(ctx, req.(*ListPickupPointsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ShippingService_ShipOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShipOrderRequest)
+func _ShippingService_GetPickupPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPickupPointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ShippingServiceServer).ShipOrder(ctx, in)
+		return srv.(ShippingServiceServer).GetPickupPoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/hipstershop.ShippingService/ShipOrder",
+		FullMethod: "/hipstershop.ShippingService/GetPickupPoint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ShippingServiceServer).ShipOrder(ctx, req.(*ShipOrderRequest))
+		return srv.(ShippingServiceServer).GetPickupPoint(ctx, req.(*GetPickupPointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2005,8 +5002,46 @@ var _ShippingService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShipOrder",
 			Handler:    _ShippingService_ShipOrder_Handler,
 		},
+		{
+			MethodName: "ListShippingOptions",
+			Handler:    _ShippingService_ListShippingOptions_Handler,
+		},
+		{
+			MethodName: "GetShipment",
+			Handler:    _ShippingService_GetShipment_Handler,
+		},
+		{
+			MethodName: "CancelShipment",
+			Handler:    _ShippingService_CancelShipment_Handler,
+		},
+		{
+			MethodName: "ValidateAddress",
+			Handler:    _ShippingService_ValidateAddress_Handler,
+		},
+		{
+			MethodName: "CreateReturn",
+			Handler:    _ShippingService_CreateReturn_Handler,
+		},
+		{
+			MethodName: "GetReturn",
+			Handler:    _ShippingService_GetReturn_Handler,
+		},
+		{
+			MethodName: "ListPickupPoints",
+			Handler:    _ShippingService_ListPickupPoints_Handler,
+		},
+		{
+			MethodName: "GetPickupPoint",
+			Handler:    _ShippingService_GetPickupPoint_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchShipment",
+			Handler:       _ShippingService_WatchShipment_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "demo.proto",
 }
 
@@ -2112,6 +5147,7 @@ var _CurrencyService_serviceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type PaymentServiceClient interface {
 	Charge(ctx context.Context, in *ChargeRequest, opts ...grpc.CallOption) (*ChargeResponse, error)
+	Refund(ctx context.Context, in *RefundRequest, opts ...grpc.CallOption) (*RefundResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -2131,9 +5167,19 @@ func (c *paymentServiceClient) Charge(ctx context.Context, in *ChargeRequest, op
 	return out, nil
 }
 
+func (c *paymentServiceClient) Refund(ctx context.Context, in *RefundRequest, opts ...grpc.CallOption) (*RefundResponse, error) {
+	out := new(RefundResponse)
+	err := c.cc.Invoke(ctx, "/hipstershop.PaymentService/Refund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 type PaymentServiceServer interface {
 	Charge(context.Context, *ChargeRequest) (*ChargeResponse, error)
+	Refund(context.Context, *RefundRequest) (*RefundResponse, error)
 }
 
 func RegisterPaymentServiceServer(s *grpc.Server, srv PaymentServiceServer) {
@@ -2158,6 +5204,24 @@ func _PaymentService_Charge_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_Refund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).Refund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hipstershop.PaymentService/Refund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).Refund(ctx, req.(*RefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PaymentService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "hipstershop.PaymentService",
 	HandlerType: (*PaymentServiceServer)(nil),
@@ -2166,6 +5230,10 @@ var _PaymentService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Charge",
 			Handler:    _PaymentService_Charge_Handler,
 		},
+		{
+			MethodName: "Refund",
+			Handler:    _PaymentService_Refund_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "demo.proto",
@@ -2240,6 +5308,10 @@ var _EmailService_serviceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type CheckoutServiceClient interface {
 	PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error)
+	RefundReturn(ctx context.Context, in *RefundReturnRequest, opts ...grpc.CallOption) (*RefundReturnResponse, error)
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*Order, error)
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
+	QuoteTaxes(ctx context.Context, in *QuoteTaxesRequest, opts ...grpc.CallOption) (*QuoteTaxesResponse, error)
 }
 
 type checkoutServiceClient struct {
@@ -2259,9 +5331,49 @@ func (c *checkoutServiceClient) PlaceOrder(ctx context.Context, in *PlaceOrderRe
 	return out, nil
 }
 
+func (c *checkoutServiceClient) RefundReturn(ctx context.Context, in *RefundReturnRequest, opts ...grpc.CallOption) (*RefundReturnResponse, error) {
+	out := new(RefundReturnResponse)
+	err := c.cc.Invoke(ctx, "/hipstershop.CheckoutService/RefundReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkoutServiceClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := c.cc.Invoke(ctx, "/hipstershop.CheckoutService/GetOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkoutServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, "/hipstershop.CheckoutService/ListOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkoutServiceClient) QuoteTaxes(ctx context.Context, in *QuoteTaxesRequest, opts ...grpc.CallOption) (*QuoteTaxesResponse, error) {
+	out := new(QuoteTaxesResponse)
+	err := c.cc.Invoke(ctx, "/hipstershop.CheckoutService/QuoteTaxes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CheckoutServiceServer is the server API for CheckoutService service.
 type CheckoutServiceServer interface {
 	PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error)
+	RefundReturn(context.Context, *RefundReturnRequest) (*RefundReturnResponse, error)
+	GetOrder(context.Context, *GetOrderRequest) (*Order, error)
+	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
+	QuoteTaxes(context.Context, *QuoteTaxesRequest) (*QuoteTaxesResponse, error)
 }
 
 func RegisterCheckoutServiceServer(s *grpc.Server, srv CheckoutServiceServer) {
@@ -2286,6 +5398,78 @@ func _CheckoutService_PlaceOrder_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CheckoutService_RefundReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckoutServiceServer).RefundReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hipstershop.CheckoutService/RefundReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckoutServiceServer).RefundReturn(ctx, req.(*RefundReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckoutService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckoutServiceServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hipstershop.CheckoutService/GetOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckoutServiceServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckoutService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckoutServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hipstershop.CheckoutService/ListOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckoutServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckoutService_QuoteTaxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteTaxesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckoutServiceServer).QuoteTaxes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hipstershop.CheckoutService/QuoteTaxes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckoutServiceServer).QuoteTaxes(ctx, req.(*QuoteTaxesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CheckoutService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "hipstershop.CheckoutService",
 	HandlerType: (*CheckoutServiceServer)(nil),
@@ -2294,6 +5478,22 @@ var _CheckoutService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PlaceOrder",
 			Handler:    _CheckoutService_PlaceOrder_Handler,
 		},
+		{
+			MethodName: "RefundReturn",
+			Handler:    _CheckoutService_RefundReturn_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _CheckoutService_GetOrder_Handler,
+		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _CheckoutService_ListOrders_Handler,
+		},
+		{
+			MethodName: "QuoteTaxes",
+			Handler:    _CheckoutService_QuoteTaxes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "demo.proto",
@@ -2366,99 +5566,235 @@ var _AdService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("demo.proto", fileDescriptor_ca53982754088a9d) }
 
 var fileDescriptor_ca53982754088a9d = []byte{
-	// 1500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xef, 0x72, 0x13, 0xb7,
-	0x16, 0xcf, 0x26, 0xb1, 0x1d, 0x1f, 0xc7, 0x4e, 0xa2, 0x9b, 0x04, 0xb3, 0x81, 0x10, 0x94, 0x81,
-	0x0b, 0x17, 0x08, 0x4c, 0xee, 0x9d, 0xe1, 0x03, 0xdc, 0xd2, 0x8c, 0xc9, 0x18, 0x4f, 0xa1, 0xd0,
-	0x0d, 0xe9, 0xd0, 0xa1, 0x53, 0xcf, 0xb2, 0x12, 0xf1, 0x96, 0xec, 0x6a, 0x91, 0xb4, 0x19, 0xcc,
-	0xc7, 0xf6, 0x01, 0xfa, 0x1e, 0x7d, 0x81, 0xce, 0xf4, 0x11, 0xfa, 0xbd, 0xaf, 0xd0, 0xe7, 0xe8,
-	0x48, 0xbb, 0xda, 0x7f, 0xb1, 0x13, 0xf8, 0xd2, 0x6f, 0xab, 0xa3, 0x9f, 0xce, 0xf9, 0xe9, 0xe8,
-	0xfc, 0xb3, 0x01, 0x08, 0x0d, 0xd8, 0x4e, 0xc4, 0x99, 0x64, 0xa8, 0x35, 0xf2, 0x23, 0x21, 0x29,
-	0x17, 0x23, 0x16, 0xe1, 0x7d, 0x58, 0xe8, 0xb9, 0x5c, 0x0e, 0x24, 0x0d, 0xd0, 0x65, 0x80, 0x88,
-	0x33, 0x12, 0x7b, 0x72, 0xe8, 0x93, 0xae, 0xb5, 0x65, 0xdd, 0x68, 0x3a, 0xcd, 0x54, 0x32, 0x20,
-	0xc8, 0x86, 0x85, 0xf7, 0xb1, 0x1b, 0x4a, 0x5f, 0x8e, 0xbb, 0xb3, 0x5b, 0xd6, 0x8d, 0x9a, 0x93,
-	0xad, 0xf1, 0x4b, 0xe8, 0xec, 0x11, 0xa2, 0xb4, 0x38, 0xf4, 0x7d, 0x4c, 0x85, 0x44, 0x17, 0xa0,
-	0x11, 0x0b, 0xca, 0x73, 0x4d, 0x75, 0xb5, 0x1c, 0x10, 0x74, 0x13, 0xe6, 0x7d, 0x49, 0x03, 0xad,
-	0xa2, 0xb5, 0xbb, 0xb6, 0x53, 0x60, 0xb3, 0x63, 0xa8, 0x38, 0x1a, 0x82, 0x6f, 0xc1, 0xf2, 0x7e,
-	0x10, 0xc9, 0xb1, 0x12, 0x9f, 0xa7, 0x17, 0xdf, 0x84, 0x4e, 0x9f, 0xca, 0x4f, 0x82, 0x3e, 0x85,
-	0x79, 0x85, 0x9b, 0xce, 0xf1, 0x16, 0xd4, 0x14, 0x01, 0xd1, 0x9d, 0xdd, 0x9a, 0x9b, 0x4e, 0x32,
-	0xc1, 0xe0, 0x06, 0xd4, 0x34, 0x4b, 0xfc, 0x2d, 0xd8, 0x4f, 0x7d, 0x21, 0x1d, 0xea, 0xb1, 0x20,
-	0xa0, 0x21, 0x71, 0xa5, 0xcf, 0x42, 0x71, 0xae, 0x43, 0xae, 0x40, 0x2b, 0x77, 0x7b, 0x62, 0xb2,
-	0xe9, 0x40, 0xe6, 0x77, 0x81, 0xbf, 0x80, 0x8d, 0x89, 0x7a, 0x45, 0xc4, 0x42, 0x41, 0xab, 0xe7,
-	0xad, 0x53, 0xe7, 0x7f, 0xb7, 0xa0, 0xf1, 0x22, 0x59, 0xa2, 0x0e, 0xcc, 0x66, 0x04, 0x66, 0x7d,
-	0x82, 0x10, 0xcc, 0x87, 0x6e, 0x40, 0xf5, 0x6b, 0x34, 0x1d, 0xfd, 0x8d, 0xb6, 0xa0, 0x45, 0xa8,
-	0xf0, 0xb8, 0x1f, 0x29, 0x43, 0xdd, 0x39, 0xbd, 0x55, 0x14, 0xa1, 0x2e, 0x34, 0x22, 0xdf, 0x93,
-	0x31, 0xa7, 0xdd, 0x79, 0xbd, 0x6b, 0x96, 0xe8, 0x2e, 0x34, 0x23, 0xee, 0x7b, 0x74, 0x18, 0x0b,
-	0xd2, 0xad, 0xe9, 0x27, 0x46, 0x25, 0xef, 0x3d, 0x63, 0x21, 0x1d, 0x3b, 0x0b, 0x1a, 0x74, 0x28,
-	0x08, 0xda, 0x04, 0xf0, 0x5c, 0x49, 0x8f, 0x18, 0xf7, 0xa9, 0xe8, 0xd6, 0x13, 0xf2, 0xb9, 0x04,
-	0x3f, 0x81, 0x55, 0x75, 0xf9, 0x94, 0x7f, 0x7e, 0xeb, 0x7b, 0xb0, 0x90, 0x5e, 0x31, 0xb9, 0x72,
-	0x6b, 0x77, 0xb5, 0x64, 0x27, 0x3d, 0xe0, 0x64, 0x28, 0xbc, 0x0d, 0x2b, 0x7d, 0x6a, 0x14, 0x99,
-	0x57, 0xa9, 0xf8, 0x03, 0xdf, 0x81, 0xb5, 0x03, 0xea, 0x72, 0x6f, 0x94, 0x1b, 0x4c, 0x80, 0xab,
-	0x50, 0x7b, 0x1f, 0x53, 0x3e, 0x4e, 0xb1, 0xc9, 0x02, 0x3f, 0x81, 0xf5, 0x2a, 0x3c, 0xe5, 0xb7,
-	0x03, 0x0d, 0x4e, 0x45, 0x7c, 0x7c, 0x0e, 0x3d, 0x03, 0xc2, 0x21, 0x2c, 0xf5, 0xa9, 0xfc, 0x26,
-	0x66, 0x92, 0x1a, 0x93, 0x3b, 0xd0, 0x70, 0x09, 0xe1, 0x54, 0x08, 0x6d, 0xb4, 0xaa, 0x62, 0x2f,
-	0xd9, 0x73, 0x0c, 0xe8, 0xf3, 0xa2, 0x76, 0x0f, 0x96, 0x73, 0x7b, 0x29, 0xe7, 0x3b, 0xb0, 0xe0,
-	0x31, 0x21, 0xf5, 0xdb, 0x59, 0x53, 0xdf, 0xae, 0xa1, 0x30, 0x87, 0x82, 0x60, 0x06, 0xcb, 0x07,
-	0x23, 0x3f, 0x7a, 0xce, 0x09, 0xe5, 0xff, 0x08, 0xe7, 0xff, 0xc1, 0x4a, 0xc1, 0x60, 0x1e, 0xfe,
-	0x92, 0xbb, 0xde, 0x3b, 0x3f, 0x3c, 0xca, 0x73, 0x0b, 0x8c, 0x68, 0x40, 0xf0, 0x2f, 0x16, 0x34,
-	0x52, 0xbb, 0xe8, 0x1a, 0x74, 0x84, 0xe4, 0x94, 0xca, 0x61, 0x91, 0x65, 0xd3, 0x69, 0x27, 0x52,
-	0x03, 0x43, 0x30, 0xef, 0x99, 0x32, 0xd7, 0x74, 0xf4, 0xb7, 0x0a, 0x00, 0x21, 0x5d, 0x49, 0xd3,
-	0x7c, 0x48, 0x16, 0x2a, 0x13, 0x3c, 0x16, 0x87, 0x92, 0x8f, 0x4d, 0x26, 0xa4, 0x4b, 0x74, 0x11,
-	0x16, 0x3e, 0xfa, 0xd1, 0xd0, 0x63, 0x84, 0xea, 0x44, 0xa8, 0x39, 0x8d, 0x8f, 0x7e, 0xd4, 0x63,
-	0x84, 0xe2, 0x57, 0x50, 0xd3, 0xae, 0x44, 0xdb, 0xd0, 0xf6, 0x62, 0xce, 0x69, 0xe8, 0x8d, 0x13,
-	0x60, 0xc2, 0x66, 0xd1, 0x08, 0x15, 0x5a, 0x19, 0x8e, 0x43, 0x5f, 0x0a, 0xcd, 0x66, 0xce, 0x49,
-	0x16, 0x4a, 0x1a, 0xba, 0x21, 0x13, 0x9a, 0x4e, 0xcd, 0x49, 0x16, 0xb8, 0x0f, 0x9b, 0x7d, 0x2a,
-	0x0f, 0xe2, 0x28, 0x62, 0x5c, 0x52, 0xd2, 0x4b, 0xf4, 0xf8, 0x34, 0x8f, 0xcb, 0x6b, 0xd0, 0x29,
-	0x99, 0x34, 0x05, 0xa3, 0x5d, 0xb4, 0x29, 0xf0, 0xf7, 0x70, 0xb1, 0x97, 0x09, 0xc2, 0x13, 0xca,
-	0x85, 0xcf, 0x42, 0xf3, 0xc8, 0xd7, 0x61, 0xfe, 0x2d, 0x67, 0xc1, 0x19, 0x31, 0xa2, 0xf7, 0x55,
-	0xc9, 0x93, 0x2c, 0xb9, 0x58, 0xe2, 0xc9, 0xba, 0x64, 0xda, 0x01, 0x7f, 0x59, 0xd0, 0xe9, 0x71,
-	0x4a, 0x7c, 0x55, 0xaf, 0xc9, 0x20, 0x7c, 0xcb, 0xd0, 0x6d, 0x40, 0x9e, 0x96, 0x0c, 0x3d, 0x97,
-	0x93, 0x61, 0x18, 0x07, 0x6f, 0x28, 0x4f, 0xfd, 0xb1, 0xec, 0x65, 0xd8, 0xaf, 0xb5, 0x1c, 0x5d,
-	0x87, 0xa5, 0x22, 0xda, 0x3b, 0x39, 0x49, 0x5b, 0x52, 0x3b, 0x87, 0xf6, 0x4e, 0x4e, 0xd0, 0xff,
-	0x61, 0xa3, 0x88, 0xa3, 0x1f, 0x22, 0x9f, 0xeb, 0xf2, 0x39, 0x1c, 0x53, 0x97, 0xa7, 0xbe, 0xeb,
-	0xe6, 0x67, 0xf6, 0x33, 0xc0, 0x77, 0xd4, 0xe5, 0xe8, 0x11, 0x5c, 0x9a, 0x72, 0x3c, 0x60, 0xa1,
-	0x1c, 0xe9, 0x27, 0xaf, 0x39, 0x17, 0x27, 0x9d, 0x7f, 0xa6, 0x00, 0x78, 0x0c, 0xed, 0xde, 0xc8,
-	0xe5, 0x47, 0x59, 0x4e, 0xff, 0x07, 0xea, 0x6e, 0xa0, 0x22, 0xe4, 0x0c, 0xe7, 0xa5, 0x08, 0xf4,
-	0x10, 0x5a, 0x05, 0xeb, 0x69, 0xc3, 0xdc, 0x28, 0x67, 0x48, 0xc9, 0x89, 0x0e, 0xe4, 0x4c, 0xf0,
-	0x7d, 0xe8, 0x18, 0xd3, 0xf9, 0xd3, 0x4b, 0xee, 0x86, 0xc2, 0xf5, 0xf4, 0x15, 0xb2, 0x64, 0x69,
-	0x17, 0xa4, 0x03, 0x82, 0x7f, 0x80, 0xa6, 0xce, 0x30, 0x3d, 0x13, 0x98, 0x6e, 0x6d, 0x9d, 0xdb,
-	0xad, 0x55, 0x54, 0xa8, 0xca, 0x90, 0xf2, 0x9c, 0x18, 0x15, 0x6a, 0x1f, 0xff, 0x34, 0x0b, 0x2d,
-	0x93, 0xc2, 0xf1, 0xb1, 0x54, 0x89, 0xc2, 0xd4, 0x32, 0x27, 0xd4, 0xd0, 0xeb, 0x01, 0x41, 0xf7,
-	0x60, 0x55, 0x8c, 0xfc, 0x28, 0x52, 0xb9, 0x5d, 0x4c, 0xf2, 0x24, 0x9a, 0x90, 0xd9, 0x7b, 0x99,
-	0x25, 0x3b, 0xba, 0x0f, 0xed, 0xec, 0x84, 0x66, 0x33, 0x37, 0x95, 0xcd, 0xa2, 0x01, 0xf6, 0x98,
-	0x90, 0xe8, 0x11, 0x2c, 0x67, 0x07, 0x4d, 0x6d, 0x98, 0x3f, 0xa3, 0x82, 0x2d, 0x19, 0xb4, 0xa9,
-	0x19, 0xb7, 0x4d, 0x25, 0xab, 0xe9, 0x4a, 0xb6, 0x5e, 0x3a, 0x95, 0x39, 0xd4, 0x94, 0x32, 0x02,
-	0x97, 0x0e, 0x68, 0x48, 0xb4, 0xbc, 0xc7, 0xc2, 0xb7, 0x3e, 0x0f, 0x74, 0xd8, 0x14, 0xda, 0x0d,
-	0x0d, 0x5c, 0xff, 0xd8, 0xb4, 0x1b, 0xbd, 0x40, 0x3b, 0x50, 0xd3, 0xae, 0x49, 0x7d, 0xdc, 0x3d,
-	0x6d, 0x23, 0xf1, 0xa9, 0x93, 0xc0, 0xf0, 0x9f, 0x16, 0xac, 0xbc, 0x38, 0x76, 0x3d, 0x5a, 0xaa,
-	0xd1, 0x53, 0x27, 0x91, 0x6d, 0x68, 0xeb, 0x0d, 0x53, 0x0a, 0x52, 0x3f, 0x2f, 0x2a, 0xa1, 0xa9,
-	0x06, 0xc5, 0x0a, 0x3f, 0xf7, 0x29, 0x15, 0x3e, 0xbb, 0x49, 0xad, 0x78, 0x93, 0x4a, 0x6c, 0xd7,
-	0x3f, 0x2f, 0xb6, 0x1f, 0x03, 0x2a, 0x5e, 0x2b, 0x6b, 0xb9, 0xa9, 0x77, 0xac, 0x4f, 0xf3, 0xce,
-	0x0e, 0x34, 0xf7, 0x88, 0x71, 0xca, 0x55, 0x58, 0xf4, 0x58, 0x28, 0xe9, 0x07, 0x39, 0x7c, 0x47,
-	0xc7, 0xa6, 0x2a, 0xb6, 0x52, 0xd9, 0x57, 0x74, 0x2c, 0xf0, 0x5d, 0x00, 0x85, 0x4f, 0xad, 0x5d,
-	0x85, 0x39, 0x97, 0x98, 0xe6, 0xbe, 0x54, 0xf1, 0x81, 0xa3, 0xf6, 0xf0, 0x03, 0x98, 0xdd, 0x23,
-	0x4a, 0xb3, 0x62, 0xce, 0xa9, 0x27, 0x87, 0x31, 0x37, 0x2f, 0xda, 0x32, 0xb2, 0x43, 0x7e, 0xac,
-	0xfa, 0x8d, 0xb2, 0x62, 0xfa, 0x8d, 0xfa, 0xde, 0xfd, 0xc3, 0x82, 0x96, 0xca, 0xb0, 0x03, 0xca,
-	0x4f, 0x7c, 0x8f, 0xa2, 0x87, 0xba, 0x8b, 0xe9, 0xa4, 0xdc, 0xa8, 0x7a, 0xbc, 0x30, 0x78, 0xdb,
-	0xe5, 0x50, 0x4f, 0x26, 0xd3, 0x19, 0xf4, 0x00, 0x1a, 0xe9, 0x74, 0x5c, 0x39, 0x5d, 0x9e, 0x99,
-	0xed, 0x95, 0x53, 0x19, 0x8e, 0x67, 0xd0, 0x97, 0xd0, 0xcc, 0xe6, 0x70, 0x74, 0xf9, 0xb4, 0xfe,
-	0xa2, 0x82, 0x89, 0xe6, 0x77, 0x7f, 0xb6, 0x60, 0xad, 0x3c, 0xbf, 0x9a, 0x6b, 0xfd, 0x08, 0xff,
-	0x9a, 0x30, 0xdc, 0xa2, 0x7f, 0x97, 0xd4, 0x4c, 0x1f, 0xab, 0xed, 0x1b, 0xe7, 0x03, 0x93, 0x07,
-	0x53, 0x2c, 0x66, 0x61, 0x2d, 0x1d, 0xbc, 0x7a, 0xae, 0x74, 0x8f, 0xd9, 0x91, 0x61, 0xd1, 0x87,
-	0xc5, 0xe2, 0x94, 0x89, 0x26, 0xdc, 0xc2, 0xbe, 0x7a, 0xca, 0x52, 0x75, 0xe8, 0xc3, 0x33, 0xe8,
-	0x31, 0x40, 0x3e, 0x64, 0xa2, 0xcd, 0xaa, 0xab, 0xcb, 0xd3, 0xa7, 0x3d, 0x71, 0x26, 0xc4, 0x33,
-	0xe8, 0x35, 0x74, 0xca, 0x63, 0x25, 0xc2, 0x25, 0xe4, 0xc4, 0x11, 0xd5, 0xde, 0x3e, 0x13, 0x93,
-	0x79, 0xe1, 0x57, 0x0b, 0x96, 0x0e, 0xd2, 0xe2, 0x65, 0xee, 0x3f, 0x80, 0x05, 0x33, 0x0d, 0xa2,
-	0x4b, 0x55, 0xd2, 0xc5, 0xa1, 0xd4, 0xbe, 0x3c, 0x65, 0x37, 0xf3, 0xc0, 0x53, 0x68, 0x66, 0x43,
-	0x5a, 0x25, 0x58, 0xaa, 0xd3, 0xa2, 0xbd, 0x39, 0x6d, 0x3b, 0x23, 0xfb, 0x9b, 0x05, 0x4b, 0xa6,
-	0xf4, 0x18, 0xb2, 0xaf, 0x61, 0x7d, 0xf2, 0x90, 0x33, 0xf1, 0xd9, 0x6e, 0x55, 0x09, 0x9f, 0x31,
-	0x1d, 0xe1, 0x19, 0xd4, 0x87, 0x46, 0x32, 0xf0, 0x48, 0x74, 0xbd, 0x9c, 0x0b, 0xd3, 0xc6, 0x21,
-	0x7b, 0x42, 0x73, 0xc1, 0x33, 0xbb, 0x87, 0xd0, 0x79, 0xe1, 0x8e, 0x03, 0x1a, 0x66, 0x19, 0xdc,
-	0x83, 0x7a, 0xd2, 0x91, 0x91, 0x5d, 0xd6, 0x5c, 0x9c, 0x10, 0xec, 0x8d, 0x89, 0x7b, 0x99, 0x43,
-	0x46, 0xb0, 0xb8, 0xaf, 0x2a, 0xa8, 0x51, 0xfa, 0x4a, 0xfd, 0x60, 0x99, 0xd0, 0x48, 0xd0, 0xcd,
-	0x4a, 0x34, 0x4c, 0x6f, 0x36, 0x53, 0x72, 0xf6, 0x0d, 0x2c, 0xf5, 0x46, 0xd4, 0x7b, 0xc7, 0xe2,
-	0xec, 0x06, 0xcf, 0x01, 0xf2, 0xba, 0x5b, 0x89, 0xee, 0x53, 0x7d, 0xc6, 0xbe, 0x32, 0x75, 0x3f,
-	0xbb, 0xcd, 0x13, 0x55, 0x82, 0x8d, 0xf6, 0x07, 0x50, 0xef, 0xab, 0x19, 0x5c, 0xa0, 0xf5, 0x6a,
-	0x39, 0x4d, 0x35, 0x5e, 0x38, 0x25, 0x37, 0x9a, 0xde, 0xd4, 0xf5, 0x9f, 0x1b, 0xff, 0xfd, 0x3b,
-	0x00, 0x00, 0xff, 0xff, 0xb2, 0xa0, 0x6e, 0x6c, 0xea, 0x10, 0x00, 0x00,
+	// 3674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x4d, 0x6f, 0x1b, 0x49,
+	0x76, 0x6a, 0x7e, 0xf3, 0xf1, 0x43, 0x54, 0x59, 0xb2, 0x69, 0xca, 0x63, 0x7b, 0xca, 0x3b, 0x33,
+	0x1e, 0xcf, 0x8c, 0xd6, 0x2b, 0xef, 0xee, 0x20, 0xf0, 0x64, 0x76, 0x19, 0x92, 0x96, 0x09, 0x6b,
+	0x64, 0xa5, 0x49, 0x3b, 0xb3, 0xd8, 0x60, 0x89, 0x76, 0x77, 0x59, 0xea, 0x15, 0xd9, 0xcd, 0xe9,
+	0x2e, 0x6a, 0x2d, 0xe7, 0x14, 0x2c, 0x82, 0xe4, 0x96, 0x4b, 0x90, 0x43, 0x02, 0xe4, 0x9a, 0x4b,
+	0x02, 0x24, 0x87, 0x20, 0xc8, 0x3f, 0x08, 0x72, 0xca, 0x5f, 0xc8, 0x25, 0x39, 0xe4, 0x98, 0x5b,
+	0x2e, 0x09, 0xea, 0xab, 0xbf, 0xd8, 0x2d, 0x52, 0x9e, 0xc5, 0x62, 0x6e, 0xac, 0xf7, 0x5e, 0x55,
+	0xbd, 0x7e, 0xf5, 0xbe, 0xab, 0x08, 0x60, 0x91, 0x99, 0xbb, 0x37, 0xf7, 0x5c, 0xea, 0xa2, 0xda,
+	0xa9, 0x3d, 0xf7, 0x29, 0xf1, 0xfc, 0x53, 0x77, 0x8e, 0x07, 0x50, 0xe9, 0x19, 0x1e, 0x1d, 0x52,
+	0x32, 0x43, 0xef, 0x01, 0xcc, 0x3d, 0xd7, 0x5a, 0x98, 0x74, 0x62, 0x5b, 0x6d, 0xed, 0xae, 0x76,
+	0xbf, 0xaa, 0x57, 0x25, 0x64, 0x68, 0xa1, 0x0e, 0x54, 0xbe, 0x59, 0x18, 0x0e, 0xb5, 0xe9, 0x45,
+	0x3b, 0x77, 0x57, 0xbb, 0x5f, 0xd4, 0x83, 0x31, 0x1e, 0x43, 0xb3, 0x6b, 0x59, 0x6c, 0x15, 0x9d,
+	0x7c, 0xb3, 0x20, 0x3e, 0x45, 0x37, 0xa0, 0xbc, 0xf0, 0x89, 0x17, 0xae, 0x54, 0x62, 0xc3, 0xa1,
+	0x85, 0x3e, 0x86, 0x82, 0x4d, 0xc9, 0x8c, 0x2f, 0x51, 0xdb, 0xdf, 0xd9, 0x8b, 0x70, 0xb3, 0xa7,
+	0x58, 0xd1, 0x39, 0x09, 0xfe, 0x04, 0x5a, 0x83, 0xd9, 0x9c, 0x5e, 0x30, 0xf0, 0xaa, 0x75, 0xf1,
+	0xc7, 0xd0, 0x3c, 0x20, 0x74, 0x2d, 0xd2, 0x43, 0x28, 0x30, 0xba, 0x6c, 0x1e, 0x3f, 0x81, 0x22,
+	0x63, 0xc0, 0x6f, 0xe7, 0xee, 0xe6, 0xb3, 0x99, 0x14, 0x34, 0xb8, 0x0c, 0x45, 0xce, 0x25, 0x7e,
+	0x09, 0x9d, 0x43, 0xdb, 0xa7, 0x3a, 0x31, 0xdd, 0xd9, 0x8c, 0x38, 0x96, 0x41, 0x6d, 0xd7, 0xf1,
+	0x57, 0x0a, 0xe4, 0x0e, 0xd4, 0x42, 0xb1, 0x8b, 0x2d, 0xab, 0x3a, 0x04, 0x72, 0xf7, 0xf1, 0x97,
+	0xb0, 0x9b, 0xba, 0xae, 0x3f, 0x77, 0x1d, 0x9f, 0x24, 0xe7, 0x6b, 0x4b, 0xf3, 0xff, 0x57, 0x83,
+	0xf2, 0xb1, 0x18, 0xa2, 0x26, 0xe4, 0x02, 0x06, 0x72, 0xb6, 0x85, 0x10, 0x14, 0x1c, 0x63, 0x46,
+	0xf8, 0x69, 0x54, 0x75, 0xfe, 0x1b, 0xdd, 0x85, 0x9a, 0x45, 0x7c, 0xd3, 0xb3, 0xe7, 0x6c, 0xa3,
+	0x76, 0x9e, 0xa3, 0xa2, 0x20, 0xd4, 0x86, 0xf2, 0xdc, 0x36, 0xe9, 0xc2, 0x23, 0xed, 0x02, 0xc7,
+	0xaa, 0x21, 0xfa, 0x3e, 0x54, 0xe7, 0x9e, 0x6d, 0x92, 0xc9, 0xc2, 0xb7, 0xda, 0x45, 0x7e, 0xc4,
+	0x28, 0x26, 0xbd, 0xaf, 0x5c, 0x87, 0x5c, 0xe8, 0x15, 0x4e, 0xf4, 0xc2, 0xb7, 0xd0, 0x6d, 0x00,
+	0xd3, 0xa0, 0xe4, 0xc4, 0xf5, 0x6c, 0xe2, 0xb7, 0x4b, 0x82, 0xf9, 0x10, 0x82, 0xbe, 0x04, 0xb0,
+	0xec, 0x19, 0x71, 0x7c, 0xf6, 0xcd, 0xed, 0x32, 0x5f, 0xf1, 0x76, 0x6c, 0xc5, 0x63, 0xc3, 0x3c,
+	0x33, 0x4e, 0x48, 0x3f, 0xa0, 0xd2, 0x23, 0x33, 0xf0, 0x9f, 0x68, 0xb0, 0xb5, 0x44, 0x81, 0x76,
+	0xa1, 0xfa, 0x2b, 0x62, 0x9f, 0x9c, 0xd2, 0xc9, 0xd9, 0x09, 0x97, 0x86, 0xa6, 0x57, 0x04, 0xe0,
+	0xd9, 0x09, 0x43, 0x4e, 0x89, 0x73, 0x42, 0x4f, 0x27, 0xa6, 0x50, 0x53, 0x4d, 0xaf, 0x08, 0x40,
+	0x6f, 0x86, 0x6e, 0x42, 0xe5, 0x57, 0xb6, 0x25, 0x70, 0x79, 0x8e, 0x2b, 0xf3, 0x71, 0x6f, 0xc6,
+	0xe6, 0x9d, 0x8a, 0x45, 0xcd, 0x19, 0x97, 0x8b, 0xa6, 0x57, 0x04, 0xa0, 0x37, 0xc3, 0x4f, 0x61,
+	0x9b, 0x1d, 0xa2, 0x3c, 0x87, 0xf0, 0xf4, 0x1e, 0x42, 0x45, 0x1e, 0x95, 0x38, 0xba, 0xda, 0xfe,
+	0x76, 0xfc, 0xeb, 0x04, 0x52, 0x0f, 0xa8, 0xf0, 0x3d, 0xd8, 0x3a, 0x20, 0x6a, 0x21, 0xa5, 0x5d,
+	0x89, 0x73, 0xc5, 0x9f, 0xc1, 0xce, 0x88, 0x18, 0x9e, 0x79, 0x1a, 0x6e, 0x28, 0x08, 0xb7, 0xa1,
+	0xf8, 0xcd, 0x82, 0x78, 0x17, 0x92, 0x56, 0x0c, 0xf0, 0x53, 0xb8, 0x9e, 0x24, 0x97, 0xfc, 0xed,
+	0x41, 0xd9, 0x23, 0xfe, 0x62, 0xba, 0x82, 0x3d, 0x45, 0x84, 0xff, 0x3d, 0x07, 0x9b, 0x07, 0x84,
+	0xfe, 0xfe, 0xc2, 0xa5, 0x44, 0xed, 0xb9, 0x07, 0x65, 0xc3, 0xb2, 0x3c, 0xe2, 0xfb, 0x7c, 0xd7,
+	0xe4, 0x1a, 0x5d, 0x81, 0xd3, 0x15, 0xd1, 0x95, 0xcc, 0x0f, 0x7d, 0x0a, 0xc8, 0x3f, 0xb5, 0xe7,
+	0x73, 0xdb, 0x39, 0x99, 0xb8, 0x5c, 0x3d, 0x99, 0x89, 0x09, 0xa5, 0x6d, 0x29, 0xcc, 0x73, 0x8e,
+	0x18, 0x5a, 0xe8, 0x1e, 0x34, 0xcc, 0x85, 0xe7, 0x11, 0xc7, 0xbc, 0x98, 0x98, 0xae, 0xa5, 0xf4,
+	0xb7, 0xae, 0x80, 0x3d, 0xd7, 0x62, 0xdf, 0x5c, 0xf1, 0x17, 0xaf, 0xa8, 0x4b, 0x8d, 0xe9, 0x65,
+	0x3a, 0xac, 0x68, 0xa4, 0xe3, 0x9c, 0xb9, 0x62, 0xc5, 0x52, 0xe0, 0x38, 0x67, 0x2e, 0x5f, 0xee,
+	0x4b, 0x68, 0x78, 0x06, 0x25, 0x13, 0x36, 0x97, 0x31, 0xc3, 0xb5, 0xb8, 0xb9, 0x7f, 0x33, 0xb6,
+	0xa6, 0x6e, 0x50, 0x32, 0x92, 0x04, 0x7a, 0xdd, 0x8b, 0x8c, 0xf0, 0xdf, 0xe4, 0xa0, 0x15, 0x8a,
+	0x54, 0x9e, 0xcb, 0x67, 0x50, 0x31, 0x5d, 0x9f, 0x72, 0x3b, 0xd3, 0x32, 0x79, 0x2c, 0x33, 0x1a,
+	0x66, 0x66, 0x1f, 0x42, 0x81, 0xfd, 0x6c, 0xe7, 0x32, 0x49, 0x39, 0x1e, 0x7d, 0x01, 0x82, 0xf1,
+	0xc0, 0xf2, 0x93, 0xd6, 0x36, 0x92, 0x12, 0x3d, 0x56, 0x54, 0x7a, 0x38, 0x81, 0x09, 0xc2, 0x34,
+	0x3c, 0xcf, 0x16, 0x6e, 0x4e, 0x88, 0xb6, 0x2a, 0x21, 0x43, 0x0b, 0xbd, 0x0f, 0x75, 0x85, 0xe6,
+	0x4e, 0xa7, 0x28, 0x3c, 0x8b, 0x84, 0x1d, 0x31, 0xdf, 0xf3, 0x08, 0x4a, 0xd6, 0x82, 0x0a, 0x57,
+	0xc0, 0x36, 0xdf, 0x8d, 0x6d, 0xde, 0xe7, 0xa8, 0x81, 0x4f, 0xed, 0x99, 0x41, 0x89, 0x2e, 0x49,
+	0xf1, 0x7f, 0x69, 0xd0, 0x8c, 0xa3, 0xa4, 0x0f, 0xa3, 0xb6, 0xc3, 0x9d, 0xa5, 0x54, 0xf6, 0x28,
+	0x08, 0x3d, 0x82, 0xda, 0x89, 0xeb, 0x5a, 0xfe, 0xe4, 0xdc, 0x98, 0x2e, 0xc8, 0x25, 0x82, 0x01,
+	0x4e, 0xf6, 0x92, 0x51, 0xa1, 0x07, 0x01, 0x7b, 0xf9, 0x4c, 0x7a, 0x49, 0x81, 0xee, 0x43, 0x91,
+	0x1a, 0x6f, 0x88, 0xdf, 0x2e, 0x64, 0x92, 0x0a, 0x02, 0x4e, 0xb9, 0x42, 0xd9, 0x04, 0x01, 0x5e,
+	0xc0, 0xd6, 0xd2, 0x01, 0x2c, 0xf9, 0xf4, 0x84, 0xff, 0xce, 0x2d, 0xfb, 0xef, 0x3d, 0xa8, 0x58,
+	0xb6, 0x6f, 0xba, 0x0b, 0x87, 0x5e, 0xf2, 0x21, 0x01, 0x0d, 0xfe, 0x3f, 0x0d, 0x5a, 0x6c, 0xdf,
+	0xe7, 0x9e, 0x45, 0xbc, 0xef, 0xa0, 0x55, 0xaf, 0xd0, 0xbb, 0x9b, 0x50, 0x71, 0x3d, 0x4b, 0x20,
+	0x85, 0xce, 0x95, 0xf9, 0x78, 0xc8, 0xec, 0x62, 0x73, 0x6e, 0x9b, 0x67, 0x8b, 0xf9, 0x64, 0xee,
+	0xda, 0x0e, 0x4f, 0x7c, 0x84, 0xfd, 0x36, 0x04, 0xf8, 0x98, 0x41, 0x87, 0x16, 0xfe, 0x5b, 0x0d,
+	0xb6, 0x22, 0x12, 0x08, 0x43, 0x2f, 0xf5, 0x0c, 0xf3, 0x8c, 0x71, 0x19, 0x1c, 0x01, 0x28, 0xd0,
+	0xd0, 0x42, 0x3f, 0x84, 0xf2, 0xdc, 0xf0, 0x4c, 0x32, 0x55, 0x5f, 0xdd, 0x59, 0x36, 0x26, 0x62,
+	0x1d, 0x73, 0x12, 0x5d, 0x91, 0xa2, 0xc7, 0x50, 0x8f, 0x32, 0x25, 0x8f, 0xa8, 0x1d, 0x77, 0xbc,
+	0x21, 0x7b, 0x7a, 0x2d, 0xc2, 0x2b, 0xfe, 0xf3, 0x1c, 0x34, 0x62, 0xeb, 0xae, 0xe6, 0xf2, 0x4a,
+	0x27, 0x13, 0x97, 0x75, 0x7e, 0x95, 0x8d, 0x17, 0x96, 0x6d, 0xfc, 0xc7, 0x70, 0x43, 0x91, 0x04,
+	0x7c, 0x39, 0x8b, 0xd9, 0x2b, 0xe2, 0xc9, 0xd3, 0xd9, 0x91, 0xe8, 0xb1, 0xc4, 0x1e, 0x71, 0x24,
+	0x9b, 0x47, 0xa4, 0x7d, 0x5b, 0x13, 0x8b, 0x4c, 0xed, 0x73, 0xe2, 0x5d, 0x4c, 0x2c, 0x83, 0x2a,
+	0x9f, 0xbb, 0x13, 0xa0, 0xfb, 0x12, 0xdb, 0x37, 0x28, 0xc1, 0x7f, 0x9f, 0x13, 0x89, 0xd9, 0x28,
+	0xa6, 0x36, 0xfe, 0x6f, 0x45, 0x8f, 0x97, 0xe2, 0x4d, 0x7e, 0x45, 0xbc, 0x29, 0x5c, 0x39, 0xde,
+	0x14, 0x57, 0xc6, 0x9b, 0xd2, 0xd5, 0xe2, 0xcd, 0x18, 0x76, 0x53, 0xc5, 0x25, 0x95, 0xfe, 0x47,
+	0x50, 0x16, 0x16, 0xa9, 0x32, 0x82, 0xdd, 0xd4, 0x00, 0x21, 0xa6, 0xe9, 0x8a, 0x16, 0xff, 0x4f,
+	0x0e, 0x9a, 0x71, 0xdc, 0x5a, 0xc9, 0x68, 0x34, 0xce, 0xe5, 0x57, 0xc7, 0xb9, 0x1f, 0xc2, 0x75,
+	0x62, 0x78, 0x53, 0x9b, 0xf8, 0x34, 0xa1, 0x22, 0x42, 0x11, 0xb7, 0x15, 0x36, 0xaa, 0x21, 0xe8,
+	0x21, 0x6c, 0x4f, 0x0d, 0xba, 0x3c, 0x47, 0x88, 0x16, 0x09, 0x5c, 0x6c, 0x86, 0x8a, 0xa7, 0xa5,
+	0xab, 0xc4, 0xd3, 0xf2, 0xb7, 0x8b, 0xa7, 0x95, 0x55, 0xb6, 0x56, 0x5d, 0xb2, 0x35, 0xfc, 0x23,
+	0x40, 0x07, 0x84, 0x1f, 0xe5, 0x8c, 0x38, 0x41, 0xb6, 0xb8, 0xca, 0x23, 0xe0, 0x11, 0xec, 0xf4,
+	0x0c, 0xc7, 0x24, 0xd3, 0xab, 0xce, 0x8c, 0xf9, 0xda, 0x5c, 0xcc, 0xd7, 0xe2, 0xc7, 0x70, 0x3d,
+	0xb9, 0xa8, 0x54, 0xa9, 0xf7, 0xa1, 0x1e, 0x59, 0x55, 0xd5, 0x30, 0xb5, 0x70, 0x59, 0x1f, 0x7f,
+	0x0e, 0xdb, 0x7f, 0x60, 0x50, 0xf3, 0xf4, 0xca, 0x9f, 0xf2, 0x35, 0x34, 0xd4, 0x9c, 0xc1, 0x39,
+	0x71, 0x28, 0x4b, 0x31, 0x7c, 0x6a, 0xd0, 0x85, 0x30, 0xf7, 0x66, 0x8a, 0xfa, 0x32, 0xda, 0x11,
+	0x27, 0xd1, 0x25, 0x29, 0x53, 0x4d, 0x6a, 0x87, 0xaa, 0xc9, 0x7e, 0xe3, 0xbf, 0x28, 0x40, 0x45,
+	0x91, 0xaf, 0x16, 0x4c, 0xb8, 0x6d, 0x6e, 0xfd, 0x6d, 0x23, 0xbe, 0x29, 0x7f, 0x25, 0xdf, 0x54,
+	0x78, 0xe7, 0x18, 0x5b, 0xcc, 0x88, 0xb1, 0xef, 0xe8, 0x7d, 0xd1, 0x3e, 0x94, 0x08, 0x93, 0x3b,
+	0x2b, 0xde, 0xd2, 0x23, 0x60, 0x70, 0x34, 0xba, 0xa4, 0xfc, 0xf6, 0x7a, 0x7f, 0x59, 0x8c, 0x81,
+	0xcb, 0x62, 0x4c, 0x54, 0x7d, 0x6b, 0x2b, 0x53, 0x85, 0x7a, 0x5a, 0xaa, 0xf0, 0x14, 0xae, 0xbf,
+	0x34, 0xa6, 0x36, 0x93, 0x8c, 0x3a, 0x9f, 0x77, 0x8b, 0x34, 0xf8, 0xef, 0x34, 0xb8, 0xb1, 0xb4,
+	0x94, 0x34, 0x99, 0x6d, 0x28, 0x9e, 0x33, 0x14, 0x5f, 0xa9, 0xa2, 0x8b, 0x01, 0xea, 0x01, 0x72,
+	0x5c, 0x6f, 0x66, 0x4c, 0xed, 0xb7, 0xc4, 0x9a, 0xa8, 0xcd, 0x72, 0x97, 0x6c, 0xb6, 0x15, 0xd2,
+	0x4b, 0x10, 0xfa, 0x31, 0x94, 0x88, 0xe7, 0xb9, 0x1e, 0xd3, 0xb9, 0xfc, 0x92, 0xc3, 0x92, 0x54,
+	0x4f, 0x6c, 0x32, 0xb5, 0x06, 0x8c, 0x4c, 0x97, 0xd4, 0xf8, 0x19, 0x6c, 0x2d, 0x21, 0x19, 0x9f,
+	0xaf, 0xd9, 0x48, 0xd5, 0x9b, 0x7c, 0xb0, 0x3a, 0x45, 0xc5, 0x7f, 0xa9, 0xc1, 0xb5, 0x9e, 0x47,
+	0x58, 0x9a, 0x4f, 0xe8, 0xc2, 0x73, 0x7e, 0x03, 0x0e, 0x28, 0xb4, 0x8e, 0xfc, 0x1a, 0xd6, 0x71,
+	0x1d, 0x4a, 0x1e, 0x31, 0x7c, 0xd7, 0x91, 0x91, 0x43, 0x8e, 0xf0, 0x23, 0x5e, 0x8c, 0x5d, 0x8d,
+	0x29, 0x3c, 0x86, 0x9a, 0x98, 0x21, 0x5c, 0xd0, 0x0f, 0x12, 0x2e, 0x28, 0x11, 0x9a, 0x39, 0xe5,
+	0x1a, 0x0e, 0xe8, 0x5f, 0xf2, 0x50, 0x12, 0xc4, 0xdf, 0x4a, 0x2c, 0xfb, 0xb0, 0xe3, 0x4b, 0x33,
+	0x9c, 0xc4, 0xdc, 0x70, 0x9e, 0xbb, 0xe1, 0x6b, 0x0a, 0x39, 0x0e, 0x56, 0xbb, 0xa2, 0xa3, 0x09,
+	0x45, 0x59, 0x8c, 0x8a, 0x32, 0x22, 0x86, 0xd2, 0xba, 0x62, 0x78, 0x98, 0xf0, 0x26, 0xed, 0x94,
+	0x29, 0xdf, 0x15, 0x5f, 0xb2, 0x0b, 0x55, 0x8f, 0xbc, 0x5e, 0x38, 0x56, 0xe8, 0x4c, 0x2a, 0x02,
+	0x30, 0xb4, 0xf0, 0x6b, 0xb8, 0xc1, 0xfb, 0x41, 0xa1, 0xeb, 0x78, 0xe7, 0x84, 0x94, 0xed, 0x63,
+	0x58, 0xf6, 0xc2, 0x9f, 0x9c, 0x05, 0xfd, 0x2a, 0x01, 0x78, 0x36, 0xc3, 0x87, 0xd0, 0x5e, 0xde,
+	0x27, 0xe8, 0x3d, 0x95, 0xb8, 0x2b, 0x53, 0x89, 0x5c, 0x76, 0x85, 0x21, 0xe9, 0xf0, 0x47, 0xb0,
+	0xc3, 0x7a, 0x4f, 0x11, 0x4c, 0x46, 0xff, 0xe9, 0x3f, 0x34, 0xa8, 0x45, 0xc8, 0xd6, 0x4a, 0xf5,
+	0xae, 0x1a, 0xec, 0x3a, 0x50, 0x99, 0x1a, 0xd4, 0xa6, 0x0b, 0xd9, 0xc6, 0xd1, 0xf4, 0x60, 0x8c,
+	0x6e, 0x41, 0x75, 0xea, 0x3a, 0x27, 0x02, 0x59, 0xe4, 0xc8, 0x10, 0xc0, 0xac, 0xc5, 0xb2, 0x7d,
+	0xca, 0x92, 0x11, 0x26, 0xb3, 0x12, 0xc7, 0x83, 0x02, 0x3d, 0x9b, 0xb1, 0xb4, 0xdd, 0x9d, 0x13,
+	0x87, 0x9d, 0xf4, 0xa9, 0xbb, 0xf0, 0x44, 0xe3, 0xb1, 0xaa, 0xd7, 0x25, 0xf0, 0x29, 0x83, 0xe1,
+	0x7f, 0xd0, 0xa0, 0xac, 0x7c, 0xe6, 0x07, 0xd0, 0xf4, 0xa9, 0x47, 0x08, 0x9d, 0x44, 0x8f, 0xae,
+	0xaa, 0x37, 0x04, 0x54, 0x91, 0x21, 0x28, 0x98, 0xaa, 0x7f, 0x5e, 0xd5, 0xf9, 0x6f, 0xe6, 0x21,
+	0x99, 0x72, 0xab, 0xd2, 0x40, 0x0c, 0x58, 0x8b, 0x95, 0xd7, 0xde, 0xde, 0x85, 0x6a, 0xb1, 0xca,
+	0x21, 0xb3, 0xe4, 0xb7, 0xf6, 0x3c, 0xcc, 0xfd, 0x8b, 0x7a, 0xf9, 0xad, 0x3d, 0xe7, 0x99, 0x3f,
+	0x6b, 0x05, 0xbb, 0x3e, 0x35, 0xa6, 0xd1, 0x4e, 0x14, 0x08, 0x10, 0x23, 0xc0, 0x5f, 0x43, 0x91,
+	0x67, 0xa7, 0xcb, 0x75, 0x89, 0x96, 0x52, 0x97, 0x6c, 0x43, 0x71, 0xe1, 0xd8, 0x54, 0x04, 0x90,
+	0xbc, 0x2e, 0x06, 0x0c, 0xea, 0x18, 0x8e, 0x2b, 0x0e, 0xa9, 0xa8, 0x8b, 0x01, 0x3e, 0x80, 0xdb,
+	0x2c, 0xd1, 0x5c, 0xcc, 0xe7, 0xae, 0x47, 0x89, 0xd5, 0x13, 0xeb, 0xd8, 0x24, 0xd4, 0xb6, 0x0f,
+	0xa0, 0x19, 0xdb, 0x52, 0xa5, 0x79, 0x8d, 0xe8, 0x9e, 0x3e, 0xfe, 0x43, 0xb8, 0xd9, 0x0b, 0x00,
+	0xce, 0x39, 0xf1, 0x7c, 0x96, 0x14, 0x4b, 0x35, 0xfb, 0x10, 0x0a, 0xaf, 0x3d, 0x77, 0x76, 0x49,
+	0xc7, 0x8b, 0xe3, 0x59, 0xb3, 0x9d, 0xca, 0xf2, 0x48, 0x88, 0xba, 0x44, 0x79, 0x6d, 0x84, 0xff,
+	0x53, 0x83, 0x66, 0xcf, 0x23, 0x96, 0xcd, 0x6e, 0x0a, 0xac, 0xa1, 0xf3, 0xda, 0x65, 0x69, 0x90,
+	0xc9, 0x21, 0x13, 0xd3, 0xf0, 0x2c, 0x65, 0xd9, 0x42, 0x1e, 0x2d, 0x33, 0xa0, 0x95, 0x46, 0xfd,
+	0x21, 0x6c, 0x46, 0xa9, 0xcd, 0xf3, 0x73, 0x79, 0x19, 0xd2, 0x08, 0x49, 0x7b, 0xe7, 0xe7, 0xe8,
+	0x77, 0x61, 0x37, 0x4a, 0x47, 0xde, 0xcc, 0x6d, 0x8f, 0x37, 0x9e, 0x26, 0x17, 0xc4, 0xf0, 0xa4,
+	0xec, 0xda, 0xe1, 0x9c, 0x41, 0x40, 0xf0, 0x33, 0x62, 0x78, 0xe8, 0x27, 0x70, 0x2b, 0x63, 0xfa,
+	0xcc, 0x75, 0xe8, 0x29, 0xd7, 0x89, 0xa2, 0x7e, 0x33, 0x6d, 0xfe, 0x57, 0x8c, 0x00, 0x5f, 0x40,
+	0xa3, 0x77, 0x6a, 0x78, 0x27, 0x41, 0x13, 0xf6, 0x01, 0x94, 0x8c, 0x19, 0xef, 0xf8, 0x64, 0x0b,
+	0x4f, 0x52, 0xa0, 0x2f, 0xa0, 0x16, 0xd9, 0x5d, 0xe6, 0x0f, 0xf1, 0x84, 0x35, 0x2e, 0x44, 0x1d,
+	0x42, 0x4e, 0xf0, 0xe7, 0xd0, 0x54, 0x5b, 0x87, 0x47, 0x4f, 0x3d, 0xc3, 0xf1, 0x0d, 0x53, 0x65,
+	0x99, 0xd2, 0x3a, 0x22, 0xd0, 0xa1, 0x85, 0x5f, 0x41, 0x43, 0xe7, 0xfe, 0x51, 0xf1, 0xbc, 0xde,
+	0xbc, 0xc8, 0xa7, 0xe5, 0x56, 0x7d, 0x1a, 0xfe, 0x0c, 0x9a, 0x6a, 0x0f, 0xc9, 0x5c, 0xcc, 0x4d,
+	0x6b, 0x09, 0x37, 0xfd, 0x0b, 0xa8, 0xf2, 0x96, 0x0f, 0xbf, 0x20, 0x53, 0x57, 0x57, 0xda, 0xca,
+	0xab, 0xab, 0x75, 0xfb, 0xad, 0xf8, 0x8f, 0x8b, 0x50, 0x53, 0x3d, 0xa5, 0xc5, 0x94, 0xc6, 0xc2,
+	0xb4, 0x16, 0x0f, 0xd3, 0x0f, 0x61, 0x3b, 0x48, 0xd7, 0xa3, 0xb1, 0x5e, 0x28, 0x78, 0x90, 0xca,
+	0x87, 0x51, 0x1a, 0x7d, 0x0e, 0x8d, 0x60, 0x06, 0xe7, 0x26, 0xbb, 0x80, 0xae, 0x2b, 0xc2, 0x1e,
+	0xab, 0x5a, 0x7f, 0x02, 0x41, 0xfe, 0x1f, 0xf8, 0xb3, 0xc2, 0x25, 0x2e, 0x79, 0x53, 0x51, 0x4b,
+	0x00, 0xfa, 0x54, 0xa5, 0x07, 0x45, 0x1e, 0x58, 0xae, 0xc7, 0x66, 0x05, 0x02, 0x55, 0xf9, 0xc1,
+	0x57, 0x91, 0x42, 0x24, 0xac, 0x96, 0x4b, 0x6b, 0x55, 0xcb, 0x5b, 0x7e, 0x12, 0x14, 0x6d, 0xba,
+	0x95, 0xd7, 0x6f, 0xba, 0x85, 0x9d, 0xe7, 0xca, 0xda, 0x9d, 0x67, 0xa6, 0xa0, 0xe2, 0xd7, 0x64,
+	0xee, 0x91, 0xb9, 0x61, 0x5b, 0x3c, 0x7f, 0xa8, 0xe8, 0x0d, 0x01, 0x3d, 0x16, 0xc0, 0xa5, 0x86,
+	0x1e, 0x5c, 0xa1, 0xa1, 0x87, 0x1e, 0x41, 0x55, 0x35, 0x62, 0xfd, 0x76, 0x2d, 0x25, 0xdd, 0xea,
+	0x4b, 0xac, 0x1e, 0xd2, 0xa1, 0x07, 0xaa, 0xf9, 0x5c, 0x4f, 0xb9, 0xb4, 0x19, 0x1b, 0x6f, 0x0e,
+	0x6d, 0x87, 0xc8, 0xf6, 0x33, 0xfe, 0x23, 0xa8, 0xa8, 0x25, 0x58, 0x3a, 0x14, 0x9c, 0x40, 0xa8,
+	0x83, 0xb5, 0x00, 0x36, 0x5c, 0xa7, 0xbd, 0x1c, 0xda, 0x63, 0x7e, 0xa5, 0x3d, 0xfe, 0xb7, 0x06,
+	0x65, 0xc9, 0x0f, 0xfa, 0x08, 0x36, 0x7f, 0xb9, 0xf0, 0x6c, 0xdf, 0xb2, 0x13, 0xf6, 0xde, 0x8c,
+	0x82, 0xd7, 0x62, 0x01, 0x41, 0xc1, 0x0b, 0x63, 0x2a, 0xff, 0x8d, 0x7e, 0x07, 0x9a, 0xd4, 0x78,
+	0x63, 0xbc, 0x9a, 0x92, 0x89, 0x64, 0x2f, 0xbb, 0xd9, 0xd6, 0x90, 0x94, 0x5d, 0x4e, 0x18, 0xf9,
+	0xa2, 0xe2, 0x4a, 0xe7, 0xd9, 0x81, 0x8a, 0xed, 0x98, 0xd3, 0x85, 0x45, 0x44, 0x2f, 0xb9, 0xa2,
+	0x07, 0x63, 0x6c, 0xc1, 0xad, 0x11, 0x71, 0x2c, 0x6e, 0x01, 0x3d, 0xd7, 0x79, 0x6d, 0x7b, 0x33,
+	0xee, 0xb3, 0x23, 0xb7, 0x73, 0x64, 0x66, 0xd8, 0x53, 0x55, 0x2d, 0xf1, 0x01, 0xda, 0x83, 0x22,
+	0x77, 0x02, 0xed, 0x5c, 0x8a, 0xde, 0x44, 0xbc, 0x87, 0x2e, 0xc8, 0xf0, 0xbf, 0xe6, 0x61, 0xeb,
+	0x78, 0x6a, 0x98, 0x24, 0xd6, 0xaf, 0xcf, 0xbc, 0x80, 0xbe, 0x07, 0x0d, 0x8e, 0x50, 0x71, 0x58,
+	0xca, 0xb3, 0xce, 0x80, 0x2a, 0x14, 0x5f, 0x39, 0x39, 0x0b, 0xbe, 0xa4, 0x18, 0xfd, 0x92, 0x44,
+	0x60, 0x29, 0x5d, 0x29, 0xb0, 0x64, 0x34, 0x2c, 0xca, 0x19, 0x0d, 0x8b, 0x3d, 0xb8, 0x16, 0xf7,
+	0x2a, 0x22, 0x1f, 0x10, 0x15, 0x40, 0xdc, 0x6d, 0xf0, 0x6c, 0xe7, 0x1e, 0x34, 0xb8, 0x11, 0x5f,
+	0x4c, 0xa4, 0x1f, 0x10, 0xa6, 0x5c, 0x17, 0x40, 0xe1, 0x00, 0xd2, 0x9a, 0x00, 0x90, 0xd2, 0x04,
+	0x60, 0xaa, 0x6c, 0x5b, 0x64, 0x36, 0x77, 0x29, 0xcf, 0x77, 0xce, 0xc8, 0x85, 0xac, 0x00, 0x9a,
+	0x11, 0xf0, 0x33, 0x72, 0x91, 0xe8, 0xe5, 0xd6, 0x13, 0xbd, 0x5c, 0xdc, 0x07, 0x14, 0x3d, 0xc9,
+	0xe0, 0x52, 0x56, 0x2a, 0x84, 0xb6, 0x9e, 0x42, 0xbc, 0x85, 0x6b, 0x2a, 0xe8, 0x45, 0xcb, 0x56,
+	0x1e, 0xf9, 0x18, 0x20, 0x16, 0xf9, 0x18, 0xe0, 0x37, 0x57, 0x47, 0xe3, 0x09, 0x6c, 0xc7, 0xf7,
+	0x5e, 0x23, 0xec, 0x5e, 0x29, 0xa2, 0x1b, 0x32, 0x44, 0x8f, 0x28, 0x99, 0x33, 0xbb, 0xf7, 0x29,
+	0x99, 0xcb, 0x05, 0xf9, 0x6f, 0x56, 0x7e, 0x46, 0x3a, 0x6f, 0xd5, 0xa0, 0x96, 0x64, 0x2a, 0xea,
+	0x79, 0xae, 0xa7, 0x12, 0x6f, 0x3e, 0x08, 0x0a, 0xed, 0x42, 0xa4, 0xd0, 0xfe, 0xa7, 0x1c, 0x14,
+	0xf9, 0x1e, 0x97, 0xc5, 0xe7, 0x88, 0x7d, 0xe5, 0x62, 0xf6, 0x15, 0x98, 0x42, 0x3e, 0x6a, 0x0a,
+	0x0f, 0x03, 0xae, 0x0a, 0xbc, 0xf8, 0x4d, 0x39, 0xc4, 0xe5, 0xda, 0x57, 0xdc, 0xb2, 0x4b, 0x27,
+	0x94, 0x7d, 0xec, 0x92, 0x0e, 0xfd, 0x00, 0x80, 0xdf, 0x18, 0x4c, 0x78, 0x68, 0xca, 0xee, 0x55,
+	0x57, 0x39, 0xd5, 0x31, 0x0b, 0x55, 0xac, 0x5c, 0xe6, 0x6d, 0x17, 0x6b, 0x62, 0x50, 0x69, 0x5b,
+	0x55, 0x09, 0xe9, 0x52, 0x16, 0xd8, 0x99, 0x4c, 0x59, 0x90, 0xcc, 0x08, 0xec, 0xec, 0x18, 0x74,
+	0x41, 0x84, 0x3f, 0xe5, 0x6f, 0x01, 0x62, 0x5e, 0x28, 0x5b, 0x80, 0xf8, 0x14, 0xb6, 0x58, 0xa9,
+	0xca, 0xc9, 0x57, 0x3f, 0x9b, 0xd9, 0x85, 0xea, 0xdc, 0x38, 0x21, 0x13, 0xdf, 0x7e, 0x4b, 0xd4,
+	0x7b, 0x24, 0x06, 0x18, 0xd9, 0x6f, 0x09, 0xb7, 0x2a, 0x86, 0xa4, 0xee, 0x19, 0x51, 0x2f, 0x58,
+	0x38, 0xf9, 0x98, 0x01, 0xf0, 0x29, 0xa0, 0xe8, 0x4e, 0x52, 0x23, 0x1f, 0x40, 0x89, 0xb3, 0xa2,
+	0xca, 0x61, 0x94, 0x22, 0x5f, 0x49, 0xc1, 0xfc, 0x80, 0x43, 0xde, 0xd0, 0x49, 0x64, 0x17, 0x71,
+	0xe8, 0x0d, 0x06, 0x3e, 0x0e, 0x76, 0xfa, 0x75, 0x0e, 0xb6, 0xf8, 0xc5, 0xfd, 0x98, 0x85, 0xda,
+	0x77, 0xad, 0xf0, 0xd7, 0xf2, 0xd0, 0x57, 0xea, 0x6e, 0x2d, 0xa5, 0x86, 0x85, 0x35, 0x53, 0xc3,
+	0x30, 0x4d, 0x2a, 0xae, 0x7f, 0x41, 0xff, 0x53, 0x40, 0x51, 0x21, 0x04, 0xf2, 0x96, 0x39, 0x8a,
+	0xb6, 0x3a, 0x47, 0xd9, 0x83, 0x6a, 0x37, 0x28, 0x0b, 0x58, 0xcf, 0xc6, 0x75, 0x28, 0x93, 0xff,
+	0x19, 0xb9, 0x08, 0xae, 0x0b, 0x24, 0xec, 0x19, 0xb9, 0xf0, 0xf1, 0xf7, 0x01, 0xba, 0x56, 0xe4,
+	0x7e, 0x21, 0x6f, 0x58, 0x6a, 0x9f, 0xcd, 0x84, 0xac, 0x75, 0x86, 0xc3, 0x8f, 0x21, 0xd7, 0xe5,
+	0xdd, 0x20, 0x16, 0x6e, 0x3c, 0x62, 0xd2, 0xc9, 0xc2, 0x53, 0x61, 0xb8, 0xa6, 0x60, 0x2f, 0xbc,
+	0x29, 0xf7, 0x0f, 0xe4, 0x0d, 0x0d, 0x1a, 0x71, 0xe4, 0x0d, 0x7d, 0xf0, 0x31, 0xd4, 0xa3, 0xf7,
+	0x69, 0xa8, 0x0e, 0x95, 0xde, 0xd3, 0x41, 0xf7, 0x78, 0x30, 0x1a, 0xb7, 0x36, 0x50, 0x0d, 0xca,
+	0x4f, 0xba, 0xa3, 0x31, 0x1b, 0x68, 0x0f, 0xfe, 0x54, 0x13, 0xd7, 0x60, 0x61, 0xb3, 0x1f, 0xdd,
+	0x81, 0xdd, 0xd1, 0xd3, 0xe1, 0xf1, 0x57, 0x83, 0xa3, 0xf1, 0x64, 0x34, 0xee, 0x8e, 0x5f, 0x8c,
+	0x26, 0x2f, 0x8e, 0x46, 0xc7, 0x83, 0xde, 0xf0, 0xc9, 0x70, 0xd0, 0x6f, 0x6d, 0xa0, 0x2d, 0x68,
+	0x1c, 0x76, 0x7f, 0x6f, 0x70, 0x38, 0xe9, 0xe9, 0x83, 0xee, 0x78, 0xd0, 0x6f, 0x69, 0xa8, 0x09,
+	0x30, 0x3c, 0x9a, 0x8c, 0xf5, 0xee, 0xd1, 0x68, 0x38, 0x6e, 0xe5, 0xd0, 0x36, 0xb4, 0x9e, 0xbf,
+	0x18, 0x4f, 0x9e, 0x3c, 0xd7, 0x27, 0xfd, 0xc1, 0xe1, 0xf0, 0xe5, 0x40, 0xff, 0x59, 0x2b, 0x8f,
+	0x1a, 0x50, 0x95, 0xa3, 0x41, 0xbf, 0x55, 0xe0, 0x6c, 0x75, 0x8f, 0x7a, 0x83, 0xc3, 0x41, 0xbf,
+	0x55, 0x7c, 0xf0, 0x67, 0x1a, 0xd4, 0xa3, 0x3d, 0x36, 0xf4, 0x1e, 0xdc, 0xd4, 0x07, 0xe3, 0x17,
+	0xfa, 0x51, 0x3a, 0x17, 0x6d, 0xd8, 0x96, 0xe8, 0x24, 0x33, 0x3b, 0xb0, 0x25, 0x31, 0x31, 0x9e,
+	0xae, 0xc1, 0xa6, 0x04, 0xeb, 0x83, 0xde, 0x60, 0xf8, 0x72, 0xd0, 0x6f, 0xe5, 0x63, 0xc0, 0x27,
+	0x2f, 0x8e, 0xfa, 0x8c, 0xb1, 0x07, 0xaf, 0x64, 0x11, 0x24, 0x19, 0xb9, 0x05, 0xed, 0xe7, 0x7a,
+	0x7f, 0xa0, 0x67, 0x4a, 0x43, 0x60, 0x8f, 0x07, 0x47, 0xfd, 0xe1, 0xd1, 0x41, 0x4b, 0x43, 0x2d,
+	0xa8, 0x4b, 0xd0, 0x61, 0xb7, 0x37, 0xe8, 0xb7, 0x72, 0x21, 0xe4, 0x49, 0x77, 0xc8, 0x3e, 0x37,
+	0xbf, 0xff, 0x6f, 0x1a, 0xd4, 0x98, 0x15, 0x8c, 0x88, 0x77, 0x6e, 0x9b, 0x04, 0x7d, 0xc1, 0x9b,
+	0x37, 0xbc, 0xae, 0xdb, 0x4d, 0x5a, 0x5f, 0xe4, 0x21, 0x63, 0x27, 0x6e, 0x12, 0xe2, 0xa5, 0xdf,
+	0x06, 0x7a, 0x0c, 0x65, 0xf9, 0xda, 0x30, 0x31, 0x3b, 0xfe, 0x06, 0xb1, 0xb3, 0xb5, 0x64, 0x85,
+	0x78, 0x03, 0xfd, 0x14, 0xaa, 0xc1, 0xbb, 0x46, 0xf4, 0xde, 0xf2, 0xfa, 0xd1, 0x05, 0x52, 0xb7,
+	0xdf, 0xff, 0xb5, 0x06, 0x3b, 0xf1, 0xf7, 0x80, 0xea, 0xb3, 0x7e, 0x09, 0xd7, 0x52, 0x1e, 0x0b,
+	0xa2, 0x8f, 0x62, 0xcb, 0x64, 0x3f, 0x53, 0xec, 0xdc, 0x5f, 0x4d, 0x28, 0x8c, 0x8a, 0x71, 0x91,
+	0x83, 0x1d, 0xf9, 0x00, 0xac, 0x67, 0x50, 0x63, 0xea, 0x9e, 0x28, 0x2e, 0x0e, 0xa0, 0x1e, 0x7d,
+	0xed, 0x86, 0x52, 0xbe, 0xa2, 0xf3, 0xfe, 0xd2, 0x4e, 0xc9, 0xc7, 0x67, 0x78, 0x03, 0xf5, 0x01,
+	0xc2, 0xc7, 0x6e, 0xe8, 0x76, 0x52, 0xd4, 0xf1, 0x57, 0x70, 0x9d, 0xd4, 0xb7, 0x69, 0x78, 0x03,
+	0xfd, 0x1c, 0x9a, 0xf1, 0xe7, 0x6d, 0x08, 0xc7, 0x4b, 0xc2, 0xb4, 0xa7, 0x72, 0x9d, 0x7b, 0x97,
+	0xd2, 0x04, 0x52, 0xf8, 0xc7, 0x32, 0x6c, 0xaa, 0xba, 0x54, 0x7d, 0xff, 0x10, 0x2a, 0xea, 0xc5,
+	0x16, 0xba, 0x95, 0x64, 0x3a, 0xfa, 0x36, 0xae, 0xf3, 0x5e, 0x06, 0x36, 0x90, 0xc0, 0x21, 0x54,
+	0x83, 0x87, 0x27, 0x09, 0x65, 0x49, 0x3e, 0xc9, 0xe9, 0xdc, 0xce, 0x42, 0x07, 0xab, 0x49, 0xf5,
+	0x48, 0xdc, 0xed, 0xa7, 0xa8, 0x47, 0xfa, 0x63, 0x89, 0xce, 0xfd, 0xd5, 0x84, 0xc1, 0x5e, 0x07,
+	0x50, 0x8b, 0xdc, 0x3d, 0xa3, 0x3b, 0xc9, 0x2f, 0x4d, 0x5c, 0xe5, 0x76, 0x76, 0x52, 0x6f, 0x06,
+	0xf1, 0x06, 0xd2, 0xa1, 0x11, 0xbb, 0xfb, 0x45, 0x71, 0xd5, 0x49, 0xbb, 0x17, 0xee, 0x5c, 0x72,
+	0xcd, 0x88, 0x37, 0x1e, 0x6a, 0x4c, 0x25, 0xe2, 0x97, 0xd1, 0x09, 0x95, 0x48, 0xbd, 0xfe, 0xee,
+	0xdc, 0xbb, 0x94, 0x26, 0xf8, 0xf2, 0x5f, 0xc0, 0x66, 0xe2, 0xde, 0x0e, 0xc5, 0x67, 0xa6, 0x5f,
+	0x10, 0x76, 0xbe, 0x77, 0x39, 0x51, 0x44, 0xb2, 0xf5, 0xe8, 0xdd, 0x18, 0xba, 0x9b, 0xac, 0xa0,
+	0x92, 0xd7, 0x66, 0x9d, 0x6b, 0x29, 0xf7, 0x24, 0x78, 0x03, 0x75, 0xa1, 0x1a, 0x5c, 0x66, 0xa1,
+	0x25, 0x55, 0x5c, 0x6b, 0x09, 0x03, 0x5a, 0xc9, 0x0b, 0x06, 0xf4, 0xbd, 0x65, 0xd3, 0x5e, 0xbe,
+	0xe7, 0xe8, 0x7c, 0xb0, 0x82, 0x2a, 0xf8, 0xdc, 0x63, 0xfe, 0xb4, 0x3b, 0x82, 0x4c, 0x9c, 0x55,
+	0xea, 0x95, 0x44, 0x27, 0xb3, 0xbd, 0x82, 0x37, 0xf6, 0xff, 0x59, 0x83, 0x4d, 0x95, 0x38, 0x29,
+	0x9b, 0xfd, 0x39, 0x5c, 0x4f, 0xef, 0x60, 0xa7, 0x7a, 0xaf, 0x4f, 0x96, 0xb4, 0x39, 0xbb, 0xf5,
+	0xcd, 0x4f, 0xac, 0x2c, 0xba, 0xd9, 0x14, 0x7d, 0x18, 0x3f, 0xac, 0xac, 0x5e, 0x77, 0x27, 0x25,
+	0x17, 0xc3, 0x1b, 0xfb, 0x7f, 0xa5, 0x41, 0xf3, 0xd8, 0xb8, 0xe0, 0xe9, 0x83, 0x64, 0xbc, 0x07,
+	0x25, 0xd1, 0x6f, 0x45, 0x71, 0xa5, 0x8f, 0xf5, 0x7f, 0x3b, 0xbb, 0xa9, 0xb8, 0x80, 0xc1, 0x1e,
+	0xbb, 0x4a, 0x64, 0xd5, 0x57, 0x62, 0x91, 0x58, 0x43, 0xb6, 0xb3, 0x9b, 0x8a, 0x0b, 0x5c, 0xe1,
+	0x29, 0xd4, 0x07, 0xac, 0xb8, 0x51, 0x9c, 0x7d, 0x0d, 0x3b, 0xa9, 0xed, 0x0e, 0xf4, 0x71, 0xc2,
+	0xb5, 0x66, 0xb7, 0x44, 0x32, 0x02, 0xe0, 0x5f, 0xe7, 0x61, 0xb3, 0x77, 0x4a, 0xcc, 0x33, 0x77,
+	0x11, 0xc8, 0xe1, 0x39, 0x40, 0x58, 0x2b, 0x27, 0x62, 0xc5, 0x52, 0x3b, 0xa4, 0x73, 0x27, 0x13,
+	0x1f, 0xc8, 0xe4, 0x05, 0xd4, 0xd5, 0x27, 0xa6, 0x98, 0x59, 0x4a, 0x45, 0xdd, 0x79, 0xff, 0x12,
+	0x8a, 0x60, 0xd9, 0x2f, 0x79, 0x70, 0x10, 0x5c, 0x2e, 0x05, 0x87, 0x18, 0x8f, 0x29, 0x15, 0x08,
+	0xde, 0x60, 0xdf, 0x19, 0x56, 0x2f, 0x89, 0xef, 0x5c, 0x2a, 0xa0, 0x3a, 0x77, 0x32, 0xf1, 0x01,
+	0x43, 0xcf, 0x01, 0xc2, 0xf4, 0x3c, 0xb1, 0xe0, 0x52, 0xf1, 0xd2, 0xb9, 0x93, 0x89, 0x0f, 0xf4,
+	0xe0, 0x29, 0xcb, 0xd6, 0xd5, 0xb1, 0x3c, 0x86, 0xd2, 0x01, 0xbb, 0x01, 0xf3, 0xd1, 0xf5, 0x64,
+	0xe6, 0x2d, 0x57, 0xbc, 0xb1, 0x04, 0x57, 0x2b, 0xbd, 0x2a, 0xf1, 0xff, 0xac, 0x3c, 0xfa, 0xff,
+	0x01, 0x00, 0x75, 0x87, 0xc3, 0x13, 0xc1, 0x32, 0x00, 0x00,
 }
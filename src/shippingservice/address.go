@@ -0,0 +1,215 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+)
+
+// Address fields, as named in field errors.
+const (
+	fieldStreetAddress = "street_address"
+	fieldCity          = "city"
+	fieldState         = "state"
+	fieldCountry       = "country"
+	fieldPostalCode    = "postal_code"
+)
+
+// defaultRequiredFields are required for countries without rules.
+var defaultRequiredFields = []string{fieldStreetAddress, fieldCity, fieldCountry}
+
+// PostalCodeRule validates and formats the postal codes of a country. Pattern
+// is matched against the compact form of the code: upper case, without spaces
+// or dashes. The canonical form inserts Separator after the first SplitAfter
+// characters, or before the last SplitBeforeLast characters.
+type PostalCodeRule struct {
+	Pattern         string `json:"pattern"`
+	Separator       string `json:"separator"`
+	SplitAfter      int    `json:"split_after"`
+	SplitBeforeLast int    `json:"split_before_last"`
+
+	re *regexp.Regexp
+}
+
+// CountryRules describes the addresses of a country. Code is the ISO 3166
+// code addresses are normalized to, and Names are the other names it may be
+// entered as. States maps the state codes to their names; when set, the
+// state must be one of them.
+type CountryRules struct {
+	Code       string            `json:"code"`
+	Names      []string          `json:"names"`
+	Required   []string          `json:"required"`
+	PostalCode *PostalCodeRule   `json:"postal_code"`
+	States     map[string]string `json:"states"`
+}
+
+// AddressValidator checks addresses against per-country rules and normalizes
+// them. Addresses in countries without rules only need the default fields.
+type AddressValidator struct {
+	Countries []*CountryRules `json:"countries"`
+
+	byName map[string]*CountryRules
+}
+
+// LoadAddressValidator reads and validates the address rules file at path.
+func LoadAddressValidator(path string) (*AddressValidator, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open address rules file: %v", err)
+	}
+	var v AddressValidator
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("failed to parse address rules: %v", err)
+	}
+	if err := v.init(); err != nil {
+		return nil, fmt.Errorf("invalid address rules: %v", err)
+	}
+	return &v, nil
+}
+
+func (v *AddressValidator) init() error {
+	v.byName = make(map[string]*CountryRules)
+	for _, c := range v.Countries {
+		if c.Code == "" {
+			return fmt.Errorf("country %v has no code", c.Names)
+		}
+		for _, f := range c.Required {
+			switch f {
+			case fieldStreetAddress, fieldCity, fieldState, fieldCountry, fieldPostalCode:
+			default:
+				return fmt.Errorf("country %q requires unknown field %q", c.Code, f)
+			}
+		}
+		if p := c.PostalCode; p != nil {
+			re, err := regexp.Compile(p.Pattern)
+			if err != nil {
+				return fmt.Errorf("country %q has an invalid postal code pattern: %v", c.Code, err)
+			}
+			p.re = re
+		}
+		for _, name := range append([]string{c.Code}, c.Names...) {
+			key := strings.ToUpper(name)
+			if _, ok := v.byName[key]; ok {
+				return fmt.Errorf("duplicate country name %q", name)
+			}
+			v.byName[key] = c
+		}
+	}
+	return nil
+}
+
+// Validate checks the address and returns it normalized, or the errors of
+// every invalid field. The input address is not modified.
+func (v *AddressValidator) Validate(addr *pb.Address) (*pb.Address, []*pb.AddressFieldError) {
+	out := &pb.Address{
+		StreetAddress: collapseSpaces(addr.GetStreetAddress()),
+		City:          collapseSpaces(addr.GetCity()),
+		State:         collapseSpaces(addr.GetState()),
+		Country:       collapseSpaces(addr.GetCountry()),
+		ZipCode:       addr.GetZipCode(),
+		PostalCode:    strings.ToUpper(collapseSpaces(postalCode(addr))),
+	}
+	var errs []*pb.AddressFieldError
+	fail := func(field, format string, a ...interface{}) {
+		errs = append(errs, &pb.AddressFieldError{Field: field, Description: fmt.Sprintf(format, a...)})
+	}
+
+	rules := v.byName[strings.ToUpper(out.Country)]
+	required := defaultRequiredFields
+	if rules != nil {
+		out.Country = rules.Code
+		required = append([]string{fieldCountry}, rules.Required...)
+	}
+	values := map[string]string{
+		fieldStreetAddress: out.StreetAddress,
+		fieldCity:          out.City,
+		fieldState:         out.State,
+		fieldCountry:       out.Country,
+		fieldPostalCode:    out.PostalCode,
+	}
+	missing := make(map[string]bool)
+	for _, f := range required {
+		if values[f] == "" && !missing[f] {
+			missing[f] = true
+			fail(f, "%s is required", strings.Replace(f, "_", " ", -1))
+		}
+	}
+	if rules == nil {
+		return out, errs
+	}
+
+	if out.State != "" && len(rules.States) > 0 {
+		if code, ok := rules.state(out.State); ok {
+			out.State = code
+		} else {
+			fail(fieldState, "unknown state %q for %s", out.State, rules.Code)
+		}
+	}
+	if out.PostalCode != "" && rules.PostalCode != nil {
+		if code, ok := rules.PostalCode.format(out.PostalCode); ok {
+			out.PostalCode = code
+		} else {
+			fail(fieldPostalCode, "invalid postal code %q for %s", out.PostalCode, rules.Code)
+		}
+	}
+	return out, errs
+}
+
+// state looks up a state by code or name and returns its code.
+func (c *CountryRules) state(s string) (string, bool) {
+	for code, name := range c.States {
+		if strings.EqualFold(code, s) || strings.EqualFold(name, s) {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+// format returns the canonical form of a postal code, or false if the code
+// does not match the rule.
+func (r *PostalCodeRule) format(code string) (string, bool) {
+	compact := strings.NewReplacer(" ", "", "-", "").Replace(code)
+	if !r.re.MatchString(compact) {
+		return "", false
+	}
+	split := 0
+	switch {
+	case r.SplitAfter > 0:
+		split = r.SplitAfter
+	case r.SplitBeforeLast > 0:
+		split = len(compact) - r.SplitBeforeLast
+	}
+	if split <= 0 || split >= len(compact) {
+		return compact, true
+	}
+	return compact[:split] + r.Separator + compact[split:], true
+}
+
+// addressErrorString joins field errors into one message.
+func addressErrorString(errs []*pb.AddressFieldError) string {
+	msgs := make([]string, len(errs))
+	for i, e := range errs {
+		msgs[i] = e.GetDescription()
+	}
+	return "invalid address: " + strings.Join(msgs, "; ")
+}
+
+// postalCode returns the postal code of an address. Zip codes are stored as
+// integers, so they are padded back to five digits to restore leading zeros.
+func postalCode(addr *pb.Address) string {
+	if code := strings.TrimSpace(addr.GetPostalCode()); code != "" {
+		return code
+	}
+	if addr.GetZipCode() == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%05d", addr.GetZipCode())
+}
+
+func collapseSpaces(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
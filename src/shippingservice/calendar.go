@@ -0,0 +1,63 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+)
+
+// dateLayout formats calendar dates, such as holidays and delivery estimates.
+const dateLayout = "2006-01-02"
+
+// BusinessCalendar knows which days carriers pick up and deliver parcels.
+// Weekends and configured holidays are not business days.
+type BusinessCalendar struct {
+	holidays map[string]bool
+}
+
+// LoadBusinessCalendar reads the list of holidays from the file at path.
+func LoadBusinessCalendar(path string) (*BusinessCalendar, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open holidays file: %v", err)
+	}
+	var cfg struct {
+		Holidays []string `json:"holidays"`
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse holidays file: %v", err)
+	}
+	return NewBusinessCalendar(cfg.Holidays...)
+}
+
+// NewBusinessCalendar creates a calendar with the given YYYY-MM-DD holidays.
+func NewBusinessCalendar(holidays ...string) (*BusinessCalendar, error) {
+	c := &BusinessCalendar{holidays: make(map[string]bool, len(holidays))}
+	for _, h := range holidays {
+		if _, err := time.Parse(dateLayout, h); err != nil {
+			return nil, fmt.Errorf("invalid holiday %q: %v", h, err)
+		}
+		c.holidays[h] = true
+	}
+	return c, nil
+}
+
+// IsBusinessDay reports whether t falls on a business day.
+func (c *BusinessCalendar) IsBusinessDay(t time.Time) bool {
+	if wd := t.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	return !c.holidays[t.Format(dateLayout)]
+}
+
+// AddBusinessDays returns the date n business days after t.
+func (c *BusinessCalendar) AddBusinessDays(t time.Time, n int) time.Time {
+	for n > 0 {
+		t = t.AddDate(0, 0, 1)
+		if c.IsBusinessDay(t) {
+			n--
+		}
+	}
+	return t
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+	"github.com/abruneau/hipstershop/src/shippingservice/store"
+)
+
+// CancelShipment voids the labels of a shipment, or of every shipment of an
+// order, before they are picked up. Nothing is canceled if one of them was
+// picked up already. Labels are then voided one at a time, so a carrier
+// failing partway through leaves the shipments before it canceled and the
+// others not. Canceling again is a no-op, so failed calls can be retried.
+func (s *server) CancelShipment(ctx context.Context, in *pb.CancelShipmentRequest) (*pb.CancelShipmentResponse, error) {
+	log.Info("[CancelShipment] received request")
+	defer log.Info("[CancelShipment] completed request")
+
+	shipments, err := s.findShipments(in.TrackingId, in.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Check that no shipment was picked up.
+	var pending []*store.Shipment
+	for _, sh := range shipments {
+		events, err := s.shipmentEvents(ctx, sh)
+		if err != nil {
+			return nil, err
+		}
+		switch events[len(events)-1].Status {
+		case pb.ShipmentStatus_CANCELED:
+		case pb.ShipmentStatus_LABEL_CREATED:
+			pending = append(pending, sh)
+		default:
+			return nil, status.Errorf(codes.FailedPrecondition, "shipment %s was already picked up", sh.TrackingID)
+		}
+	}
+
+	// 2. Void the labels with the carriers.
+	for _, sh := range pending {
+		if c, ok := s.carrier(sh.CarrierID); ok {
+			if err := s.voidLabel(ctx, c, sh); err != nil {
+				return nil, err
+			}
+		} else if sh.CarrierID != "" {
+			return nil, status.Errorf(codes.Unavailable, "carrier %s is not enabled", sh.CarrierID)
+		}
+		if err := s.shipments.CancelShipment(sh.TrackingID, s.now()); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to cancel shipment: %v", err)
+		}
+		log.Infof("[CancelShipment] canceled shipment %s of order %q", sh.TrackingID, sh.OrderID)
+	}
+
+	res := &pb.CancelShipmentResponse{}
+	for _, sh := range shipments {
+		res.TrackingIds = append(res.TrackingIds, sh.TrackingID)
+	}
+	return res, nil
+}
+
+// voidLabel cancels the label of a shipment with its carrier.
+func (s *server) voidLabel(ctx context.Context, c enabledCarrier, sh *store.Shipment) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	err := c.Cancel(ctx, Label{
+		CarrierID:      sh.CarrierID,
+		TrackingNumber: sh.CarrierTrackingNumber,
+		CreatedAt:      sh.CreatedAt,
+	})
+	if err == errPickedUp {
+		return status.Errorf(codes.FailedPrecondition, "shipment %s was already picked up", sh.TrackingID)
+	}
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "carrier %s failed to cancel shipment %s: %v", c.ID(), sh.TrackingID, err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+	"github.com/abruneau/hipstershop/src/shippingservice/money"
+)
+
+// errNotServed is returned by carriers asked to ship to a zone they do not serve.
+var errNotServed = errors.New("zone not served by the carrier")
+
+// Carrier is a shipping company delivering parcels. Its methods may be slow
+// or fail, so callers bound them with a context deadline.
+type Carrier interface {
+	// ID identifies the carrier in requests and saved shipments.
+	ID() string
+	// Name is shown to customers.
+	Name() string
+	// Quote prices a shipment to zone, in USD, before the shipping option
+	// is applied. It returns errNotServed if the carrier does not ship there.
+	Quote(ctx context.Context, zone *Zone, parcel shipment) (CarrierRate, error)
+	// CreateLabel buys a shipping label for a shipment to zone.
+	CreateLabel(ctx context.Context, zone *Zone, parcel shipment) (Label, error)
+	// Track lists the status changes of a labelled shipment so far.
+	Track(ctx context.Context, label Label) ([]*pb.ShipmentEvent, error)
+	// Cancel voids a label before the shipment is picked up.
+	Cancel(ctx context.Context, label Label) error
+}
+
+// CarrierRate is the price and transit time quoted by a carrier. TransitDays
+// are business days added to the delivery window of every shipping option.
+type CarrierRate struct {
+	Cost        money.Micros
+	TransitDays int
+}
+
+// Label is a shipping label bought from a carrier.
+type Label struct {
+	CarrierID      string
+	TrackingNumber string
+	CreatedAt      time.Time
+}
+
+// Duration is a time.Duration read from a JSON string such as "250ms".
+type Duration time.Duration
+
+// UnmarshalJSON parses the duration with time.ParseDuration.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+)
+
+// productResolver looks up the products being shipped.
+type productResolver interface {
+	GetProduct(ctx context.Context, id string) (*pb.Product, error)
+}
+
+// catalogResolver resolves products with the product catalog service.
+type catalogResolver struct {
+	client pb.ProductCatalogServiceClient
+}
+
+func newCatalogResolver(conn *grpc.ClientConn) *catalogResolver {
+	return &catalogResolver{client: pb.NewProductCatalogServiceClient(conn)}
+}
+
+func (c *catalogResolver) GetProduct(ctx context.Context, id string) (*pb.Product, error) {
+	return c.client.GetProduct(ctx, &pb.GetProductRequest{Id: id})
+}
+
+// noCatalog is used when no product catalog is configured. Its products have
+// no dimensions, so every item is counted at the default item weight.
+type noCatalog struct{}
+
+func (noCatalog) GetProduct(ctx context.Context, id string) (*pb.Product, error) {
+	return &pb.Product{Id: id}, nil
+}
+
+// shipment measures a set of items for pricing.
+type shipment struct {
+	items         int
+	weightKg      float64
+	dimensionalKg float64
+}
+
+// billableWeightKg is the greater of the actual and dimensional weights.
+func (s shipment) billableWeightKg() float64 {
+	if s.dimensionalKg > s.weightKg {
+		return s.dimensionalKg
+	}
+	return s.weightKg
+}
+
+// orderLine is an item of an order with its product.
+type orderLine struct {
+	item    *pb.CartItem
+	product *pb.Product
+}
+
+// maxOrderItems bounds the number of items in an order. Items are packed one
+// by one, and each may need a parcel, and so a label, of its own.
+const maxOrderItems = 1000
+
+// lookupProducts looks up the product of every item of an order. An order has
+// at least one item and at most maxOrderItems in all.
+func (s *server) lookupProducts(ctx context.Context, items []*pb.CartItem) ([]orderLine, error) {
+	if len(items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "an order must have at least one item")
+	}
+	var total int64
+	for _, item := range items {
+		if item.GetQuantity() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid quantity %d of product %q", item.GetQuantity(), item.GetProductId())
+		}
+		total += int64(item.GetQuantity())
+	}
+	if total > maxOrderItems {
+		return nil, status.Errorf(codes.InvalidArgument, "an order can have at most %d items, got %d", maxOrderItems, total)
+	}
+	lines := make([]orderLine, len(items))
+	for i, item := range items {
+		product, err := s.products.GetProduct(ctx, item.GetProductId())
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown product %q", item.GetProductId())
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to get product %q: %v", item.GetProductId(), err)
+		}
+		lines[i] = orderLine{item: item, product: product}
+	}
+	return lines, nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+	"github.com/abruneau/hipstershop/src/shippingservice/money"
+)
+
+// quoteCurrency is the currency of the rate tables.
+const quoteCurrency = "USD"
+
+// currencyConverter converts quotes to the customer's currency.
+type currencyConverter interface {
+	Convert(ctx context.Context, from money.Money, toCode string) (money.Money, error)
+}
+
+// currencyServiceConverter converts amounts with the currency service.
+type currencyServiceConverter struct {
+	client pb.CurrencyServiceClient
+}
+
+func newCurrencyServiceConverter(conn *grpc.ClientConn) *currencyServiceConverter {
+	return &currencyServiceConverter{client: pb.NewCurrencyServiceClient(conn)}
+}
+
+func (c *currencyServiceConverter) Convert(ctx context.Context, from money.Money, toCode string) (money.Money, error) {
+	res, err := c.client.Convert(ctx, &pb.CurrencyConversionRequest{From: from.Proto(), ToCode: toCode})
+	if err != nil {
+		return money.Money{}, err
+	}
+	return money.FromProto(res), nil
+}
+
+// noConverter is used when no currency service is configured. Only USD quotes
+// are available.
+type noConverter struct{}
+
+func (noConverter) Convert(ctx context.Context, from money.Money, toCode string) (money.Money, error) {
+	return money.Money{}, status.Errorf(codes.Unimplemented, "cannot convert %s to %s without a currency service", from.CurrencyCode, toCode)
+}
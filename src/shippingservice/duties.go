@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/abruneau/hipstershop/src/shippingservice/money"
+)
+
+// DutyRule estimates the import duties and taxes of a customs territory, such
+// as the European Union. Orders worth more than DeMinimis in USD pay duties,
+// charged per product category at DutyRates percent of the goods value, or at
+// DefaultDutyRate for other categories. Orders worth more than TaxDeMinimis
+// pay TaxRate percent of the goods value plus duties, plus the shipping cost
+// if TaxIncludesShipping is set.
+type DutyRule struct {
+	Destination         string                  `json:"destination"`
+	Countries           []string                `json:"countries"`
+	DeMinimis           money.Micros            `json:"de_minimis"`
+	TaxDeMinimis        money.Micros            `json:"tax_de_minimis"`
+	DefaultDutyRate     money.Micros            `json:"default_duty_rate"`
+	DutyRates           map[string]money.Micros `json:"duty_rates"`
+	TaxRate             money.Micros            `json:"tax_rate"`
+	TaxIncludesShipping bool                    `json:"tax_includes_shipping"`
+}
+
+// DutyEngine estimates the duties and taxes of cross-border orders. Orders to
+// countries without a rule, such as domestic ones, pay none.
+type DutyEngine struct {
+	Rules []*DutyRule `json:"destinations"`
+}
+
+// dutyEstimate is the duties and taxes of an order, in USD.
+type dutyEstimate struct {
+	destination string
+	goods       money.Micros
+	duties      money.Micros
+	taxes       money.Micros
+}
+
+// LoadDutyEngine reads the duties file at path.
+func LoadDutyEngine(path string) (*DutyEngine, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open duties file: %v", err)
+	}
+	var e DutyEngine
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("failed to parse duties: %v", err)
+	}
+	if err := e.validate(); err != nil {
+		return nil, fmt.Errorf("invalid duties: %v", err)
+	}
+	return &e, nil
+}
+
+func (e *DutyEngine) validate() error {
+	destinations := make(map[string]bool, len(e.Rules))
+	countries := make(map[string]string)
+	for _, r := range e.Rules {
+		if r.Destination == "" || destinations[r.Destination] {
+			return fmt.Errorf("destination %q must have a unique name", r.Destination)
+		}
+		destinations[r.Destination] = true
+		if len(r.Countries) == 0 {
+			return fmt.Errorf("destination %q has no countries", r.Destination)
+		}
+		for _, c := range r.Countries {
+			key := strings.ToUpper(c)
+			if other, ok := countries[key]; ok {
+				return fmt.Errorf("country %q is in both %q and %q", c, other, r.Destination)
+			}
+			countries[key] = r.Destination
+		}
+		if r.DeMinimis < 0 || r.TaxDeMinimis < 0 {
+			return fmt.Errorf("destination %q has a negative de minimis", r.Destination)
+		}
+		rates := []money.Micros{r.DefaultDutyRate, r.TaxRate}
+		for _, rate := range r.DutyRates {
+			rates = append(rates, rate)
+		}
+		for _, rate := range rates {
+			if rate < 0 || rate > money.FromUnits(100) {
+				return fmt.Errorf("destination %q has a rate outside of 0 to 100 percent", r.Destination)
+			}
+		}
+	}
+	return nil
+}
+
+// Rule returns the rule for the country of a destination, or nil if its
+// orders pay no duties.
+func (e *DutyEngine) Rule(country string) *DutyRule {
+	country = strings.TrimSpace(country)
+	for _, r := range e.Rules {
+		for _, c := range r.Countries {
+			if strings.EqualFold(c, country) {
+				return r
+			}
+		}
+	}
+	return nil
+}
+
+// Estimate computes the duties and taxes of an order shipped for shippingUSD.
+// Goods are valued at their catalog price.
+func (r *DutyRule) Estimate(lines []orderLine, shippingUSD money.Micros) dutyEstimate {
+	est := dutyEstimate{destination: r.Destination}
+	values := make([]money.Micros, len(lines))
+	for i, line := range lines {
+		price := money.FromProto(line.product.GetPriceUsd()).Amount
+		values[i] = price.Mul(money.FromUnits(int64(line.item.GetQuantity())))
+		est.goods += values[i]
+	}
+
+	if est.goods > r.DeMinimis {
+		for i, line := range lines {
+			est.duties += percent(values[i], r.dutyRate(line.product.GetCategories()))
+		}
+	}
+	if est.goods > r.TaxDeMinimis {
+		taxable := est.goods + est.duties
+		if r.TaxIncludesShipping {
+			taxable += shippingUSD
+		}
+		est.taxes = percent(taxable, r.TaxRate)
+	}
+	return est
+}
+
+// dutyRate returns the rate of the first category with one, or the default.
+func (r *DutyRule) dutyRate(categories []string) money.Micros {
+	for _, c := range categories {
+		if rate, ok := r.DutyRates[c]; ok {
+			return rate
+		}
+	}
+	return r.DefaultDutyRate
+}
+
+// percent returns rate percent of an amount.
+func percent(amount, rate money.Micros) money.Micros {
+	return amount.MulRatio(int64(rate), int64(money.FromUnits(100)))
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"time"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+)
+
+// shipmentStages lists the statuses a shipment goes through, in order.
+var shipmentStages = []pb.ShipmentStatus{
+	pb.ShipmentStatus_LABEL_CREATED,
+	pb.ShipmentStatus_IN_TRANSIT,
+	pb.ShipmentStatus_OUT_FOR_DELIVERY,
+	pb.ShipmentStatus_DELIVERED,
+}
+
+// final reports whether a shipment status never changes.
+func final(status pb.ShipmentStatus) bool {
+	return status == pb.ShipmentStatus_DELIVERED || status == pb.ShipmentStatus_CANCELED
+}
+
+// returnStages lists the statuses a return goes through until it is received.
+// It is refunded afterwards.
+var returnStages = []pb.ReturnStatus{
+	pb.ReturnStatus_RETURN_LABEL_CREATED,
+	pb.ReturnStatus_RETURN_IN_TRANSIT,
+	pb.ReturnStatus_RETURN_RECEIVED,
+}
+
+// lifecycle simulates the progress of shipments and returns: they move to the
+// next stage every time stage elapses, until they are delivered or received.
+type lifecycle struct {
+	stage time.Duration
+}
+
+// stageAt returns the index in shipmentStages reached at now by a shipment
+// created at created.
+func (l lifecycle) stageAt(created, now time.Time) int {
+	return l.indexAt(created, now, len(shipmentStages))
+}
+
+// indexAt returns the stage reached at now, out of n stages, by a shipment or
+// return created at created.
+func (l lifecycle) indexAt(created, now time.Time, n int) int {
+	if now.Before(created) {
+		return 0
+	}
+	i := int(now.Sub(created) / l.stage)
+	if i >= n {
+		i = n - 1
+	}
+	return i
+}
+
+// received reports whether a return created at created was received at now.
+func (l lifecycle) received(created, now time.Time) bool {
+	return l.indexAt(created, now, len(returnStages)) == len(returnStages)-1
+}
+
+// returnEventsAt lists the status changes of a return created at created, up
+// to now, ending with its refund if refunded is not zero.
+func (l lifecycle) returnEventsAt(created, refunded, now time.Time) []*pb.ReturnEvent {
+	last := l.indexAt(created, now, len(returnStages))
+	events := make([]*pb.ReturnEvent, last+1)
+	for i := 0; i <= last; i++ {
+		events[i] = &pb.ReturnEvent{
+			Status: returnStages[i],
+			Time:   created.Add(time.Duration(i) * l.stage).Format(time.RFC3339),
+		}
+	}
+	if !refunded.IsZero() {
+		events = append(events, &pb.ReturnEvent{
+			Status: pb.ReturnStatus_RETURN_REFUNDED,
+			Time:   refunded.Format(time.RFC3339),
+		})
+	}
+	return events
+}
+
+// eventsAt lists the status changes of a shipment created at created, up to now.
+func (l lifecycle) eventsAt(created, now time.Time) []*pb.ShipmentEvent {
+	last := l.stageAt(created, now)
+	events := make([]*pb.ShipmentEvent, last+1)
+	for i := 0; i <= last; i++ {
+		events[i] = &pb.ShipmentEvent{
+			Status: shipmentStages[i],
+			Time:   created.Add(time.Duration(i) * l.stage).Format(time.RFC3339),
+		}
+	}
+	return events
+}
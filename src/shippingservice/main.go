@@ -2,8 +2,11 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"net"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -14,11 +17,24 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+	"github.com/abruneau/hipstershop/src/shippingservice/store"
+	"github.com/abruneau/hipstershop/src/shippingservice/tracking"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 const (
-	defaultPort = "50051"
+	defaultPort         = "50051"
+	defaultRatesConfig  = "rates.json"
+	defaultHolidays     = "holidays.json"
+	defaultAddressRules = "address_rules.json"
+	defaultPromotions   = "promotions.json"
+	defaultDuties       = "duties.json"
+	defaultRestrictions = "restrictions.json"
+	defaultPickupPoints = "pickup_points.json"
+	defaultStage        = 2 * time.Minute
+	defaultReturnPoll   = 30 * time.Second
+	defaultWatch        = 5 * time.Second
+	defaultWebhookTries = 5
 )
 
 var log *logrus.Logger
@@ -44,14 +60,202 @@ func main() {
 	}
 	port = fmt.Sprintf(":%s", port)
 
+	ratesConfig := defaultRatesConfig
+	if value, ok := os.LookupEnv("RATES_CONFIG"); ok {
+		ratesConfig = value
+	}
+	rates, err := LoadRateEngine(ratesConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	holidays := defaultHolidays
+	if value, ok := os.LookupEnv("HOLIDAYS_CONFIG"); ok {
+		holidays = value
+	}
+	calendar, err := LoadBusinessCalendar(holidays)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	promotionsConfig := defaultPromotions
+	if value, ok := os.LookupEnv("PROMOTIONS_CONFIG"); ok {
+		promotionsConfig = value
+	}
+	promotions, err := LoadPromotionEngine(promotionsConfig, rates)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	dutiesConfig := defaultDuties
+	if value, ok := os.LookupEnv("DUTIES_CONFIG"); ok {
+		dutiesConfig = value
+	}
+	duties, err := LoadDutyEngine(dutiesConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	restrictionsConfig := defaultRestrictions
+	if value, ok := os.LookupEnv("RESTRICTIONS_CONFIG"); ok {
+		restrictionsConfig = value
+	}
+	restrictions, err := LoadRestrictionEngine(restrictionsConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	addressRules := defaultAddressRules
+	if value, ok := os.LookupEnv("ADDRESS_RULES_CONFIG"); ok {
+		addressRules = value
+	}
+	addresses, err := LoadAddressValidator(addressRules)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	pickupPoints := defaultPickupPoints
+	if value, ok := os.LookupEnv("PICKUP_POINTS_CONFIG"); ok {
+		pickupPoints = value
+	}
+	pickups, err := LoadPickupDirectory(pickupPoints, addresses)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	lis, err := net.Listen("tcp", port)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
+	stage := defaultStage
+	if value, ok := os.LookupEnv("SHIPMENT_STAGE_DURATION"); ok {
+		stage, err = time.ParseDuration(value)
+		if err != nil || stage <= 0 {
+			log.Fatalf("failed to parse SHIPMENT_STAGE_DURATION (%s) as a positive time.Duration: %v", value, err)
+		}
+	}
+
+	// The shipment lifecycle is shared by the simulated carriers.
+	life := lifecycle{stage: stage}
+	var enabled []string
+	if value, ok := os.LookupEnv("ENABLED_CARRIERS"); ok && value != "" {
+		enabled = strings.Split(value, ",")
+	}
+	carriers, err := rates.startCarriers(enabled, life, time.Now)
+	if err != nil {
+		log.Fatalf("failed to start carriers: %v", err)
+	}
+
+	shipments, err := store.New(log)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer shipments.Disconnect()
+
+	var products productResolver = noCatalog{}
+	if addr, ok := os.LookupEnv("PRODUCT_CATALOG_SERVICE_ADDR"); ok {
+		conn, err := grpc.Dial(addr, grpc.WithInsecure())
+		if err != nil {
+			log.Fatalf("failed to connect product catalog service: %v", err)
+		}
+		defer conn.Close()
+		products = newCatalogResolver(conn)
+	} else {
+		log.Warn("PRODUCT_CATALOG_SERVICE_ADDR not set, pricing every item at the default weight")
+	}
+
+	var currencies currencyConverter = noConverter{}
+	if addr, ok := os.LookupEnv("CURRENCY_SERVICE_ADDR"); ok {
+		conn, err := grpc.Dial(addr, grpc.WithInsecure())
+		if err != nil {
+			log.Fatalf("failed to connect currency service: %v", err)
+		}
+		defer conn.Close()
+		currencies = newCurrencyServiceConverter(conn)
+	} else {
+		log.Warn("CURRENCY_SERVICE_ADDR not set, quoting in USD only")
+	}
+
+	var refunds refunder
+	if addr, ok := os.LookupEnv("CHECKOUT_SERVICE_ADDR"); ok {
+		conn, err := grpc.Dial(addr, grpc.WithInsecure())
+		if err != nil {
+			log.Fatalf("failed to connect checkout service: %v", err)
+		}
+		defer conn.Close()
+		refunds = newCheckoutRefunder(conn)
+	} else {
+		log.Warn("CHECKOUT_SERVICE_ADDR not set, received returns are not refunded")
+	}
+
+	watch := defaultWatch
+	if value, ok := os.LookupEnv("SHIPMENT_WATCH_INTERVAL"); ok {
+		watch, err = time.ParseDuration(value)
+		if err != nil || watch <= 0 {
+			log.Fatalf("failed to parse SHIPMENT_WATCH_INTERVAL (%s) as a positive time.Duration: %v", value, err)
+		}
+	}
+
+	var webhooks *webhookDispatcher
+	if url, ok := os.LookupEnv("WEBHOOK_URL"); ok {
+		secret := os.Getenv("WEBHOOK_SECRET")
+		if secret == "" {
+			log.Fatal("WEBHOOK_SECRET must be set to sign the requests to WEBHOOK_URL")
+		}
+		attempts := defaultWebhookTries
+		if value, ok := os.LookupEnv("WEBHOOK_MAX_ATTEMPTS"); ok {
+			attempts, err = strconv.Atoi(value)
+			if err != nil || attempts <= 0 {
+				log.Fatalf("failed to parse WEBHOOK_MAX_ATTEMPTS (%s) as a positive integer: %v", value, err)
+			}
+		}
+		var deadLetters io.Writer
+		if path, ok := os.LookupEnv("WEBHOOK_DEAD_LETTER_LOG"); ok {
+			f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+			if err != nil {
+				log.Fatalf("failed to open webhook dead letter log: %v", err)
+			}
+			defer f.Close()
+			deadLetters = f
+		}
+		webhooks = newWebhookDispatcher(url, secret, attempts, deadLetters)
+	}
+
 	var srv *grpc.Server
 	srv = grpc.NewServer()
-	svc := &server{}
+	svc := &server{
+		rates:         rates,
+		promotions:    promotions,
+		duties:        duties,
+		restrictions:  restrictions,
+		calendar:      calendar,
+		addresses:     addresses,
+		pickups:       pickups,
+		products:      products,
+		currencies:    currencies,
+		shipments:     shipments,
+		refunds:       refunds,
+		trackingIDs:   tracking.NewIDGenerator(),
+		carriers:      carriers,
+		lifecycle:     life,
+		watchInterval: watch,
+		now:           time.Now,
+	}
+	if refunds != nil {
+		poll := defaultReturnPoll
+		if value, ok := os.LookupEnv("RETURN_POLL_INTERVAL"); ok {
+			poll, err = time.ParseDuration(value)
+			if err != nil || poll <= 0 {
+				log.Fatalf("failed to parse RETURN_POLL_INTERVAL (%s) as a positive time.Duration: %v", value, err)
+			}
+		}
+		go svc.watchReturns(context.Background(), poll)
+	}
+	if webhooks != nil {
+		go webhooks.Run(context.Background())
+		go svc.monitorShipments(context.Background(), watch, webhooks.Publish)
+	}
 	pb.RegisterShippingServiceServer(srv, svc)
 	healthpb.RegisterHealthServer(srv, svc)
 	log.Infof("Shipping Service listening on port %s", port)
@@ -64,7 +268,25 @@ func main() {
 }
 
 // server controls RPC service responses.
-type server struct{}
+type server struct {
+	rates        *RateEngine
+	promotions   *PromotionEngine
+	duties       *DutyEngine
+	restrictions *RestrictionEngine
+	calendar     *BusinessCalendar
+	addresses    *AddressValidator
+	pickups      *PickupDirectory
+	products     productResolver
+	currencies   currencyConverter
+	carriers     []enabledCarrier
+	shipments    store.Store
+	refunds      refunder
+	trackingIDs  tracking.IDGenerator
+	lifecycle    lifecycle
+	// watchInterval is how often shipments are tracked to find status changes.
+	watchInterval time.Duration
+	now           func() time.Time
+}
 
 // Check is for health checking.
 func (s *server) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
@@ -75,41 +297,297 @@ func (s *server) Watch(req *healthpb.HealthCheckRequest, ws healthpb.Health_Watc
 	return status.Errorf(codes.Unimplemented, "health check via Watch not implemented")
 }
 
-// GetQuote produces a shipping quote (cost) in USD.
+// GetQuote produces a shipping quote (cost) in USD and in the requested currency.
 func (s *server) GetQuote(ctx context.Context, in *pb.GetQuoteRequest) (*pb.GetQuoteResponse, error) {
 	log.Info("[GetQuote] received request")
 	defer log.Info("[GetQuote] completed request")
 
-	// 1. Our quote system requires the total number of items to be shipped.
-	count := 0
-	for _, item := range in.Items {
-		count += int(item.Quantity)
+	// 1. Find the shipping zone serving the destination address.
+	zone, err := s.rates.Zone(in.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// 2. Generate a quote based on the total number of items to be shipped.
-	quote := CreateQuoteFromCount(count)
+	// 2. Pack the items to be shipped into parcels.
+	lines, err := s.lookupProducts(ctx, in.Items)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkRestrictions(in.Address, lines); err != nil {
+		return nil, err
+	}
+	parcels := s.packOrder(lines)
 
-	// 3. Generate a response.
+	// 3. Shop the rates of the carriers for the shipping option.
+	option, err := s.rates.ShippingOption(in.ShippingOptionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	params, err := s.quoteParamsFrom(ctx, in.CurrencyCode, in.Subtotal, in.PromoCode)
+	if err != nil {
+		return nil, err
+	}
+	quotes, err := s.collectRates(ctx, zone, parcels)
+	if err != nil {
+		return nil, err
+	}
+	best := s.bestOffer(quotes, option, in.RateShopping, s.now())
+
+	// 4. Generate a quote with the best promotion for the order.
+	usd, cost, promo, err := s.quoteOffer(ctx, zone, option, best, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// 5. Estimate the duties and taxes of a cross-border order.
+	duties, err := s.quoteDuties(ctx, in.Address.GetCountry(), lines, usd.Amount, params.currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// 6. Generate a response.
 	return &pb.GetQuoteResponse{
-		CostUsd: &pb.Money{
-			CurrencyCode: "USD",
-			Units:        int64(quote.Dollars),
-			Nanos:        int32(quote.Cents * 10000000)},
+		CostUsd:     usd.Proto(),
+		Cost:        cost.Proto(),
+		Promotion:   promo,
+		CarrierId:   best.carrier.ID(),
+		CarrierName: best.carrier.Name(),
+		Duties:      duties,
 	}, nil
 
 }
 
-// ShipOrder mocks that the requested items will be shipped.
-// It supplies a tracking ID for notional lookup of shipment delivery status.
+// ListShippingOptions quotes every shipping option for the order, along with
+// its estimated delivery window.
+func (s *server) ListShippingOptions(ctx context.Context, in *pb.ListShippingOptionsRequest) (*pb.ListShippingOptionsResponse, error) {
+	log.Info("[ListShippingOptions] received request")
+	defer log.Info("[ListShippingOptions] completed request")
+
+	zone, err := s.rates.Zone(in.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	lines, err := s.lookupProducts(ctx, in.Items)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkRestrictions(in.Address, lines); err != nil {
+		return nil, err
+	}
+	parcels := s.packOrder(lines)
+	params, err := s.quoteParamsFrom(ctx, in.CurrencyCode, in.Subtotal, in.PromoCode)
+	if err != nil {
+		return nil, err
+	}
+	quotes, err := s.collectRates(ctx, zone, parcels)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+
+	options := make([]*pb.ShippingOption, len(s.rates.ShippingOptions))
+	for i, o := range s.rates.ShippingOptions {
+		best := s.bestOffer(quotes, o, in.RateShopping, now)
+		usd, cost, promo, err := s.quoteOffer(ctx, zone, o, best, params)
+		if err != nil {
+			return nil, err
+		}
+		options[i] = &pb.ShippingOption{
+			Id:                   o.ID,
+			Name:                 o.Name,
+			CostUsd:              usd.Proto(),
+			Cost:                 cost.Proto(),
+			Promotion:            promo,
+			EarliestDeliveryDate: best.earliest.Format(dateLayout),
+			LatestDeliveryDate:   best.latest.Format(dateLayout),
+			CarrierId:            best.carrier.ID(),
+			CarrierName:          best.carrier.Name(),
+		}
+	}
+	return &pb.ListShippingOptionsResponse{Options: options}, nil
+}
+
+// ValidateAddress checks the required fields and postal code format of an
+// address for its country, and returns it normalized.
+func (s *server) ValidateAddress(ctx context.Context, in *pb.ValidateAddressRequest) (*pb.ValidateAddressResponse, error) {
+	log.Info("[ValidateAddress] received request")
+	defer log.Info("[ValidateAddress] completed request")
+
+	addr, errs := s.addresses.Validate(in.Address)
+	if len(errs) > 0 {
+		return &pb.ValidateAddressResponse{Errors: errs}, nil
+	}
+	return &pb.ValidateAddressResponse{Valid: true, NormalizedAddress: addr}, nil
+}
+
+// ShipOrder mocks that the requested items will be shipped, split into
+// parcels, to an address or a pickup point. It supplies a tracking ID per
+// parcel for lookup of its delivery status.
 func (s *server) ShipOrder(ctx context.Context, in *pb.ShipOrderRequest) (*pb.ShipOrderResponse, error) {
 	log.Info("[ShipOrder] received request")
 	defer log.Info("[ShipOrder] completed request")
-	// 1. Create a Tracking ID
-	baseAddress := fmt.Sprintf("%s, %s, %s", in.Address.StreetAddress, in.Address.City, in.Address.State)
-	id := CreateTrackingID(baseAddress)
+	var pickup *PickupPoint
+	addr := in.Address
+	if in.PickupPointId != "" {
+		var ok bool
+		if pickup, ok = s.pickups.Point(in.PickupPointId); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown pickup point %q", in.PickupPointId)
+		}
+		addr = pickup.Address
+	}
+	addr, errs := s.addresses.Validate(addr)
+	if len(errs) > 0 {
+		return nil, status.Error(codes.InvalidArgument, addressErrorString(errs))
+	}
+	zone, err := s.rates.Zone(addr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	option, err := s.rates.ShippingOption(in.ShippingOptionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	lines, err := s.lookupProducts(ctx, in.Items)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkRestrictions(addr, lines); err != nil {
+		return nil, err
+	}
+	parcels := s.packOrder(lines)
 
-	// 2. Generate a response.
-	return &pb.ShipOrderResponse{
-		TrackingId: id,
-	}, nil
+	// 1. Choose the carrier: the one quoted to the customer, or the cheapest.
+	now := s.now()
+	var quotes []carrierQuote
+	if in.CarrierId != "" {
+		c, ok := s.carrier(in.CarrierId)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown carrier %q", in.CarrierId)
+		}
+		q, err := s.quoteCarrier(ctx, c, zone, parcels)
+		if err != nil {
+			return nil, err
+		}
+		quotes = []carrierQuote{q}
+	} else if quotes, err = s.collectRates(ctx, zone, parcels); err != nil {
+		return nil, err
+	}
+	best := s.bestOffer(quotes, option, pb.RateShopping_CHEAPEST, now)
+	log.Infof("[ShipOrder] shipping with option %q by %s", option.ID, best.carrier.ID())
+
+	// 2. Buy a label from the carrier for every parcel. If one cannot be
+	// bought, the others are voided so that the order can be shipped again.
+	labels := make([]Label, 0, len(parcels))
+	for _, p := range parcels {
+		label, err := s.createLabel(ctx, best.carrier, zone, p.shipment)
+		if err != nil {
+			for _, l := range labels {
+				s.cancelLabel(ctx, best.carrier, l)
+			}
+			return nil, err
+		}
+		labels = append(labels, label)
+	}
+
+	res := &pb.ShipOrderResponse{}
+	for i, p := range parcels {
+		// 3. Create a Tracking ID
+		id := s.trackingIDs.New()
+
+		// 4. Record the shipment so that its status can be tracked.
+		if err := s.shipments.PutShipment(&store.Shipment{
+			TrackingID:            id,
+			OrderID:               in.OrderId,
+			Address:               addr,
+			PickupPointID:         in.PickupPointId,
+			Items:                 p.items,
+			ShippingOptionID:      option.ID,
+			CarrierID:             labels[i].CarrierID,
+			CarrierTrackingNumber: labels[i].TrackingNumber,
+			CreatedAt:             labels[i].CreatedAt,
+			EstimatedArrival:      best.latest,
+		}); err != nil {
+			// The parcels saved so far are canceled with the order, but the
+			// labels of the others would never be voided.
+			for _, l := range labels[i:] {
+				s.cancelLabel(ctx, best.carrier, l)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to save shipment: %v", err)
+		}
+		res.Parcels = append(res.Parcels, &pb.ShippedParcel{
+			TrackingId:            id,
+			Items:                 p.items,
+			CarrierId:             best.carrier.ID(),
+			CarrierName:           best.carrier.Name(),
+			CarrierTrackingNumber: labels[i].TrackingNumber,
+			EstimatedDeliveryDate: best.latest.Format(dateLayout),
+		})
+	}
+	log.Infof("[ShipOrder] shipped order %q in %d parcels", in.OrderId, len(parcels))
+
+	// 5. Generate a response.
+	res.TrackingId = res.Parcels[0].TrackingId
+	if pickup != nil {
+		res.PickupPoint = pickup.Proto()
+	}
+	return res, nil
+}
+
+// GetShipment returns a shipment and its delivery status.
+func (s *server) GetShipment(ctx context.Context, in *pb.GetShipmentRequest) (*pb.Shipment, error) {
+	log.Info("[GetShipment] received request")
+	defer log.Info("[GetShipment] completed request")
+
+	if err := tracking.ValidateTrackingID(in.TrackingId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: %q", err, in.TrackingId)
+	}
+	shipment, err := s.shipments.GetShipment(in.TrackingId)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "no shipment with tracking ID %s", in.TrackingId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shipment: %v", err)
+	}
+
+	res := &pb.Shipment{
+		TrackingId:            shipment.TrackingID,
+		OrderId:               shipment.OrderID,
+		Address:               shipment.Address,
+		Items:                 shipment.Items,
+		ShippingOptionId:      shipment.ShippingOptionID,
+		EstimatedDeliveryDate: shipment.EstimatedArrival.Format(dateLayout),
+		CarrierId:             shipment.CarrierID,
+		CarrierTrackingNumber: shipment.CarrierTrackingNumber,
+		PickupPointId:         shipment.PickupPointID,
+	}
+	if c, ok := s.carrier(shipment.CarrierID); ok {
+		res.CarrierName = c.Name()
+	}
+	res.Events, err = s.shipmentEvents(ctx, shipment)
+	if err != nil {
+		return nil, err
+	}
+	res.Status = res.Events[len(res.Events)-1].Status
+	return res, nil
+}
+
+// shipmentEvents lists the status changes of a shipment as tracked by its
+// carrier.
+func (s *server) shipmentEvents(ctx context.Context, shipment *store.Shipment) ([]*pb.ShipmentEvent, error) {
+	if !shipment.CanceledAt.IsZero() {
+		// Shipments are canceled before they are picked up.
+		return []*pb.ShipmentEvent{
+			{Status: pb.ShipmentStatus_LABEL_CREATED, Time: shipment.CreatedAt.Format(time.RFC3339)},
+			{Status: pb.ShipmentStatus_CANCELED, Time: shipment.CanceledAt.Format(time.RFC3339)},
+		}, nil
+	}
+	if shipment.CarrierID == "" {
+		// Shipments saved before carriers were introduced follow the lifecycle.
+		return s.lifecycle.eventsAt(shipment.CreatedAt, s.now()), nil
+	}
+	c, ok := s.carrier(shipment.CarrierID)
+	if !ok {
+		return nil, status.Errorf(codes.Unavailable, "carrier %s is not enabled", shipment.CarrierID)
+	}
+	return s.track(ctx, c, shipment)
 }
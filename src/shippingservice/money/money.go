@@ -0,0 +1,222 @@
+// Package money implements exact decimal arithmetic for shipping quotes.
+//
+// Amounts are fixed-point numbers counted in millionths (micros), so prices
+// and rates read from configuration are represented exactly. Intermediate
+// results are rounded to the micro, and a quote is rounded only once, to the
+// minor unit of its currency. Rounding is half away from zero.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+)
+
+const (
+	// microsPerUnit is the number of micros in one unit.
+	microsPerUnit = 1000000
+	// microsDigits is the number of fractional digits of a Micros value.
+	microsDigits = 6
+	// nanosPerMicro converts micros to the nanos of a pb.Money.
+	nanosPerMicro = 1000
+)
+
+var (
+	ErrInvalidDecimal = errors.New("invalid decimal number")
+	ErrTooPrecise     = errors.New("decimal number has more than 6 fractional digits")
+)
+
+// Micros is a decimal number with six fractional digits, such as a price, a
+// rate or a multiplier. One unit is 1000000 micros.
+type Micros int64
+
+// FromUnits returns a whole number of units.
+func FromUnits(units int64) Micros { return Micros(units * microsPerUnit) }
+
+// Parse reads a decimal number such as "4.99" or "-0.5" exactly.
+func Parse(s string) (Micros, error) {
+	neg := strings.HasPrefix(s, "-")
+	digits := strings.TrimPrefix(s, "-")
+	whole, frac := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		whole, frac = digits[:i], digits[i+1:]
+		if frac == "" {
+			return 0, ErrInvalidDecimal
+		}
+	}
+	if whole == "" || !isDigits(whole) || !isDigits(frac) {
+		return 0, ErrInvalidDecimal
+	}
+	if len(frac) > microsDigits {
+		return 0, ErrTooPrecise
+	}
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || units > (1<<63-1)/microsPerUnit {
+		return 0, ErrInvalidDecimal
+	}
+	fraction, _ := strconv.ParseInt(frac+strings.Repeat("0", microsDigits-len(frac)), 10, 64)
+	m := Micros(units*microsPerUnit + fraction)
+	if neg {
+		m = -m
+	}
+	return m, nil
+}
+
+// MustParse is like Parse but panics if s is not a valid decimal number.
+func MustParse(s string) Micros {
+	m, err := Parse(s)
+	if err != nil {
+		panic(fmt.Sprintf("money: cannot parse %q: %v", s, err))
+	}
+	return m
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// UnmarshalJSON reads a JSON number without going through a float64.
+func (m *Micros) UnmarshalJSON(b []byte) error {
+	v, err := Parse(string(b))
+	if err != nil {
+		return fmt.Errorf("%s: %v", b, err)
+	}
+	*m = v
+	return nil
+}
+
+// MulRatio returns m * num / den, rounded to the micro.
+func (m Micros) MulRatio(num, den int64) Micros {
+	r := new(big.Rat).SetFrac(new(big.Int).Mul(big.NewInt(int64(m)), big.NewInt(num)), big.NewInt(den))
+	return Micros(roundRat(r))
+}
+
+// Mul returns the product of two decimal numbers, rounded to the micro.
+func (m Micros) Mul(factor Micros) Micros {
+	return m.MulRatio(int64(factor), microsPerUnit)
+}
+
+// Round rounds m to the given number of fractional digits, half away from zero.
+func (m Micros) Round(places int) Micros {
+	if places >= microsDigits {
+		return m
+	}
+	step := pow10(microsDigits - places)
+	return Micros(roundRat(big.NewRat(int64(m), step)) * step)
+}
+
+// roundRat rounds a rational number to the nearest integer, half away from zero.
+func roundRat(r *big.Rat) int64 {
+	num, den := new(big.Int).Abs(r.Num()), r.Denom()
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Lsh(rem, 1).Cmp(den) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if r.Sign() < 0 {
+		q.Neg(q)
+	}
+	return q.Int64()
+}
+
+func pow10(n int) int64 {
+	p := int64(1)
+	for i := 0; i < n; i++ {
+		p *= 10
+	}
+	return p
+}
+
+// Format renders m with exactly the given number of fractional digits,
+// rounding it first.
+func (m Micros) Format(places int) string {
+	if places > microsDigits {
+		places = microsDigits
+	}
+	m = m.Round(places)
+	sign := ""
+	if m < 0 {
+		sign, m = "-", -m
+	}
+	units, fraction := int64(m)/microsPerUnit, int64(m)%microsPerUnit
+	if places == 0 {
+		return fmt.Sprintf("%s%d", sign, units)
+	}
+	return fmt.Sprintf("%s%d.%0*d", sign, units, places, fraction/pow10(microsDigits-places))
+}
+
+// String renders m with all six fractional digits.
+func (m Micros) String() string { return m.Format(microsDigits) }
+
+// zeroDecimalCurrencies and threeDecimalCurrencies list the ISO 4217
+// currencies whose minor unit is not a hundredth.
+var (
+	zeroDecimalCurrencies  = []string{"BIF", "CLP", "DJF", "GNF", "ISK", "JPY", "KMF", "KRW", "PYG", "RWF", "UGX", "UYI", "VND", "VUV", "XAF", "XOF", "XPF"}
+	threeDecimalCurrencies = []string{"BHD", "IQD", "JOD", "KWD", "LYD", "OMR", "TND"}
+)
+
+// MinorUnitDigits returns the number of fractional digits of the currency's
+// minor unit: 2 for USD cents, 0 for JPY, 3 for KWD.
+func MinorUnitDigits(currency string) int {
+	for _, c := range zeroDecimalCurrencies {
+		if c == currency {
+			return 0
+		}
+	}
+	for _, c := range threeDecimalCurrencies {
+		if c == currency {
+			return 3
+		}
+	}
+	return 2
+}
+
+// Money is an amount in a currency.
+type Money struct {
+	CurrencyCode string
+	Amount       Micros
+}
+
+// New returns an amount in a currency, rounded to the currency's minor unit.
+func New(currency string, amount Micros) Money {
+	return Money{CurrencyCode: currency, Amount: amount.Round(MinorUnitDigits(currency))}
+}
+
+// String renders m rounded to its currency's minor unit, such as "$11.20"
+// for US dollars or "1200 JPY" for other currencies.
+func (m Money) String() string {
+	s := m.Amount.Format(MinorUnitDigits(m.CurrencyCode))
+	if m.CurrencyCode == "USD" {
+		if strings.HasPrefix(s, "-") {
+			return "-$" + s[1:]
+		}
+		return "$" + s
+	}
+	return s + " " + m.CurrencyCode
+}
+
+// Proto converts m to its protobuf representation.
+func (m Money) Proto() *pb.Money {
+	return &pb.Money{
+		CurrencyCode: m.CurrencyCode,
+		Units:        int64(m.Amount) / microsPerUnit,
+		Nanos:        int32(int64(m.Amount) % microsPerUnit * nanosPerMicro),
+	}
+}
+
+// FromProto converts a protobuf amount, rounding its nanos to the micro.
+func FromProto(m *pb.Money) Money {
+	nanos := Micros(m.GetNanos()).MulRatio(1, nanosPerMicro)
+	return Money{
+		CurrencyCode: m.GetCurrencyCode(),
+		Amount:       FromUnits(m.GetUnits()) + nanos,
+	}
+}
@@ -0,0 +1,148 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Micros
+		err  error
+	}{
+		{"0", 0, nil},
+		{"4.99", 4990000, nil},
+		{"-0.5", -500000, nil},
+		{"0.29", 290000, nil},
+		{"12.000001", 12000001, nil},
+		{"1.0000001", 0, ErrTooPrecise},
+		{"1.", 0, ErrInvalidDecimal},
+		{".5", 0, ErrInvalidDecimal},
+		{"1e3", 0, ErrInvalidDecimal},
+		{"", 0, ErrInvalidDecimal},
+		{"99999999999999999999", 0, ErrInvalidDecimal},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if err != tt.err {
+			t.Errorf("Parse(%q): got error %v, expected %v", tt.in, err, tt.err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q) = %d, expected %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var v struct {
+		Rate Micros `json:"rate"`
+	}
+	// 0.29 is 0.28999999999999998 as a float64.
+	if err := json.Unmarshal([]byte(`{"rate": 0.29}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Rate != 290000 {
+		t.Errorf("got %d, expected %d", v.Rate, 290000)
+	}
+	if err := json.Unmarshal([]byte(`{"rate": 1.0000001}`), &v); err == nil {
+		t.Error("expected an error for a number with too many fractional digits")
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		in     string
+		places int
+		want   string
+	}{
+		{"1.004999", 2, "1.000000"},
+		{"1.005", 2, "1.010000"},
+		{"1.015", 2, "1.020000"},
+		{"2.675", 2, "2.680000"},
+		{"-1.005", 2, "-1.010000"},
+		{"-1.004", 2, "-1.000000"},
+		{"1199.5", 0, "1200.000000"},
+		{"1199.499999", 0, "1199.000000"},
+		{"0.0005", 3, "0.001000"},
+		{"1.234567", 6, "1.234567"},
+	}
+	for _, tt := range tests {
+		if got := MustParse(tt.in).Round(tt.places).String(); got != tt.want {
+			t.Errorf("Round(%s, %d) = %s, expected %s", tt.in, tt.places, got, tt.want)
+		}
+	}
+}
+
+func TestMul(t *testing.T) {
+	tests := []struct {
+		a, b, want string
+	}{
+		{"4.99", "1.5", "7.485"},
+		{"0.75", "1.3", "0.975"},
+		{"0.000001", "0.5", "0.000001"},
+		{"-0.000001", "0.5", "-0.000001"},
+		{"0.000001", "0.4", "0"},
+		{"92233.720368", "100000", "9223372036.8"},
+	}
+	for _, tt := range tests {
+		if got, want := MustParse(tt.a).Mul(MustParse(tt.b)), MustParse(tt.want); got != want {
+			t.Errorf("%s * %s = %s, expected %s", tt.a, tt.b, got, want)
+		}
+	}
+	if got := MustParse("0.50").MulRatio(1300, 1000); got != MustParse("0.65") {
+		t.Errorf("MulRatio: got %s, expected 0.65", got)
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		m    Money
+		want string
+	}{
+		{New("USD", MustParse("11.2")), "$11.20"},
+		{New("USD", MustParse("11.05")), "$11.05"},
+		{New("USD", MustParse("0.005")), "$0.01"},
+		{New("USD", MustParse("-3.5")), "-$3.50"},
+		{New("EUR", MustParse("8.294999")), "8.29 EUR"},
+		{New("JPY", MustParse("1199.5")), "1200 JPY"},
+		{New("KWD", MustParse("2.0005")), "2.001 KWD"},
+	}
+	for _, tt := range tests {
+		if got := tt.m.String(); got != tt.want {
+			t.Errorf("String(%v) = %q, expected %q", tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestProto(t *testing.T) {
+	tests := []struct {
+		m    Money
+		want *pb.Money
+	}{
+		{New("USD", MustParse("11.2")), &pb.Money{CurrencyCode: "USD", Units: 11, Nanos: 200000000}},
+		{New("USD", MustParse("0.07")), &pb.Money{CurrencyCode: "USD", Units: 0, Nanos: 70000000}},
+		{New("USD", MustParse("-1.25")), &pb.Money{CurrencyCode: "USD", Units: -1, Nanos: -250000000}},
+		{New("JPY", MustParse("1200")), &pb.Money{CurrencyCode: "JPY", Units: 1200}},
+	}
+	for _, tt := range tests {
+		got := tt.m.Proto()
+		if !proto.Equal(got, tt.want) {
+			t.Errorf("Proto(%v) = %v, expected %v", tt.m, got, tt.want)
+		}
+		if back := FromProto(got); back != tt.m {
+			t.Errorf("FromProto(%v) = %v, expected %v", got, back, tt.m)
+		}
+	}
+
+	// Nanos beyond the micro are rounded.
+	got := FromProto(&pb.Money{CurrencyCode: "EUR", Units: 8, Nanos: 294999500})
+	if want := MustParse("8.295"); got.Amount != want {
+		t.Errorf("FromProto: got %s, expected %s", got.Amount, want)
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/abruneau/hipstershop/src/shippingservice/money"
+)
+
+// defaultShippingOption is used when a request does not choose an option.
+const defaultShippingOption = "standard"
+
+// ShippingOption is a delivery speed offered to customers. It costs the
+// carrier's rate times Multiplier plus Surcharge, and is delivered between
+// MinDays and MaxDays business days after the order, plus the carrier's
+// transit days.
+type ShippingOption struct {
+	ID         string       `json:"id"`
+	Name       string       `json:"name"`
+	Multiplier money.Micros `json:"multiplier"`
+	Surcharge  money.Micros `json:"surcharge"`
+	MinDays    int          `json:"min_days"`
+	MaxDays    int          `json:"max_days"`
+}
+
+// ShippingOption looks up a shipping option by ID. An empty ID selects the
+// default option.
+func (e *RateEngine) ShippingOption(id string) (*ShippingOption, error) {
+	if id == "" {
+		id = defaultShippingOption
+	}
+	for _, o := range e.ShippingOptions {
+		if o.ID == id {
+			return o, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown shipping option %q", id)
+}
+
+// Price applies the option's pricing to the carrier rate of a shipment. Empty
+// shipments are free whatever the option.
+func (o *ShippingOption) Price(rate money.Micros) money.Micros {
+	if rate == 0 {
+		return 0
+	}
+	return rate.Mul(o.Multiplier) + o.Surcharge
+}
+
+// DeliveryWindow estimates the earliest and latest delivery dates of an order
+// placed at now and shipped by a carrier with the given transit days.
+func (o *ShippingOption) DeliveryWindow(cal *BusinessCalendar, transitDays int, now time.Time) (time.Time, time.Time) {
+	return cal.AddBusinessDays(now, o.MinDays+transitDays),
+		cal.AddBusinessDays(now, o.MaxDays+transitDays)
+}
+
+func (o *ShippingOption) validate() error {
+	if o.ID == "" {
+		return fmt.Errorf("shipping option %q has no id", o.Name)
+	}
+	if o.Multiplier <= 0 {
+		return fmt.Errorf("shipping option %q must have a positive multiplier", o.ID)
+	}
+	if o.MinDays < 1 || o.MaxDays < o.MinDays {
+		return fmt.Errorf("shipping option %q has an invalid delivery window", o.ID)
+	}
+	return nil
+}
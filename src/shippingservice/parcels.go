@@ -0,0 +1,124 @@
+package main
+
+import (
+	"errors"
+	"sort"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+)
+
+// ParcelLimits decide how orders are split into parcels. A parcel weighs at
+// most MaxWeightKg and holds at most MaxVolumeCm3 of packages, except for a
+// single item over the limits, which is shipped on its own. Items in one of
+// the SeparateCategories are never packed with items of another category.
+type ParcelLimits struct {
+	MaxWeightKg        float64  `json:"max_weight_kg"`
+	MaxVolumeCm3       float64  `json:"max_volume_cm3"`
+	SeparateCategories []string `json:"separate_categories"`
+}
+
+func (l ParcelLimits) validate() error {
+	if l.MaxWeightKg <= 0 || l.MaxVolumeCm3 <= 0 {
+		return errors.New("parcels must have a positive max_weight_kg and max_volume_cm3")
+	}
+	return nil
+}
+
+// parcel is a set of items packed and shipped together.
+type parcel struct {
+	shipment
+	// group is the separate category of the items, or empty for the others.
+	group     string
+	items     []*pb.CartItem
+	volumeCm3 float64
+}
+
+// unit is a single item to pack.
+type unit struct {
+	productID string
+	group     string
+	weightKg  float64
+	volumeCm3 float64
+}
+
+// add packs a unit into the parcel.
+func (p *parcel) add(u unit, divisor float64) {
+	p.shipment.items++
+	p.weightKg += u.weightKg
+	p.volumeCm3 += u.volumeCm3
+	p.dimensionalKg = p.volumeCm3 / divisor
+	for _, item := range p.items {
+		if item.ProductId == u.productID {
+			item.Quantity++
+			return
+		}
+	}
+	p.items = append(p.items, &pb.CartItem{ProductId: u.productID, Quantity: 1})
+}
+
+// fits reports whether the unit can be added to the parcel within the limits.
+func (p *parcel) fits(u unit, l ParcelLimits) bool {
+	if p.group != u.group {
+		return false
+	}
+	if p.shipment.items == 0 {
+		return true
+	}
+	return p.weightKg+u.weightKg <= l.MaxWeightKg && p.volumeCm3+u.volumeCm3 <= l.MaxVolumeCm3
+}
+
+// packOrder packs the items of an order into parcels by their package
+// dimensions, heaviest first, each into the first parcel it fits in. Products
+// without dimensions are counted at the default item weight and take no room.
+func (s *server) packOrder(lines []orderLine) []*parcel {
+	var (
+		units   []unit
+		missing int
+	)
+	for _, line := range lines {
+		item := line.item
+		u := unit{productID: item.GetProductId(), group: s.rates.Parcels.group(line.product)}
+		dims := line.product.GetDimensions()
+		if dims.GetWeightKg() == 0 {
+			u.weightKg = s.rates.DefaultItemWeightKg
+			missing++
+		} else {
+			u.weightKg = dims.GetWeightKg()
+			u.volumeCm3 = dims.GetLengthCm() * dims.GetWidthCm() * dims.GetHeightCm()
+		}
+		for i := int32(0); i < item.GetQuantity(); i++ {
+			units = append(units, u)
+		}
+	}
+	if missing > 0 {
+		log.Debugf("%d items have no package dimensions, using the default item weight", missing)
+	}
+
+	sort.SliceStable(units, func(i, j int) bool { return units[i].weightKg > units[j].weightKg })
+	var parcels []*parcel
+	for _, u := range units {
+		var into *parcel
+		for _, p := range parcels {
+			if p.fits(u, s.rates.Parcels) {
+				into = p
+				break
+			}
+		}
+		if into == nil {
+			into = &parcel{group: u.group}
+			parcels = append(parcels, into)
+		}
+		into.add(u, s.rates.DimensionalDivisor)
+	}
+	return parcels
+}
+
+// group returns the first separate category of a product, or an empty string.
+func (l ParcelLimits) group(product *pb.Product) string {
+	for _, c := range product.GetCategories() {
+		if contains(l.SeparateCategories, c) {
+			return c
+		}
+	}
+	return ""
+}
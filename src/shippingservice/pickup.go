@@ -0,0 +1,210 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+)
+
+// earthRadiusKm is the mean radius of the Earth.
+const earthRadiusKm = 6371.0
+
+// PickupPoint is a place where customers collect their orders.
+type PickupPoint struct {
+	ID           string      `json:"id"`
+	Name         string      `json:"name"`
+	Address      *pb.Address `json:"address"`
+	Latitude     float64     `json:"latitude"`
+	Longitude    float64     `json:"longitude"`
+	OpeningHours string      `json:"opening_hours"`
+}
+
+// PostalCentroid locates the postal codes of a country starting with
+// PostalPrefix, ignoring spaces.
+type PostalCentroid struct {
+	Country      string  `json:"country"`
+	PostalPrefix string  `json:"postal_prefix"`
+	Latitude     float64 `json:"latitude"`
+	Longitude    float64 `json:"longitude"`
+}
+
+// PickupDirectory finds the pickup points near an address. Addresses are
+// located at the centroid of their postal code, the one with the longest
+// matching prefix in their country.
+type PickupDirectory struct {
+	DefaultRadiusKm float64           `json:"default_radius_km"`
+	MaxRadiusKm     float64           `json:"max_radius_km"`
+	Centroids       []*PostalCentroid `json:"centroids"`
+	Points          []*PickupPoint    `json:"points"`
+}
+
+// nearbyPoint is a pickup point and its distance from the address searched.
+type nearbyPoint struct {
+	*PickupPoint
+	distanceKm float64
+}
+
+// LoadPickupDirectory reads the pickup points file at path. The addresses of
+// the points are checked and normalized with the address rules.
+func LoadPickupDirectory(path string, addresses *AddressValidator) (*PickupDirectory, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open pickup points file: %v", err)
+	}
+	var d PickupDirectory
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, fmt.Errorf("failed to parse pickup points: %v", err)
+	}
+	if err := d.validate(addresses); err != nil {
+		return nil, fmt.Errorf("invalid pickup points: %v", err)
+	}
+	return &d, nil
+}
+
+func (d *PickupDirectory) validate(addresses *AddressValidator) error {
+	if d.DefaultRadiusKm <= 0 || d.MaxRadiusKm < d.DefaultRadiusKm {
+		return errors.New("default_radius_km must be positive and at most max_radius_km")
+	}
+	for _, c := range d.Centroids {
+		if c.Country == "" || c.PostalPrefix == "" || !validCoordinates(c.Latitude, c.Longitude) {
+			return fmt.Errorf("centroid %s %q must have a country, a prefix and valid coordinates", c.Country, c.PostalPrefix)
+		}
+	}
+	ids := make(map[string]bool, len(d.Points))
+	for _, p := range d.Points {
+		if p.ID == "" || ids[p.ID] {
+			return fmt.Errorf("pickup point %q must have a unique id", p.ID)
+		}
+		ids[p.ID] = true
+		if p.Name == "" || !validCoordinates(p.Latitude, p.Longitude) {
+			return fmt.Errorf("pickup point %q must have a name and valid coordinates", p.ID)
+		}
+		addr, errs := addresses.Validate(p.Address)
+		if len(errs) > 0 {
+			return fmt.Errorf("pickup point %q has an invalid address: %s", p.ID, addressErrorString(errs))
+		}
+		p.Address = addr
+	}
+	return nil
+}
+
+func validCoordinates(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+// Point looks up a pickup point by ID.
+func (d *PickupDirectory) Point(id string) (*PickupPoint, bool) {
+	for _, p := range d.Points {
+		if p.ID == id {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// Locate returns the coordinates of a normalized address.
+func (d *PickupDirectory) Locate(addr *pb.Address) (lat, lng float64, err error) {
+	postal := strings.ToUpper(strings.Replace(postalCode(addr), " ", "", -1))
+	var best *PostalCentroid
+	for _, c := range d.Centroids {
+		prefix := strings.ToUpper(strings.Replace(c.PostalPrefix, " ", "", -1))
+		if !strings.EqualFold(c.Country, addr.GetCountry()) || !strings.HasPrefix(postal, prefix) {
+			continue
+		}
+		if best == nil || len(c.PostalPrefix) > len(best.PostalPrefix) {
+			best = c
+		}
+	}
+	if best == nil {
+		return 0, 0, fmt.Errorf("cannot locate postal code %q in %s", postalCode(addr), addr.GetCountry())
+	}
+	return best.Latitude, best.Longitude, nil
+}
+
+// Near lists the pickup points within radiusKm of the coordinates, nearest
+// first.
+func (d *PickupDirectory) Near(lat, lng, radiusKm float64) []nearbyPoint {
+	var near []nearbyPoint
+	for _, p := range d.Points {
+		if dist := haversineKm(lat, lng, p.Latitude, p.Longitude); dist <= radiusKm {
+			near = append(near, nearbyPoint{PickupPoint: p, distanceKm: dist})
+		}
+	}
+	sort.SliceStable(near, func(i, j int) bool { return near[i].distanceKm < near[j].distanceKm })
+	return near
+}
+
+// haversineKm returns the great-circle distance between two coordinates.
+func haversineKm(lat1, lng1, lat2, lng2 float64) float64 {
+	rad := math.Pi / 180
+	dLat := (lat2 - lat1) * rad
+	dLng := (lng2 - lng1) * rad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
+
+// Proto returns the pickup point as a message, with a copy of its address.
+func (p *PickupPoint) Proto() *pb.PickupPoint {
+	return &pb.PickupPoint{
+		Id:           p.ID,
+		Name:         p.Name,
+		Address:      proto.Clone(p.Address).(*pb.Address),
+		Latitude:     p.Latitude,
+		Longitude:    p.Longitude,
+		OpeningHours: p.OpeningHours,
+	}
+}
+
+// ListPickupPoints lists the pickup points near an address, nearest first.
+func (s *server) ListPickupPoints(ctx context.Context, in *pb.ListPickupPointsRequest) (*pb.ListPickupPointsResponse, error) {
+	log.Info("[ListPickupPoints] received request")
+	defer log.Info("[ListPickupPoints] completed request")
+
+	radius := in.RadiusKm
+	if radius == 0 {
+		radius = s.pickups.DefaultRadiusKm
+	}
+	if radius < 0 || radius > s.pickups.MaxRadiusKm {
+		return nil, status.Errorf(codes.InvalidArgument, "radius must be between 0 and %g km", s.pickups.MaxRadiusKm)
+	}
+	addr, errs := s.addresses.Validate(in.Address)
+	if len(errs) > 0 {
+		return nil, status.Error(codes.InvalidArgument, addressErrorString(errs))
+	}
+	lat, lng, err := s.pickups.Locate(addr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := &pb.ListPickupPointsResponse{}
+	for _, p := range s.pickups.Near(lat, lng, radius) {
+		point := p.Proto()
+		point.DistanceKm = math.Round(p.distanceKm*10) / 10
+		res.Points = append(res.Points, point)
+	}
+	return res, nil
+}
+
+// GetPickupPoint returns a pickup point by ID.
+func (s *server) GetPickupPoint(ctx context.Context, in *pb.GetPickupPointRequest) (*pb.PickupPoint, error) {
+	log.Info("[GetPickupPoint] received request")
+	defer log.Info("[GetPickupPoint] completed request")
+
+	p, ok := s.pickups.Point(in.Id)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no pickup point %q", in.Id)
+	}
+	return p.Proto(), nil
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/abruneau/hipstershop/src/shippingservice/money"
+)
+
+const (
+	// promoFreeShipping makes shipping free.
+	promoFreeShipping = "free_shipping"
+	// promoFlatRate replaces the cost of shipping with Price.
+	promoFlatRate = "flat_rate"
+	// promoPercentOff takes Percent percent off the cost of shipping.
+	promoPercentOff = "percent_off"
+	// promoAmountOff takes Amount off the cost of shipping.
+	promoAmountOff = "amount_off"
+)
+
+// Promotion discounts shipping for the orders it applies to. An order must
+// meet every condition that is set: the promo code, the minimum subtotal in
+// USD, the zones and shipping options, and the validity window. Starts and
+// Ends are YYYY-MM-DD dates in UTC and are both included.
+type Promotion struct {
+	ID              string       `json:"id"`
+	Description     string       `json:"description"`
+	Type            string       `json:"type"`
+	Code            string       `json:"code"`
+	MinSubtotal     money.Micros `json:"min_subtotal"`
+	Zones           []string     `json:"zones"`
+	ShippingOptions []string     `json:"shipping_options"`
+	Price           money.Micros `json:"price"`
+	Percent         money.Micros `json:"percent"`
+	Amount          money.Micros `json:"amount"`
+	Starts          string       `json:"starts"`
+	Ends            string       `json:"ends"`
+
+	starts, ends time.Time
+}
+
+// PromotionEngine picks the best promotion for an order.
+type PromotionEngine struct {
+	Promotions []*Promotion `json:"promotions"`
+}
+
+// promoOrder is what promotions know about an order being quoted.
+type promoOrder struct {
+	zone        string
+	option      string
+	subtotalUSD money.Micros
+	code        string
+	now         time.Time
+}
+
+// LoadPromotionEngine reads the promotions file at path and checks that its
+// zones and shipping options exist in the rate configuration.
+func LoadPromotionEngine(path string, rates *RateEngine) (*PromotionEngine, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open promotions file: %v", err)
+	}
+	var e PromotionEngine
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("failed to parse promotions: %v", err)
+	}
+	if err := e.validate(rates); err != nil {
+		return nil, fmt.Errorf("invalid promotions: %v", err)
+	}
+	return &e, nil
+}
+
+func (e *PromotionEngine) validate(rates *RateEngine) error {
+	ids := make(map[string]bool, len(e.Promotions))
+	for _, p := range e.Promotions {
+		if p.ID == "" || ids[p.ID] {
+			return fmt.Errorf("promotion %q must have a unique id", p.ID)
+		}
+		ids[p.ID] = true
+		p.Code = normalizePromoCode(p.Code)
+
+		switch p.Type {
+		case promoFreeShipping:
+		case promoFlatRate:
+			if p.Price < 0 {
+				return fmt.Errorf("promotion %q has a negative price", p.ID)
+			}
+		case promoPercentOff:
+			if p.Percent <= 0 || p.Percent > money.FromUnits(100) {
+				return fmt.Errorf("promotion %q must take off between 0 and 100 percent", p.ID)
+			}
+		case promoAmountOff:
+			if p.Amount <= 0 {
+				return fmt.Errorf("promotion %q must take off a positive amount", p.ID)
+			}
+		default:
+			return fmt.Errorf("promotion %q has unknown type %q", p.ID, p.Type)
+		}
+
+		for _, z := range p.Zones {
+			if !rates.hasZone(z) {
+				return fmt.Errorf("promotion %q references unknown zone %q", p.ID, z)
+			}
+		}
+		for _, o := range p.ShippingOptions {
+			if _, err := rates.ShippingOption(o); err != nil {
+				return fmt.Errorf("promotion %q references unknown shipping option %q", p.ID, o)
+			}
+		}
+
+		var err error
+		if p.Starts != "" {
+			if p.starts, err = time.Parse(dateLayout, p.Starts); err != nil {
+				return fmt.Errorf("promotion %q has an invalid start date: %v", p.ID, err)
+			}
+		}
+		if p.Ends != "" {
+			if p.ends, err = time.Parse(dateLayout, p.Ends); err != nil {
+				return fmt.Errorf("promotion %q has an invalid end date: %v", p.ID, err)
+			}
+			// The end date is included.
+			p.ends = p.ends.AddDate(0, 0, 1)
+			if !p.starts.IsZero() && !p.ends.After(p.starts) {
+				return fmt.Errorf("promotion %q ends before it starts", p.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// HasCode reports whether a promotion is unlocked by the promo code.
+func (e *PromotionEngine) HasCode(code string) bool {
+	code = normalizePromoCode(code)
+	for _, p := range e.Promotions {
+		if p.Code != "" && p.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply returns the promotion giving the order the lowest shipping cost, and
+// that cost. It returns nil and the undiscounted cost when no promotion
+// applies or lowers the cost. The first of equally good promotions wins.
+func (e *PromotionEngine) Apply(o promoOrder, cost money.Micros) (*Promotion, money.Micros) {
+	var best *Promotion
+	bestCost := cost
+	for _, p := range e.Promotions {
+		if !p.appliesTo(o) {
+			continue
+		}
+		if c := p.discount(cost); c < bestCost {
+			best, bestCost = p, c
+		}
+	}
+	return best, bestCost
+}
+
+func (p *Promotion) appliesTo(o promoOrder) bool {
+	if p.Code != "" && p.Code != normalizePromoCode(o.code) {
+		return false
+	}
+	if o.subtotalUSD < p.MinSubtotal {
+		return false
+	}
+	if !p.starts.IsZero() && o.now.Before(p.starts) {
+		return false
+	}
+	if !p.ends.IsZero() && !o.now.Before(p.ends) {
+		return false
+	}
+	return (len(p.Zones) == 0 || contains(p.Zones, o.zone)) &&
+		(len(p.ShippingOptions) == 0 || contains(p.ShippingOptions, o.option))
+}
+
+// discount returns the cost of shipping under the promotion, which is never
+// negative nor higher than the undiscounted cost.
+func (p *Promotion) discount(cost money.Micros) money.Micros {
+	var c money.Micros
+	switch p.Type {
+	case promoFlatRate:
+		c = p.Price
+	case promoPercentOff:
+		c = cost - cost.MulRatio(int64(p.Percent), int64(money.FromUnits(100)))
+	case promoAmountOff:
+		c = cost - p.Amount
+	}
+	if c < 0 {
+		return 0
+	}
+	if c > cost {
+		return cost
+	}
+	return c
+}
+
+func normalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
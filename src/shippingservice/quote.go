@@ -1,41 +1,140 @@
 package main
 
 import (
-	"fmt"
-	"math"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+	"github.com/abruneau/hipstershop/src/shippingservice/money"
 )
 
-// Quote represents a currency value.
-type Quote struct {
-	Dollars uint32
-	Cents   uint32
+// quoteParams are the parts of a quote request shared by every option.
+type quoteParams struct {
+	currency    string
+	subtotalUSD money.Micros
+	promoCode   string
+}
+
+// quoteParamsFrom validates the currency, subtotal and promo code of a quote
+// request, converting the subtotal to USD.
+func (s *server) quoteParamsFrom(ctx context.Context, currency string, subtotal *pb.Money, promoCode string) (quoteParams, error) {
+	p := quoteParams{
+		currency:  strings.ToUpper(strings.TrimSpace(currency)),
+		promoCode: promoCode,
+	}
+	if p.currency == "" {
+		p.currency = quoteCurrency
+	}
+	if normalizePromoCode(promoCode) != "" && !s.promotions.HasCode(promoCode) {
+		return p, status.Errorf(codes.InvalidArgument, "unknown promo code %q", promoCode)
+	}
+	if subtotal == nil {
+		return p, nil
+	}
+
+	amount := money.FromProto(subtotal)
+	if amount.CurrencyCode == "" || amount.CurrencyCode == quoteCurrency {
+		p.subtotalUSD = amount.Amount
+		return p, nil
+	}
+	usd, err := s.convert(ctx, amount, quoteCurrency)
+	if err != nil {
+		return p, err
+	}
+	p.subtotalUSD = usd.Amount
+	return p, nil
 }
 
-// String representation of the Quote.
-func (q Quote) String() string {
-	return fmt.Sprintf("$%d.%d", q.Dollars, q.Cents)
+// quoteOffer prices a carrier offer for a shipping option with the best
+// promotion for it. It returns the cost in USD and in the requested currency,
+// and the promotion applied, if any.
+func (s *server) quoteOffer(ctx context.Context, zone *Zone, option *ShippingOption, o offer, p quoteParams) (usd, cost money.Money, promo *pb.ShippingPromotion, err error) {
+	full := o.cost
+	applied, discounted := s.promotions.Apply(promoOrder{
+		zone:        zone.Name,
+		option:      option.ID,
+		subtotalUSD: p.subtotalUSD,
+		code:        p.promoCode,
+		now:         s.now(),
+	}, full)
+
+	usd, cost, err = s.quote(ctx, discounted, p.currency)
+	if err != nil || applied == nil {
+		return usd, cost, nil, err
+	}
+
+	// The discount is the difference of the rounded costs, so that it adds
+	// up with the cost shown to the customer.
+	_, undiscounted, err := s.quote(ctx, full, p.currency)
+	if err != nil {
+		return usd, cost, nil, err
+	}
+	discount := money.Money{CurrencyCode: cost.CurrencyCode, Amount: undiscounted.Amount - cost.Amount}
+	return usd, cost, &pb.ShippingPromotion{
+		Id:          applied.ID,
+		Description: applied.Description,
+		Discount:    discount.Proto(),
+	}, nil
 }
 
-// CreateQuoteFromCount takes a number of items and returns a Price struct.
-func CreateQuoteFromCount(count int) Quote {
-	return CreateQuoteFromFloat(quoteByCountFloat(count))
+// quoteDuties estimates the duties and taxes of an order to a country in the
+// requested currency, or returns nil if the country charges none.
+func (s *server) quoteDuties(ctx context.Context, country string, lines []orderLine, shippingUSD money.Micros, currency string) (*pb.DutiesEstimate, error) {
+	rule := s.duties.Rule(country)
+	if rule == nil {
+		return nil, nil
+	}
+	est := rule.Estimate(lines, shippingUSD)
+
+	var amounts [3]money.Money
+	for i, amount := range []money.Micros{est.goods, est.duties, est.taxes} {
+		_, local, err := s.quote(ctx, amount, currency)
+		if err != nil {
+			return nil, err
+		}
+		amounts[i] = local
+	}
+	goods, duties, taxes := amounts[0], amounts[1], amounts[2]
+	// The total adds up the rounded amounts shown to the customer.
+	total := money.Money{CurrencyCode: duties.CurrencyCode, Amount: duties.Amount + taxes.Amount}
+	return &pb.DutiesEstimate{
+		Destination: est.destination,
+		GoodsValue:  goods.Proto(),
+		Duties:      duties.Proto(),
+		Taxes:       taxes.Proto(),
+		Total:       total.Proto(),
+	}, nil
 }
 
-// CreateQuoteFromFloat takes a price represented as a float and creates a Price struct.
-func CreateQuoteFromFloat(value float64) Quote {
-	units, fraction := math.Modf(value)
-	return Quote{
-		uint32(units),
-		uint32(math.Trunc(fraction * 100)),
+// quote rounds a USD amount to the cent and converts it to the requested
+// currency. The converted amount is computed from the unrounded amount, so
+// that it is only rounded once, to the minor unit of its own currency.
+func (s *server) quote(ctx context.Context, amount money.Micros, currency string) (usd, local money.Money, err error) {
+	usd = money.New(quoteCurrency, amount)
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" || currency == quoteCurrency {
+		return usd, usd, nil
+	}
+
+	converted, err := s.convert(ctx, money.Money{CurrencyCode: quoteCurrency, Amount: amount}, currency)
+	if err != nil {
+		return usd, local, err
 	}
+	return usd, money.New(currency, converted.Amount), nil
 }
 
-// quoteByCountFloat takes a number of items and generates a price quote represented as a float.
-func quoteByCountFloat(count int) float64 {
-	if count == 0 {
-		return 0
+// convert converts an amount with the currency converter, reporting
+// conversion failures as gRPC errors.
+func (s *server) convert(ctx context.Context, from money.Money, toCode string) (money.Money, error) {
+	converted, err := s.currencies.Convert(ctx, from, toCode)
+	if err != nil {
+		if code := status.Code(err); code == codes.Unimplemented || code == codes.InvalidArgument {
+			return converted, err
+		}
+		return converted, status.Errorf(codes.Unavailable, "failed to convert %s to %s: %v", from.CurrencyCode, toCode, err)
 	}
-	count64 := float64(count)
-	var p = 1 + (count64 * 0.2)
-	return count64 + math.Pow(3, p)
+	return converted, nil
 }
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"strings"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+	"github.com/abruneau/hipstershop/src/shippingservice/money"
+)
+
+const (
+	// basisItem prices a rate table per item shipped.
+	basisItem = "item"
+	// basisWeight prices a rate table per kilogram shipped.
+	basisWeight = "weight"
+)
+
+// Tier is one step of a graduated rate table. Rate applies to every unit
+// (item or kilogram) above the previous tier and up to UpTo. An UpTo of zero
+// means the tier is unbounded and must come last.
+type Tier struct {
+	UpTo float64      `json:"up_to"`
+	Rate money.Micros `json:"rate"`
+}
+
+// RateTable prices a shipment in USD as a base fee plus graduated tiers.
+type RateTable struct {
+	Basis string       `json:"basis"`
+	Base  money.Micros `json:"base"`
+	Tiers []Tier       `json:"tiers"`
+}
+
+// Zone groups destinations that carriers price alike. A zone matches an
+// address when its country is listed and, if ZipPrefixes is set, the zip code
+// starts with one of the prefixes.
+type Zone struct {
+	Name        string   `json:"name"`
+	Countries   []string `json:"countries"`
+	ZipPrefixes []string `json:"zip_prefixes"`
+}
+
+// RateEngine holds the shipping zones, the rate tables and the simulated
+// carriers charging them. Weight-based tables charge the billable weight: the
+// greater of the actual weight and the dimensional weight, which is the
+// package volume in cubic centimetres divided by DimensionalDivisor.
+// CarrierTimeout bounds the calls to carriers without their own Timeout, and
+// Parcels decide how orders are split into parcels.
+type RateEngine struct {
+	DefaultItemWeightKg float64               `json:"default_item_weight_kg"`
+	DimensionalDivisor  float64               `json:"dimensional_divisor"`
+	CarrierTimeout      Duration              `json:"carrier_timeout"`
+	Parcels             ParcelLimits          `json:"parcels"`
+	RateTables          map[string]*RateTable `json:"rate_tables"`
+	Zones               []*Zone               `json:"zones"`
+	Carriers            []*SimulatedCarrier   `json:"carriers"`
+	ShippingOptions     []*ShippingOption     `json:"shipping_options"`
+}
+
+// LoadRateEngine reads and validates the rate configuration file at path.
+func LoadRateEngine(path string) (*RateEngine, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open rates config file: %v", err)
+	}
+	var e RateEngine
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("failed to parse rates config: %v", err)
+	}
+	if err := e.validate(); err != nil {
+		return nil, fmt.Errorf("invalid rates config: %v", err)
+	}
+	return &e, nil
+}
+
+func (e *RateEngine) validate() error {
+	if e.DimensionalDivisor <= 0 {
+		return errors.New("dimensional_divisor must be positive")
+	}
+	if e.CarrierTimeout <= 0 {
+		return errors.New("carrier_timeout must be positive")
+	}
+	if err := e.Parcels.validate(); err != nil {
+		return err
+	}
+	for name, t := range e.RateTables {
+		if t.Basis != basisItem && t.Basis != basisWeight {
+			return fmt.Errorf("rate table %q has unknown basis %q", name, t.Basis)
+		}
+		for i, tier := range t.Tiers {
+			if tier.UpTo == 0 && i != len(t.Tiers)-1 {
+				return fmt.Errorf("rate table %q has an unbounded tier before the last one", name)
+			}
+			if i > 0 && tier.UpTo != 0 && tier.UpTo <= t.Tiers[i-1].UpTo {
+				return fmt.Errorf("rate table %q tiers are not increasing", name)
+			}
+		}
+	}
+	if len(e.Carriers) == 0 {
+		return errors.New("at least one carrier is required")
+	}
+	carriers := make(map[string]bool, len(e.Carriers))
+	for _, c := range e.Carriers {
+		if err := c.validate(e); err != nil {
+			return err
+		}
+		if carriers[c.CarrierID] {
+			return fmt.Errorf("duplicate carrier %q", c.CarrierID)
+		}
+		carriers[c.CarrierID] = true
+	}
+	seen := make(map[string]bool, len(e.ShippingOptions))
+	for _, o := range e.ShippingOptions {
+		if err := o.validate(); err != nil {
+			return err
+		}
+		if seen[o.ID] {
+			return fmt.Errorf("duplicate shipping option %q", o.ID)
+		}
+		seen[o.ID] = true
+	}
+	if !seen[defaultShippingOption] {
+		return fmt.Errorf("the %q shipping option is required", defaultShippingOption)
+	}
+	return nil
+}
+
+// Zone finds the zone serving the address. When several zones match the
+// country, the one with the longest matching zip prefix wins.
+func (e *RateEngine) Zone(addr *pb.Address) (*Zone, error) {
+	if addr == nil {
+		return nil, errors.New("address is required to quote shipping")
+	}
+	zip := postalCode(addr)
+	var (
+		best      *Zone
+		bestScore = -1
+	)
+	for _, z := range e.Zones {
+		if !z.servesCountry(addr.GetCountry()) {
+			continue
+		}
+		if score := z.zipScore(zip); score > bestScore {
+			best, bestScore = z, score
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("no shipping zone serves country %q with zip code %q", addr.GetCountry(), zip)
+	}
+	return best, nil
+}
+
+func (e *RateEngine) hasZone(name string) bool {
+	for _, z := range e.Zones {
+		if z.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Price quotes the cost of a shipment, in USD. The result is exact to the
+// micro and is rounded to the cent when quoted.
+func (t *RateTable) Price(s shipment) money.Micros {
+	if s.items == 0 {
+		return 0
+	}
+	if t.Basis == basisWeight {
+		return t.price(milliUnits(s.billableWeightKg()))
+	}
+	return t.price(milliUnits(float64(s.items)))
+}
+
+// price charges a number of units, counted in thousandths so that weights
+// are priced by the gram.
+func (t *RateTable) price(units int64) money.Micros {
+	total := t.Base
+	var prev int64
+	for _, tier := range t.Tiers {
+		if units <= prev {
+			break
+		}
+		upper := units
+		if upTo := milliUnits(tier.UpTo); upTo != 0 && upTo < units {
+			upper = upTo
+		}
+		total += tier.Rate.MulRatio(upper-prev, 1000)
+		prev = upper
+	}
+	return total
+}
+
+// milliUnits counts a quantity, such as a weight in kilograms, in thousandths.
+func milliUnits(v float64) int64 {
+	return int64(math.Round(v * 1000))
+}
+
+func (z *Zone) servesCountry(country string) bool {
+	country = strings.TrimSpace(country)
+	for _, c := range z.Countries {
+		if strings.EqualFold(c, country) {
+			return true
+		}
+	}
+	return false
+}
+
+// zipScore returns the length of the longest matching prefix, 0 for a zone
+// without prefixes and -1 when the zone's prefixes do not match.
+func (z *Zone) zipScore(zip string) int {
+	if len(z.ZipPrefixes) == 0 {
+		return 0
+	}
+	score := -1
+	for _, p := range z.ZipPrefixes {
+		if strings.HasPrefix(zip, p) && len(p) > score {
+			score = len(p)
+		}
+	}
+	return score
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+)
+
+// RestrictionRule forbids shipping products of some categories to some
+// destinations. A destination is restricted when it meets every condition
+// that is set: its country is one of Countries and none of ExceptCountries,
+// its state is one of States, and its postal code starts with one of
+// ZipPrefixes. Countries and states are matched by code or name.
+type RestrictionRule struct {
+	ID              string   `json:"id"`
+	Reason          string   `json:"reason"`
+	Categories      []string `json:"categories"`
+	Countries       []string `json:"countries"`
+	ExceptCountries []string `json:"except_countries"`
+	States          []string `json:"states"`
+	ZipPrefixes     []string `json:"zip_prefixes"`
+}
+
+// RestrictionEngine checks the items of an order against the restriction rules.
+type RestrictionEngine struct {
+	Rules []*RestrictionRule `json:"rules"`
+}
+
+// LoadRestrictionEngine reads the restrictions file at path.
+func LoadRestrictionEngine(path string) (*RestrictionEngine, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open restrictions file: %v", err)
+	}
+	var e RestrictionEngine
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("failed to parse restrictions: %v", err)
+	}
+	if err := e.validate(); err != nil {
+		return nil, fmt.Errorf("invalid restrictions: %v", err)
+	}
+	return &e, nil
+}
+
+func (e *RestrictionEngine) validate() error {
+	ids := make(map[string]bool, len(e.Rules))
+	for _, r := range e.Rules {
+		if r.ID == "" || ids[r.ID] {
+			return fmt.Errorf("restriction %q must have a unique id", r.ID)
+		}
+		ids[r.ID] = true
+		if r.Reason == "" {
+			return fmt.Errorf("restriction %q has no reason", r.ID)
+		}
+		if len(r.Categories) == 0 {
+			return fmt.Errorf("restriction %q has no categories", r.ID)
+		}
+	}
+	return nil
+}
+
+// Check returns a violation for every item that cannot be shipped to the
+// address, with the reason of the first rule restricting it. The address
+// should be normalized so that its country and state are codes when known.
+func (e *RestrictionEngine) Check(addr *pb.Address, lines []orderLine) []*errdetails.PreconditionFailure_Violation {
+	var violations []*errdetails.PreconditionFailure_Violation
+	for _, line := range lines {
+		for _, r := range e.Rules {
+			if r.restricts(addr, line.product.GetCategories()) {
+				violations = append(violations, &errdetails.PreconditionFailure_Violation{
+					Type:        r.ID,
+					Subject:     line.item.GetProductId(),
+					Description: r.Reason,
+				})
+				break
+			}
+		}
+	}
+	return violations
+}
+
+// restricts reports whether the rule forbids shipping a product with the
+// given categories to the address.
+func (r *RestrictionRule) restricts(addr *pb.Address, categories []string) bool {
+	matched := false
+	for _, c := range categories {
+		if contains(r.Categories, c) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return false
+	}
+
+	country, state := addr.GetCountry(), addr.GetState()
+	if len(r.Countries) > 0 && !containsFold(r.Countries, country) {
+		return false
+	}
+	if containsFold(r.ExceptCountries, country) {
+		return false
+	}
+	if len(r.States) > 0 && !containsFold(r.States, state) {
+		return false
+	}
+	if len(r.ZipPrefixes) > 0 {
+		zip := postalCode(addr)
+		for _, p := range r.ZipPrefixes {
+			if strings.HasPrefix(zip, p) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// containsFold reports whether list holds s, ignoring case and spaces around s.
+func containsFold(list []string, s string) bool {
+	s = strings.TrimSpace(s)
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// restrictionError reports restricted items as a FailedPrecondition error
+// detailing the reason for every item.
+func restrictionError(addr *pb.Address, violations []*errdetails.PreconditionFailure_Violation) error {
+	st := status.Newf(codes.FailedPrecondition, "%d items cannot be shipped to %s", len(violations), addr.GetCountry())
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{Violations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// checkRestrictions returns a FailedPrecondition error if some items of the
+// order cannot be shipped to the address.
+func (s *server) checkRestrictions(addr *pb.Address, lines []orderLine) error {
+	normalized, _ := s.addresses.Validate(addr)
+	if violations := s.restrictions.Check(normalized, lines); len(violations) > 0 {
+		return restrictionError(normalized, violations)
+	}
+	return nil
+}
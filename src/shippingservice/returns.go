@@ -0,0 +1,298 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+	"github.com/abruneau/hipstershop/src/shippingservice/store"
+	"github.com/abruneau/hipstershop/src/shippingservice/tracking"
+)
+
+// refunder refunds the items of returns once they are received.
+type refunder interface {
+	RefundReturn(ctx context.Context, r *store.Return) (refundID string, err error)
+}
+
+// checkoutRefunder refunds returns with the checkout service.
+type checkoutRefunder struct {
+	client pb.CheckoutServiceClient
+}
+
+func newCheckoutRefunder(conn *grpc.ClientConn) *checkoutRefunder {
+	return &checkoutRefunder{client: pb.NewCheckoutServiceClient(conn)}
+}
+
+func (c *checkoutRefunder) RefundReturn(ctx context.Context, r *store.Return) (string, error) {
+	res, err := c.client.RefundReturn(ctx, &pb.RefundReturnRequest{
+		ReturnId: r.TrackingID,
+		OrderId:  r.OrderID,
+		Items:    r.Items,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.GetRefundId(), nil
+}
+
+// CreateReturn issues a return label for delivered items of a shipment or of
+// an order. Items can be returned once, up to the quantity delivered.
+func (s *server) CreateReturn(ctx context.Context, in *pb.CreateReturnRequest) (*pb.Return, error) {
+	log.Info("[CreateReturn] received request")
+	defer log.Info("[CreateReturn] completed request")
+
+	if len(in.Items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one item must be returned")
+	}
+	requested := make(map[string]int32)
+	for _, item := range in.Items {
+		if item.GetQuantity() <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid quantity %d of product %q", item.GetQuantity(), item.GetProductId())
+		}
+		requested[item.GetProductId()] += item.GetQuantity()
+	}
+
+	// 1. Find the shipments the items were delivered in.
+	shipments, err := s.findShipments(in.TrackingId, in.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	delivered, err := s.deliveredShipments(ctx, shipments)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Check that the items were shipped, delivered and not returned yet.
+	ids := make([]string, len(shipments))
+	for i, sh := range shipments {
+		ids[i] = sh.TrackingID
+	}
+	previous, err := s.shipments.GetReturnsByShipments(ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get returns: %v", err)
+	}
+	returned := make(map[string]int32)
+	for _, r := range previous {
+		for _, item := range r.Items {
+			returned[item.GetProductId()] += item.GetQuantity()
+		}
+	}
+	shipped, arrived := make(map[string]int32), make(map[string]int32)
+	var from []*store.Shipment
+	for _, sh := range shipments {
+		holds := false
+		for _, item := range sh.Items {
+			shipped[item.GetProductId()] += item.GetQuantity()
+			if delivered[sh.TrackingID] {
+				arrived[item.GetProductId()] += item.GetQuantity()
+			}
+			if requested[item.GetProductId()] > 0 {
+				holds = true
+			}
+		}
+		if holds && delivered[sh.TrackingID] {
+			from = append(from, sh)
+		}
+	}
+	for id, qty := range requested {
+		if left := shipped[id] - returned[id]; qty > left {
+			return nil, status.Errorf(codes.InvalidArgument, "only %d of product %q can be returned", left, id)
+		}
+		if left := arrived[id] - returned[id]; qty > left {
+			return nil, status.Errorf(codes.FailedPrecondition, "product %q is not delivered yet", id)
+		}
+	}
+
+	// 3. Buy a return label from the carrier that delivered the items.
+	zone, err := s.rates.Zone(from[0].Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	lines, err := s.lookupProducts(ctx, in.Items)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.returnCarrier(ctx, from[0].CarrierID, zone, lines)
+	if err != nil {
+		return nil, err
+	}
+	label, err := s.createLabel(ctx, c, zone, measure(s.packOrder(lines)))
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Record the return so that its status can be tracked.
+	r := &store.Return{
+		TrackingID:            s.trackingIDs.New(),
+		OrderID:               from[0].OrderID,
+		Items:                 in.Items,
+		Reason:                in.Reason,
+		CarrierID:             label.CarrierID,
+		CarrierTrackingNumber: label.TrackingNumber,
+		CreatedAt:             label.CreatedAt,
+	}
+	for _, sh := range from {
+		r.ShipmentTrackingIDs = append(r.ShipmentTrackingIDs, sh.TrackingID)
+	}
+	if err := s.shipments.PutReturn(r); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save return: %v", err)
+	}
+	log.Infof("[CreateReturn] created return %s of %d products", r.TrackingID, len(requested))
+	return s.returnProto(r), nil
+}
+
+// GetReturn returns a return and its status.
+func (s *server) GetReturn(ctx context.Context, in *pb.GetReturnRequest) (*pb.Return, error) {
+	log.Info("[GetReturn] received request")
+	defer log.Info("[GetReturn] completed request")
+
+	if err := tracking.ValidateTrackingID(in.TrackingId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: %q", err, in.TrackingId)
+	}
+	r, err := s.shipments.GetReturn(in.TrackingId)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "no return with tracking ID %s", in.TrackingId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get return: %v", err)
+	}
+	return s.returnProto(r), nil
+}
+
+// findShipments finds the shipment with the tracking ID, or else the
+// shipments of the order.
+func (s *server) findShipments(trackingID, orderID string) ([]*store.Shipment, error) {
+	if trackingID != "" {
+		if err := tracking.ValidateTrackingID(trackingID); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v: %q", err, trackingID)
+		}
+		sh, err := s.shipments.GetShipment(trackingID)
+		if err == store.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "no shipment with tracking ID %s", trackingID)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shipment: %v", err)
+		}
+		if orderID != "" && sh.OrderID != orderID {
+			return nil, status.Errorf(codes.InvalidArgument, "shipment %s is not part of order %s", trackingID, orderID)
+		}
+		return []*store.Shipment{sh}, nil
+	}
+	if orderID == "" {
+		return nil, status.Error(codes.InvalidArgument, "a tracking ID or an order ID is required")
+	}
+	shipments, err := s.shipments.GetShipmentsByOrder(orderID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shipments: %v", err)
+	}
+	if len(shipments) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no shipment for order %s", orderID)
+	}
+	return shipments, nil
+}
+
+// deliveredShipments tracks the shipments and returns the tracking IDs of
+// those delivered.
+func (s *server) deliveredShipments(ctx context.Context, shipments []*store.Shipment) (map[string]bool, error) {
+	delivered := make(map[string]bool, len(shipments))
+	for _, sh := range shipments {
+		events, err := s.shipmentEvents(ctx, sh)
+		if err != nil {
+			return nil, err
+		}
+		delivered[sh.TrackingID] = events[len(events)-1].Status == pb.ShipmentStatus_DELIVERED
+	}
+	return delivered, nil
+}
+
+// returnCarrier returns the enabled carrier with the ID, or the cheapest
+// carrier serving the zone when it is not enabled.
+func (s *server) returnCarrier(ctx context.Context, id string, zone *Zone, lines []orderLine) (enabledCarrier, error) {
+	if c, ok := s.carrier(id); ok {
+		return c, nil
+	}
+	quotes, err := s.collectRates(ctx, zone, s.packOrder(lines))
+	if err != nil {
+		return enabledCarrier{}, err
+	}
+	option, err := s.rates.ShippingOption(defaultShippingOption)
+	if err != nil {
+		return enabledCarrier{}, status.Error(codes.Internal, err.Error())
+	}
+	return s.bestOffer(quotes, option, pb.RateShopping_CHEAPEST, s.now()).carrier, nil
+}
+
+// measure adds up parcels, which are sent back in a single package.
+func measure(parcels []*parcel) shipment {
+	var total shipment
+	for _, p := range parcels {
+		total.items += p.shipment.items
+		total.weightKg += p.weightKg
+		total.dimensionalKg += p.dimensionalKg
+	}
+	return total
+}
+
+func (s *server) returnProto(r *store.Return) *pb.Return {
+	res := &pb.Return{
+		TrackingId:            r.TrackingID,
+		OrderId:               r.OrderID,
+		ShipmentTrackingIds:   r.ShipmentTrackingIDs,
+		Items:                 r.Items,
+		Reason:                r.Reason,
+		Events:                s.lifecycle.returnEventsAt(r.CreatedAt, r.RefundedAt, s.now()),
+		CarrierId:             r.CarrierID,
+		CarrierTrackingNumber: r.CarrierTrackingNumber,
+		RefundId:              r.RefundID,
+	}
+	if c, ok := s.carrier(r.CarrierID); ok {
+		res.CarrierName = c.Name()
+	}
+	res.Status = res.Events[len(res.Events)-1].Status
+	return res
+}
+
+// refundReturns refunds the returns received since the last call. Failed
+// refunds are retried on the next call.
+func (s *server) refundReturns(ctx context.Context) error {
+	pending, err := s.shipments.GetUnrefundedReturns()
+	if err != nil {
+		return fmt.Errorf("failed to get returns to refund: %v", err)
+	}
+	for _, r := range pending {
+		if !s.lifecycle.received(r.CreatedAt, s.now()) {
+			continue
+		}
+		refundID, err := s.refunds.RefundReturn(ctx, r)
+		if err != nil {
+			log.Warnf("failed to refund return %s of order %s: %v", r.TrackingID, r.OrderID, err)
+			continue
+		}
+		if err := s.shipments.SetReturnRefund(r.TrackingID, refundID, s.now()); err != nil {
+			return fmt.Errorf("failed to save refund of return %s: %v", r.TrackingID, err)
+		}
+		log.Infof("refunded return %s of order %s: %s", r.TrackingID, r.OrderID, refundID)
+	}
+	return nil
+}
+
+// watchReturns refunds received returns every interval.
+func (s *server) watchReturns(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if err := s.refundReturns(ctx); err != nil {
+				log.Warn(err)
+			}
+		}
+	}
+}
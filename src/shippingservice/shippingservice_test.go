@@ -1,24 +1,164 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+	"github.com/abruneau/hipstershop/src/shippingservice/money"
+	"github.com/abruneau/hipstershop/src/shippingservice/store"
+	"github.com/abruneau/hipstershop/src/shippingservice/tracking"
 )
 
+// testNow is the fixed time seen by test servers, the Friday before Thanksgiving 2026.
+var testNow = time.Date(2026, time.November, 20, 10, 0, 0, 0, time.UTC)
+
+// fakeCatalog resolves products from a map, without a product catalog service.
+type fakeCatalog map[string]*pb.Product
+
+func (c fakeCatalog) GetProduct(ctx context.Context, id string) (*pb.Product, error) {
+	p, ok := c[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no product with ID %s", id)
+	}
+	return &pb.Product{Id: id, PriceUsd: p.PriceUsd, Categories: p.Categories, Dimensions: p.Dimensions}, nil
+}
+
+// testCatalog holds the products used by the tests.
+var testCatalog = fakeCatalog{
+	"23":         {PriceUsd: usd("19.99"), Dimensions: &pb.PackageDimensions{WeightKg: 1, LengthCm: 20, WidthCm: 20, HeightCm: 10}},
+	"46":         {Dimensions: &pb.PackageDimensions{WeightKg: 0.5, LengthCm: 10, WidthCm: 10, HeightCm: 10}},
+	"air-plant":  {PriceUsd: usd("12.30"), Categories: []string{"gardening"}, Dimensions: &pb.PackageDimensions{WeightKg: 0.2, LengthCm: 10, WidthCm: 10, HeightCm: 15}},
+	"city-bike":  {PriceUsd: usd("789.50"), Categories: []string{"cycling"}, Dimensions: &pb.PackageDimensions{WeightKg: 14, LengthCm: 160, WidthCm: 25, HeightCm: 85}},
+	"typewriter": {PriceUsd: usd("67.99"), Categories: []string{"vintage"}, Dimensions: &pb.PackageDimensions{WeightKg: 5.5, LengthCm: 40, WidthCm: 40, HeightCm: 20}},
+	"mystery":    {},
+}
+
+// usd returns an amount in US dollars.
+func usd(amount string) *pb.Money { return money.New("USD", money.MustParse(amount)).Proto() }
+
+// fakeConverter converts USD amounts at fixed exchange rates.
+type fakeConverter map[string]money.Micros
+
+func (c fakeConverter) Convert(ctx context.Context, from money.Money, toCode string) (money.Money, error) {
+	if toCode == "USD" {
+		rate, ok := c[from.CurrencyCode]
+		if !ok {
+			return money.Money{}, status.Errorf(codes.InvalidArgument, "unsupported currency %s", from.CurrencyCode)
+		}
+		return money.Money{CurrencyCode: toCode, Amount: from.Amount.MulRatio(int64(money.FromUnits(1)), int64(rate))}, nil
+	}
+	rate, ok := c[toCode]
+	if !ok {
+		return money.Money{}, status.Errorf(codes.InvalidArgument, "unsupported currency %s", toCode)
+	}
+	return money.Money{CurrencyCode: toCode, Amount: from.Amount.Mul(rate)}, nil
+}
+
+// testRates holds the exchange rates used by the tests.
+var testRates = fakeConverter{
+	"EUR": money.MustParse("0.9"),
+	"JPY": money.MustParse("150"),
+}
+
+// newTestServer creates a server using the configuration shipped with the service.
+func newTestServer(t *testing.T) *server {
+	rates, err := LoadRateEngine(defaultRatesConfig)
+	if err != nil {
+		t.Fatalf("failed to load rates: %v", err)
+	}
+	calendar, err := LoadBusinessCalendar(defaultHolidays)
+	if err != nil {
+		t.Fatalf("failed to load holidays: %v", err)
+	}
+	promotions, err := LoadPromotionEngine(defaultPromotions, rates)
+	if err != nil {
+		t.Fatalf("failed to load promotions: %v", err)
+	}
+	duties, err := LoadDutyEngine(defaultDuties)
+	if err != nil {
+		t.Fatalf("failed to load duties: %v", err)
+	}
+	restrictions, err := LoadRestrictionEngine(defaultRestrictions)
+	if err != nil {
+		t.Fatalf("failed to load restrictions: %v", err)
+	}
+	addresses, err := LoadAddressValidator(defaultAddressRules)
+	if err != nil {
+		t.Fatalf("failed to load address rules: %v", err)
+	}
+	pickups, err := LoadPickupDirectory(defaultPickupPoints, addresses)
+	if err != nil {
+		t.Fatalf("failed to load pickup points: %v", err)
+	}
+	s := &server{
+		rates:         rates,
+		promotions:    promotions,
+		duties:        duties,
+		restrictions:  restrictions,
+		calendar:      calendar,
+		addresses:     addresses,
+		pickups:       pickups,
+		products:      testCatalog,
+		currencies:    testRates,
+		shipments:     store.NewMemoryStore(),
+		trackingIDs:   tracking.NewIDGenerator(),
+		lifecycle:     lifecycle{stage: time.Hour},
+		watchInterval: time.Millisecond,
+		now:           func() time.Time { return testNow },
+	}
+	// Carriers follow the clock of the server, which tests may change.
+	s.carriers, err = rates.startCarriers(nil, s.lifecycle, func() time.Time { return s.now() })
+	if err != nil {
+		t.Fatalf("failed to start carriers: %v", err)
+	}
+	// Carriers answer at once and never fail, unless a test says otherwise.
+	for _, c := range rates.Carriers {
+		c.Latency, c.Jitter = 0, 0
+		c.random = func() float64 { return 0.5 }
+	}
+	return s
+}
+
+// testCarrier looks up a simulated carrier of a test server.
+func testCarrier(t *testing.T, s *server, id string) *SimulatedCarrier {
+	for _, c := range s.rates.Carriers {
+		if c.CarrierID == id {
+			return c
+		}
+	}
+	t.Fatalf("no carrier %q", id)
+	return nil
+}
+
 // TestGetQuote is a basic check on the GetQuote RPC service.
 func TestGetQuote(t *testing.T) {
-	s := server{}
+	s := newTestServer(t)
 
 	// A basic test case to test logic and protobuf interactions.
 	req := &pb.GetQuoteRequest{
 		Address: &pb.Address{
-			StreetAddress: "Muffin Man",
-			City:          "London",
-			State:         "",
-			Country:       "England",
+			StreetAddress: "1600 Amphitheatre Parkway",
+			City:          "Mountain View",
+			State:         "CA",
+			Country:       "United States",
+			ZipCode:       94043,
 		},
 		Items: []*pb.CartItem{
 			{
@@ -36,14 +176,529 @@ func TestGetQuote(t *testing.T) {
 	if err != nil {
 		t.Errorf("TestGetQuote (%v) failed", err)
 	}
-	if res.CostUsd.GetUnits() != 11 || res.CostUsd.GetNanos() != 220000000 {
-		t.Errorf("TestGetQuote: Quote value '%d.%d' does not match expected '%s'", res.CostUsd.GetUnits(), res.CostUsd.GetNanos(), "11.220000000")
+	if res.CostUsd.GetUnits() != 7 || res.CostUsd.GetNanos() != 490000000 {
+		t.Errorf("TestGetQuote: Quote value '%d.%d' does not match expected '%s'", res.CostUsd.GetUnits(), res.CostUsd.GetNanos(), "7.490000000")
+	}
+}
+
+// TestGetQuoteRounding checks that quotes are rounded once, half away from
+// zero, to the minor unit of the requested currency.
+func TestGetQuoteRounding(t *testing.T) {
+	s := newTestServer(t)
+
+	// 2.5kg within us-west: 4.99 + 2.5 * 1.00 = 7.49, and 16.235 by express.
+	tests := []struct {
+		option, currency string
+		usd, want        string
+	}{
+		{"standard", "", "$7.49", "$7.49"},
+		{"express", "usd", "$16.24", "$16.24"},
+		// 16.235 * 0.9 = 14.6115, where the rounded 16.24 would give 14.62.
+		{"express", "EUR", "$16.24", "14.61 EUR"},
+		// 16.235 * 150 = 2435.25, and yen have no minor unit.
+		{"express", "JPY", "$16.24", "2435 JPY"},
+		// 7.49 * 2.5 + 15 = 33.725
+		{"overnight", "EUR", "$33.73", "30.35 EUR"},
+	}
+	for _, tt := range tests {
+		res, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{
+			Address:          &pb.Address{Country: "United States", ZipCode: 94043},
+			Items:            []*pb.CartItem{{ProductId: "23", Quantity: 1}, {ProductId: "46", Quantity: 3}},
+			ShippingOptionId: tt.option,
+			CurrencyCode:     tt.currency,
+		})
+		if err != nil {
+			t.Fatalf("TestGetQuoteRounding(%s, %s): unexpected error %v", tt.option, tt.currency, err)
+		}
+		if got := money.FromProto(res.CostUsd).String(); got != tt.usd {
+			t.Errorf("TestGetQuoteRounding(%s, %s): got %s in USD, expected %s", tt.option, tt.currency, got, tt.usd)
+		}
+		if got := money.FromProto(res.Cost).String(); got != tt.want {
+			t.Errorf("TestGetQuoteRounding(%s, %s): got %s, expected %s", tt.option, tt.currency, got, tt.want)
+		}
+	}
+
+	_, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{
+		Address:      &pb.Address{Country: "United States", ZipCode: 94043},
+		Items:        []*pb.CartItem{{ProductId: "23", Quantity: 1}},
+		CurrencyCode: "XYZ",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("TestGetQuoteRounding: got error %v for an unknown currency, expected code %s", err, codes.InvalidArgument)
+	}
+}
+
+// TestGetQuotePromotions checks that the best promotion for the order is
+// applied and explained.
+func TestGetQuotePromotions(t *testing.T) {
+	s := newTestServer(t)
+
+	usWest := &pb.Address{Country: "United States", ZipCode: 94043}
+	// 2.5kg within us-west: 7.49 by standard, 16.235 by express.
+	items := []*pb.CartItem{{ProductId: "23", Quantity: 1}, {ProductId: "46", Quantity: 3}}
+	tests := []struct {
+		name     string
+		address  *pb.Address
+		items    []*pb.CartItem
+		option   string
+		currency string
+		subtotal *pb.Money
+		code     string
+		want     string
+		promo    string
+		discount string
+	}{
+		{"below the free-shipping threshold", usWest, items, "", "", usd("74.99"), "", "$7.49", "", ""},
+		{"free standard shipping", usWest, items, "", "", usd("75"), "", "$0.00", "free-standard-us", "$7.49"},
+		{"subtotal in another currency", usWest, items, "", "EUR", money.New("EUR", money.MustParse("67.50")).Proto(), "", "0.00 EUR", "free-standard-us", "6.74 EUR"},
+		{"free shipping is standard only", usWest, items, "express", "", usd("80"), "", "$16.24", "", ""},
+		{"amount off", usWest, items, "express", "", usd("80"), "ship5", "$11.24", "five-off", "$5.00"},
+		// 7.49 / 2 = 3.745
+		{"percent off", usWest, items, "", "", nil, " shiphalf ", "$3.75", "holiday-half-off", "$3.74"},
+		{"best promotion wins", usWest, items, "", "", usd("100"), "SHIP5", "$0.00", "free-standard-us", "$7.49"},
+		// Tortoise Freight is the cheapest to Canada at 119.99.
+		{"flat rate", &pb.Address{Country: "Canada"}, []*pb.CartItem{{ProductId: "city-bike", Quantity: 1}}, "", "", nil, "", "$12.99", "flat-north-america", "$107.00"},
+	}
+	for _, tt := range tests {
+		res, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{
+			Address:          tt.address,
+			Items:            tt.items,
+			ShippingOptionId: tt.option,
+			CurrencyCode:     tt.currency,
+			Subtotal:         tt.subtotal,
+			PromoCode:        tt.code,
+		})
+		if err != nil {
+			t.Fatalf("TestGetQuotePromotions(%s): unexpected error %v", tt.name, err)
+		}
+		if got := money.FromProto(res.Cost).String(); got != tt.want {
+			t.Errorf("TestGetQuotePromotions(%s): got %s, expected %s", tt.name, got, tt.want)
+		}
+		if got := res.Promotion.GetId(); got != tt.promo {
+			t.Errorf("TestGetQuotePromotions(%s): got promotion %q, expected %q", tt.name, got, tt.promo)
+		}
+		if tt.promo != "" {
+			if got := money.FromProto(res.Promotion.Discount).String(); got != tt.discount {
+				t.Errorf("TestGetQuotePromotions(%s): got discount %s, expected %s", tt.name, got, tt.discount)
+			}
+		}
+	}
+
+	_, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{Address: usWest, Items: items, PromoCode: "FREESTUFF"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("TestGetQuotePromotions: got error %v for an unknown promo code, expected code %s", err, codes.InvalidArgument)
+	}
+
+	// Promotions are not applied outside of their validity window.
+	s.now = func() time.Time { return time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC) }
+	res, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{Address: usWest, Items: items, PromoCode: "SHIPHALF"})
+	if err != nil {
+		t.Fatalf("TestGetQuotePromotions: unexpected error %v", err)
+	}
+	if res.Promotion != nil {
+		t.Errorf("TestGetQuotePromotions: got promotion %v after it ended", res.Promotion)
+	}
+}
+
+// TestPriceByWeight checks that shipments are priced by their billable weight.
+func TestPriceByWeight(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name  string
+		table string
+		items []*pb.CartItem
+		want  string
+	}{
+		// 2kg actual, 3kg dimensional: 4.99 + 3 * 1.00
+		{"ten air plants", "us-local", []*pb.CartItem{{ProductId: "air-plant", Quantity: 10}}, "7.99"},
+		// 14kg actual, 68kg dimensional: 4.99 + 5 * 1.00 + 63 * 0.50
+		{"city bike", "us-local", []*pb.CartItem{{ProductId: "city-bike", Quantity: 1}}, "41.49"},
+		// 5.5kg actual, 6.4kg dimensional: 14.99 + 2 * 5.00 + 4.4 * 4.00
+		{"typewriter abroad", "international", []*pb.CartItem{{ProductId: "typewriter", Quantity: 1}}, "42.59"},
+		// 14kg actual, 68kg dimensional: 9.99 + 2 * 3.00 + 66 * 2.00
+		{"city bike to Canada", "north-america", []*pb.CartItem{{ProductId: "city-bike", Quantity: 1}}, "147.99"},
+		// No dimensions, 2 * 0.5kg default weight: 6.99 + 1 * 1.50
+		{"products without dimensions", "us-national", []*pb.CartItem{{ProductId: "mystery", Quantity: 2}}, "8.49"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := s.lookupProducts(context.Background(), tt.items)
+			if err != nil {
+				t.Fatalf("unexpected lookup error: %v", err)
+			}
+			parcels := s.packOrder(lines)
+			if len(parcels) != 1 {
+				t.Fatalf("got %d parcels, expected 1", len(parcels))
+			}
+			if got := s.rates.RateTables[tt.table].Price(parcels[0].shipment); got != money.MustParse(tt.want) {
+				t.Errorf("got price %s, expected %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPackOrder checks that orders are split into parcels by weight, volume
+// and category, and that every parcel is charged.
+func TestPackOrder(t *testing.T) {
+	s := newTestServer(t)
+
+	lines, err := s.lookupProducts(context.Background(), []*pb.CartItem{
+		{ProductId: "air-plant", Quantity: 2},
+		{ProductId: "23", Quantity: 1},
+		{ProductId: "typewriter", Quantity: 4},
+		{ProductId: "city-bike", Quantity: 2},
+	})
+	if err != nil {
+		t.Fatalf("TestPackOrder: unexpected error %v", err)
+	}
+	parcels := s.packOrder(lines)
+	// Bikes are too big to share a parcel, three typewriters and the 23 make
+	// exactly 20kg and 100000cm³, and plants travel on their own.
+	want := [][]*pb.CartItem{
+		{{ProductId: "city-bike", Quantity: 1}},
+		{{ProductId: "city-bike", Quantity: 1}},
+		{{ProductId: "typewriter", Quantity: 3}, {ProductId: "23", Quantity: 1}},
+		{{ProductId: "typewriter", Quantity: 1}},
+		{{ProductId: "air-plant", Quantity: 2}},
+	}
+	if len(parcels) != len(want) {
+		t.Fatalf("TestPackOrder: got %d parcels, expected %d", len(parcels), len(want))
+	}
+	for i, w := range want {
+		if !reflect.DeepEqual(parcels[i].items, w) {
+			t.Errorf("TestPackOrder: parcel %d holds %v, expected %v", i, parcels[i].items, w)
+		}
+	}
+
+	// Two typewriters (12.8kg dimensional) for 4.99 + 5 * 1.00 + 7.8 * 0.50,
+	// and a plant (0.3kg dimensional) for 4.99 + 0.3 * 1.00.
+	res, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{
+		Address: &pb.Address{Country: "United States", ZipCode: 94043},
+		Items:   []*pb.CartItem{{ProductId: "typewriter", Quantity: 2}, {ProductId: "air-plant", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("TestPackOrder: unexpected error %v", err)
+	}
+	if got := money.FromProto(res.CostUsd).String(); got != "$19.18" {
+		t.Errorf("TestPackOrder: got quote %s, expected %s", got, "$19.18")
+	}
+}
+
+// TestGetQuoteDuties checks the duties and taxes estimated for cross-border orders.
+func TestGetQuoteDuties(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name     string
+		country  string
+		items    []*pb.CartItem
+		currency string
+		duties   string
+		taxes    string
+		total    string
+	}{
+		// 789.50 over the 160 de minimis pays 14% for cycling, and 20% VAT
+		// on 789.50 + 110.53 + 119.99 of shipping.
+		{"bike to France", "France", []*pb.CartItem{{ProductId: "city-bike", Quantity: 1}}, "", "$110.53", "$204.00", "$314.53"},
+		// 67.99 is under the de minimis, but pays VAT on 67.99 + 23.99.
+		{"typewriter to France", "FR", []*pb.CartItem{{ProductId: "typewriter", Quantity: 1}}, "", "$0.00", "$18.40", "$18.40"},
+		{"typewriter to France in euros", "FR", []*pb.CartItem{{ProductId: "typewriter", Quantity: 1}}, "EUR", "0.00 EUR", "16.56 EUR", "16.56 EUR"},
+		// 59.97 is under both de minimis of Japan.
+		{"small order to Japan", "Japan", []*pb.CartItem{{ProductId: "23", Quantity: 3}}, "", "$0.00", "$0.00", "$0.00"},
+	}
+	for _, tt := range tests {
+		res, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{
+			Address:      &pb.Address{Country: tt.country},
+			Items:        tt.items,
+			CurrencyCode: tt.currency,
+		})
+		if err != nil {
+			t.Fatalf("TestGetQuoteDuties(%s): unexpected error %v", tt.name, err)
+		}
+		if res.Duties == nil {
+			t.Fatalf("TestGetQuoteDuties(%s): got no duties estimate", tt.name)
+		}
+		got := []string{
+			money.FromProto(res.Duties.Duties).String(),
+			money.FromProto(res.Duties.Taxes).String(),
+			money.FromProto(res.Duties.Total).String(),
+		}
+		if want := []string{tt.duties, tt.taxes, tt.total}; !reflect.DeepEqual(got, want) {
+			t.Errorf("TestGetQuoteDuties(%s): got duties, taxes and total %v, expected %v", tt.name, got, want)
+		}
+	}
+
+	res, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{
+		Address: &pb.Address{Country: "United States", ZipCode: 94043},
+		Items:   []*pb.CartItem{{ProductId: "city-bike", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("TestGetQuoteDuties: unexpected error %v", err)
+	}
+	if res.Duties != nil {
+		t.Errorf("TestGetQuoteDuties: got duties %v for a domestic order", res.Duties)
+	}
+}
+
+// TestShippingRestrictions checks that restricted items are reported with
+// the reason for each of them.
+func TestShippingRestrictions(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name    string
+		address *pb.Address
+		items   []*pb.CartItem
+		want    map[string]string
+	}{
+		{"plants abroad", &pb.Address{Country: "France"},
+			[]*pb.CartItem{{ProductId: "air-plant", Quantity: 1}, {ProductId: "typewriter", Quantity: 1}},
+			map[string]string{"air-plant": "live-plants-domestic-only"}},
+		{"plants to Hawaii", &pb.Address{Country: "United States", State: "Hawaii", ZipCode: 96813},
+			[]*pb.CartItem{{ProductId: "air-plant", Quantity: 1}, {ProductId: "city-bike", Quantity: 1}},
+			map[string]string{"air-plant": "live-plants-hawaii", "city-bike": "oversized-bicycles"}},
+		{"bike to Alaska", &pb.Address{Country: "US", ZipCode: 99501},
+			[]*pb.CartItem{{ProductId: "city-bike", Quantity: 1}},
+			map[string]string{"city-bike": "oversized-bicycles"}},
+		{"plants and bike to California", &pb.Address{Country: "US", State: "CA", ZipCode: 94043},
+			[]*pb.CartItem{{ProductId: "air-plant", Quantity: 1}, {ProductId: "city-bike", Quantity: 1}},
+			nil},
+	}
+	for _, tt := range tests {
+		_, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{Address: tt.address, Items: tt.items})
+		if tt.want == nil {
+			if err != nil {
+				t.Errorf("TestShippingRestrictions(%s): unexpected error %v", tt.name, err)
+			}
+			continue
+		}
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("TestShippingRestrictions(%s): got error %v, expected code %s", tt.name, err, codes.FailedPrecondition)
+		}
+		got := make(map[string]string)
+		for _, d := range status.Convert(err).Details() {
+			if f, ok := d.(*errdetails.PreconditionFailure); ok {
+				for _, v := range f.Violations {
+					got[v.Subject] = v.Type
+				}
+			}
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("TestShippingRestrictions(%s): got violations %v, expected %v", tt.name, got, tt.want)
+		}
+	}
+
+	_, err := s.ShipOrder(context.Background(), &pb.ShipOrderRequest{
+		Address: &pb.Address{StreetAddress: "1 Rue de Rivoli", City: "Paris", Country: "France", PostalCode: "75001"},
+		Items:   []*pb.CartItem{{ProductId: "air-plant", Quantity: 1}},
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("TestShippingRestrictions: ShipOrder got error %v, expected code %s", err, codes.FailedPrecondition)
+	}
+}
+
+// TestRateShopping checks that the carrier is chosen by price or by speed.
+func TestRateShopping(t *testing.T) {
+	s := newTestServer(t)
+
+	france := &pb.Address{Country: "France"}
+	typewriter := []*pb.CartItem{{ProductId: "typewriter", Quantity: 1}}
+	// 6.4kg billable to Europe: 42.59 by Hipster Post in 4 days, 61.29 by
+	// Bolt Express in 2 days and 23.99 by Tortoise Freight in 8 days.
+	tests := []struct {
+		address  *pb.Address
+		items    []*pb.CartItem
+		strategy pb.RateShopping
+		carrier  string
+		want     string
+	}{
+		{france, typewriter, pb.RateShopping_CHEAPEST, "tortoise-freight", "$23.99"},
+		{france, typewriter, pb.RateShopping_FASTEST, "bolt-express", "$61.29"},
+		// Bolt Express is as fast as Hipster Post in us-west, but dearer.
+		{&pb.Address{Country: "United States", ZipCode: 94043}, typewriter, pb.RateShopping_FASTEST, "hipster-post", "$10.69"},
+	}
+	for _, tt := range tests {
+		res, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{
+			Address:      tt.address,
+			Items:        tt.items,
+			RateShopping: tt.strategy,
+		})
+		if err != nil {
+			t.Fatalf("TestRateShopping(%s): unexpected error %v", tt.strategy, err)
+		}
+		if res.CarrierId != tt.carrier {
+			t.Errorf("TestRateShopping(%s): got carrier %q, expected %q", tt.strategy, res.CarrierId, tt.carrier)
+		}
+		if got := money.FromProto(res.CostUsd).String(); got != tt.want {
+			t.Errorf("TestRateShopping(%s): got %s, expected %s", tt.strategy, got, tt.want)
+		}
+	}
+
+	// Carriers that time out or fail are left out of the shopping.
+	testCarrier(t, s, "tortoise-freight").Latency = Duration(time.Second)
+	for i := range s.carriers {
+		s.carriers[i].timeout = 20 * time.Millisecond
+	}
+	testCarrier(t, s, "hipster-post").random = func() float64 { return 0 }
+	res, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{Address: france, Items: typewriter})
+	if err != nil {
+		t.Fatalf("TestRateShopping: unexpected error %v", err)
+	}
+	if res.CarrierId != "bolt-express" {
+		t.Errorf("TestRateShopping: got carrier %q with the others unavailable, expected %q", res.CarrierId, "bolt-express")
+	}
+
+	testCarrier(t, s, "bolt-express").random = func() float64 { return 0 }
+	_, err = s.GetQuote(context.Background(), &pb.GetQuoteRequest{Address: france, Items: typewriter})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("TestRateShopping: got error %v with every carrier unavailable, expected code %s", err, codes.Unavailable)
+	}
+}
+
+// TestGetQuoteUnknownProduct checks that products missing from the catalog are rejected.
+func TestGetQuoteUnknownProduct(t *testing.T) {
+	s := newTestServer(t)
+
+	req := &pb.GetQuoteRequest{
+		Address: &pb.Address{Country: "United States", ZipCode: 94043},
+		Items:   []*pb.CartItem{{ProductId: "does-not-exist", Quantity: 1}},
+	}
+	_, err := s.GetQuote(context.Background(), req)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("TestGetQuoteUnknownProduct: got error %v, expected code %s", err, codes.InvalidArgument)
+	}
+}
+
+// TestGetQuoteInvalidItems checks that empty orders and orders with invalid or
+// too many items are rejected before they are packed.
+func TestGetQuoteInvalidItems(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name  string
+		items []*pb.CartItem
+	}{
+		{"no items", nil},
+		{"no quantity", []*pb.CartItem{{ProductId: "23", Quantity: 0}}},
+		{"negative quantity", []*pb.CartItem{{ProductId: "23", Quantity: -1}}},
+		{"too many", []*pb.CartItem{{ProductId: "23", Quantity: 5000000}}},
+		{"too many in all", []*pb.CartItem{
+			{ProductId: "23", Quantity: maxOrderItems},
+			{ProductId: "air-plant", Quantity: 1},
+		}},
+	}
+	for _, tt := range tests {
+		req := &pb.GetQuoteRequest{
+			Address: &pb.Address{Country: "United States", ZipCode: 94043},
+			Items:   tt.items,
+		}
+		if _, err := s.GetQuote(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("TestGetQuoteInvalidItems: %s: got error %v, expected code %s", tt.name, err, codes.InvalidArgument)
+		}
+	}
+}
+
+// TestGetQuoteUnknownZone checks that destinations outside every zone are rejected.
+func TestGetQuoteUnknownZone(t *testing.T) {
+	s := newTestServer(t)
+
+	req := &pb.GetQuoteRequest{
+		Address: &pb.Address{
+			StreetAddress: "1 Main Street",
+			City:          "Nowhere",
+			Country:       "Atlantis",
+		},
+		Items: []*pb.CartItem{{ProductId: "23", Quantity: 1}},
+	}
+
+	_, err := s.GetQuote(context.Background(), req)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("TestGetQuoteUnknownZone: got error %v, expected code %s", err, codes.InvalidArgument)
+	}
+}
+
+// TestZoneLookup checks that the most specific zone serving an address is selected.
+func TestZoneLookup(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		country string
+		zip     int32
+		want    string
+	}{
+		{"United States", 94043, "us-west"},
+		{"us", 10001, "us"},
+		{"United States", 2139, "us"},
+		{"Canada", 0, "north-america"},
+		{"England", 0, "europe"},
+	}
+	for _, tt := range tests {
+		zone, err := s.rates.Zone(&pb.Address{Country: tt.country, ZipCode: tt.zip})
+		if err != nil {
+			t.Errorf("TestZoneLookup(%s, %d): unexpected error %v", tt.country, tt.zip, err)
+			continue
+		}
+		if zone.Name != tt.want {
+			t.Errorf("TestZoneLookup(%s, %d): got zone %q, expected %q", tt.country, tt.zip, zone.Name, tt.want)
+		}
+	}
+}
+
+// TestListShippingOptions checks the options offered and their delivery windows.
+func TestListShippingOptions(t *testing.T) {
+	s := newTestServer(t)
+
+	req := &pb.ListShippingOptionsRequest{
+		Address: &pb.Address{Country: "United States", ZipCode: 94043},
+		Items:   []*pb.CartItem{{ProductId: "23", Quantity: 4}},
+	}
+	res, err := s.ListShippingOptions(context.Background(), req)
+	if err != nil {
+		t.Fatalf("TestListShippingOptions (%v) failed", err)
+	}
+
+	// Thanksgiving (2026-11-26) and the weekend are skipped.
+	want := []struct {
+		id, earliest, latest string
+	}{
+		{"standard", "2026-11-25", "2026-11-30"},
+		{"express", "2026-11-23", "2026-11-24"},
+		{"overnight", "2026-11-23", "2026-11-23"},
+	}
+	if len(res.Options) != len(want) {
+		t.Fatalf("TestListShippingOptions: got %d options, expected %d", len(res.Options), len(want))
+	}
+	for i, w := range want {
+		got := res.Options[i]
+		if got.Id != w.id || got.EarliestDeliveryDate != w.earliest || got.LatestDeliveryDate != w.latest {
+			t.Errorf("TestListShippingOptions: got %s %s..%s, expected %s %s..%s",
+				got.Id, got.EarliestDeliveryDate, got.LatestDeliveryDate, w.id, w.earliest, w.latest)
+		}
+	}
+	if res.Options[1].CostUsd.GetUnits() <= res.Options[0].CostUsd.GetUnits() {
+		t.Errorf("TestListShippingOptions: express (%v) should cost more than standard (%v)", res.Options[1].CostUsd, res.Options[0].CostUsd)
+	}
+}
+
+// TestGetQuoteUnknownOption checks that unknown shipping options are rejected.
+func TestGetQuoteUnknownOption(t *testing.T) {
+	s := newTestServer(t)
+
+	req := &pb.GetQuoteRequest{
+		Address:          &pb.Address{Country: "United States", ZipCode: 94043},
+		Items:            []*pb.CartItem{{ProductId: "23", Quantity: 1}},
+		ShippingOptionId: "teleport",
+	}
+	_, err := s.GetQuote(context.Background(), req)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("TestGetQuoteUnknownOption: got error %v, expected code %s", err, codes.InvalidArgument)
 	}
 }
 
 // TestShipOrder is a basic check on the ShipOrder RPC service.
 func TestShipOrder(t *testing.T) {
-	s := server{}
+	s := newTestServer(t)
 
 	// A basic test case to test logic and protobuf interactions.
 	req := &pb.ShipOrderRequest{
@@ -52,6 +707,7 @@ func TestShipOrder(t *testing.T) {
 			City:          "London",
 			State:         "",
 			Country:       "England",
+			PostalCode:    "sw1a 1aa",
 		},
 		Items: []*pb.CartItem{
 			{
@@ -69,8 +725,656 @@ func TestShipOrder(t *testing.T) {
 	if err != nil {
 		t.Errorf("TestShipOrder (%v) failed", err)
 	}
-	// @todo improve quality of this test to check for a pattern such as '[A-Z]{2}-\d+-\d+'.
-	if len(res.TrackingId) != 18 {
-		t.Errorf("TestShipOrder: Tracking ID is malformed - has %d characters, %d expected", len(res.TrackingId), 18)
+	if err := tracking.ValidateTrackingID(res.TrackingId); err != nil {
+		t.Errorf("TestShipOrder: Tracking ID %q is malformed: %v", res.TrackingId, err)
+	}
+}
+
+// TestShipOrderParcels checks that every parcel of an order is tracked on its own.
+func TestShipOrderParcels(t *testing.T) {
+	s := newTestServer(t)
+
+	res, err := s.ShipOrder(context.Background(), &pb.ShipOrderRequest{
+		Address: &pb.Address{StreetAddress: "1 Main St", City: "Boston", State: "MA", Country: "US", ZipCode: 2110},
+		Items:   []*pb.CartItem{{ProductId: "typewriter", Quantity: 4}, {ProductId: "air-plant", Quantity: 1}},
+		OrderId: "order-1",
+	})
+	if err != nil {
+		t.Fatalf("TestShipOrderParcels: ShipOrder failed: %v", err)
+	}
+	if len(res.Parcels) != 3 {
+		t.Fatalf("TestShipOrderParcels: got %d parcels, expected 3", len(res.Parcels))
+	}
+	if res.TrackingId != res.Parcels[0].TrackingId {
+		t.Errorf("TestShipOrderParcels: got tracking ID %s, expected the first parcel's %s", res.TrackingId, res.Parcels[0].TrackingId)
+	}
+	seen := make(map[string]bool)
+	for _, p := range res.Parcels {
+		if seen[p.TrackingId] {
+			t.Errorf("TestShipOrderParcels: tracking ID %s is shared by parcels", p.TrackingId)
+		}
+		seen[p.TrackingId] = true
+		shipment, err := s.GetShipment(context.Background(), &pb.GetShipmentRequest{TrackingId: p.TrackingId})
+		if err != nil {
+			t.Fatalf("TestShipOrderParcels: GetShipment failed: %v", err)
+		}
+		if shipment.OrderId != "order-1" || !reflect.DeepEqual(shipment.Items, p.Items) {
+			t.Errorf("TestShipOrderParcels: got shipment of %q with %v, expected %q with %v", shipment.OrderId, shipment.Items, "order-1", p.Items)
+		}
+	}
+}
+
+// cancelRecorder records the labels its carrier voids.
+type cancelRecorder struct {
+	Carrier
+	canceled *[]string
+}
+
+func (c cancelRecorder) Cancel(ctx context.Context, label Label) error {
+	*c.canceled = append(*c.canceled, label.TrackingNumber)
+	return c.Carrier.Cancel(ctx, label)
+}
+
+// failingShipments fails to save shipments after the first few.
+type failingShipments struct {
+	store.Store
+	left int
+}
+
+func (s *failingShipments) PutShipment(sh *store.Shipment) error {
+	if s.left == 0 {
+		return errors.New("disk full")
+	}
+	s.left--
+	return s.Store.PutShipment(sh)
+}
+
+// TestShipOrderSaveFails checks that the labels of the parcels that could
+// not be saved are voided.
+func TestShipOrderSaveFails(t *testing.T) {
+	s := newTestServer(t)
+	var canceled []string
+	for i := range s.carriers {
+		s.carriers[i].Carrier = cancelRecorder{s.carriers[i].Carrier, &canceled}
+	}
+	shipments := &failingShipments{Store: s.shipments, left: 1}
+	s.shipments = shipments
+
+	_, err := s.ShipOrder(context.Background(), &pb.ShipOrderRequest{
+		Address: &pb.Address{StreetAddress: "1 Main St", City: "Boston", State: "MA", Country: "US", ZipCode: 2110},
+		Items:   []*pb.CartItem{{ProductId: "typewriter", Quantity: 4}, {ProductId: "air-plant", Quantity: 1}},
+		OrderId: "order-1",
+	})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("TestShipOrderSaveFails: got error %v, expected code %s", err, codes.Internal)
+	}
+	saved, err := shipments.GetShipmentsByOrder("order-1")
+	if err != nil || len(saved) != 1 {
+		t.Fatalf("TestShipOrderSaveFails: got %d saved shipments (%v), expected 1", len(saved), err)
+	}
+	// Of the 3 parcels, the 2 that were not saved are voided.
+	if len(canceled) != 2 {
+		t.Errorf("TestShipOrderSaveFails: voided labels %v, expected 2", canceled)
+	}
+}
+
+// TestGetShipment checks that shipped orders can be tracked through their lifecycle.
+func TestGetShipment(t *testing.T) {
+	s := newTestServer(t)
+
+	shipped, err := s.ShipOrder(context.Background(), &pb.ShipOrderRequest{
+		Address: &pb.Address{
+			StreetAddress: "1600 Amphitheatre Parkway",
+			City:          "Mountain View",
+			State:         "California",
+			Country:       "United States",
+			ZipCode:       94043,
+		},
+		Items:            []*pb.CartItem{{ProductId: "23", Quantity: 1}},
+		ShippingOptionId: "express",
+	})
+	if err != nil {
+		t.Fatalf("TestGetShipment: ShipOrder failed: %v", err)
+	}
+
+	tests := []struct {
+		elapsed time.Duration
+		want    pb.ShipmentStatus
+	}{
+		{0, pb.ShipmentStatus_LABEL_CREATED},
+		{90 * time.Minute, pb.ShipmentStatus_IN_TRANSIT},
+		{2 * time.Hour, pb.ShipmentStatus_OUT_FOR_DELIVERY},
+		{48 * time.Hour, pb.ShipmentStatus_DELIVERED},
+	}
+	for _, tt := range tests {
+		elapsed := tt.elapsed
+		s.now = func() time.Time { return testNow.Add(elapsed) }
+		res, err := s.GetShipment(context.Background(), &pb.GetShipmentRequest{TrackingId: shipped.TrackingId})
+		if err != nil {
+			t.Fatalf("TestGetShipment: GetShipment failed: %v", err)
+		}
+		if res.Status != tt.want {
+			t.Errorf("TestGetShipment after %v: got status %s, expected %s", tt.elapsed, res.Status, tt.want)
+		}
+		if last := res.Events[len(res.Events)-1]; last.Status != tt.want {
+			t.Errorf("TestGetShipment after %v: last event is %s, expected %s", tt.elapsed, last.Status, tt.want)
+		}
+		if res.Address.State != "CA" || res.Address.Country != "US" {
+			t.Errorf("TestGetShipment: got address %v, expected it normalized", res.Address)
+		}
+		if res.CarrierId != "hipster-post" || res.CarrierName != "Hipster Post" || !strings.HasPrefix(res.CarrierTrackingNumber, "HP") {
+			t.Errorf("TestGetShipment: got carrier %q (%q) and tracking number %q, expected Hipster Post", res.CarrierId, res.CarrierName, res.CarrierTrackingNumber)
+		}
+		if res.EstimatedDeliveryDate != "2026-11-24" {
+			t.Errorf("TestGetShipment: got estimated delivery %s, expected %s", res.EstimatedDeliveryDate, "2026-11-24")
+		}
+	}
+
+	unknown := tracking.NewIDGenerator().New()
+	_, err = s.GetShipment(context.Background(), &pb.GetShipmentRequest{TrackingId: unknown})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("TestGetShipment: got error %v for an unknown tracking ID, expected code %s", err, codes.NotFound)
+	}
+
+	_, err = s.GetShipment(context.Background(), &pb.GetShipmentRequest{TrackingId: "XX-0000-0000"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("TestGetShipment: got error %v for a malformed tracking ID, expected code %s", err, codes.InvalidArgument)
+	}
+}
+
+// TestPickupPoints checks that pickup points are listed nearest first within
+// the radius, and that orders can be shipped to them.
+func TestPickupPoints(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	mountainView := &pb.Address{StreetAddress: "1600 Amphitheatre Parkway", City: "Mountain View", State: "CA", Country: "US", ZipCode: 94043}
+
+	tests := []struct {
+		radius float64
+		want   []string
+	}{
+		{0, []string{"mv-shoreline", "mv-castro", "pa-university"}},
+		{2, []string{"mv-shoreline"}},
+		{60, []string{"mv-shoreline", "mv-castro", "pa-university", "sj-santana", "sf-ferry-building"}},
+	}
+	for _, tt := range tests {
+		res, err := s.ListPickupPoints(ctx, &pb.ListPickupPointsRequest{Address: mountainView, RadiusKm: tt.radius})
+		if err != nil {
+			t.Fatalf("TestPickupPoints: ListPickupPoints failed: %v", err)
+		}
+		var got []string
+		for _, p := range res.Points {
+			got = append(got, p.Id)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("TestPickupPoints within %g km: got %v, expected %v", tt.radius, got, tt.want)
+		}
+	}
+
+	errTests := []struct {
+		name string
+		req  *pb.ListPickupPointsRequest
+	}{
+		{"radius too large", &pb.ListPickupPointsRequest{Address: mountainView, RadiusKm: 500}},
+		{"unknown postal code", &pb.ListPickupPointsRequest{Address: &pb.Address{StreetAddress: "1 Main St", City: "Denver", State: "CO", Country: "US", ZipCode: 80202}}},
+		{"invalid address", &pb.ListPickupPointsRequest{Address: &pb.Address{Country: "US"}}},
+	}
+	for _, tt := range errTests {
+		if _, err := s.ListPickupPoints(ctx, tt.req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("TestPickupPoints %s: got error %v, expected code %s", tt.name, err, codes.InvalidArgument)
+		}
+	}
+
+	shipped, err := s.ShipOrder(ctx, &pb.ShipOrderRequest{
+		Items:         []*pb.CartItem{{ProductId: "23", Quantity: 1}},
+		PickupPointId: "lon-victoria",
+	})
+	if err != nil {
+		t.Fatalf("TestPickupPoints: ShipOrder failed: %v", err)
+	}
+	if shipped.PickupPoint.GetName() != "Victoria Station Lockers" {
+		t.Errorf("TestPickupPoints: got pickup point %v, expected Victoria Station Lockers", shipped.PickupPoint)
+	}
+	shipment, err := s.GetShipment(ctx, &pb.GetShipmentRequest{TrackingId: shipped.TrackingId})
+	if err != nil {
+		t.Fatalf("TestPickupPoints: GetShipment failed: %v", err)
+	}
+	if shipment.PickupPointId != "lon-victoria" || shipment.Address.PostalCode != "SW1V 1JU" {
+		t.Errorf("TestPickupPoints: got shipment to %q at %v, expected the pickup point's address", shipment.PickupPointId, shipment.Address)
+	}
+
+	_, err = s.ShipOrder(ctx, &pb.ShipOrderRequest{Items: []*pb.CartItem{{ProductId: "23", Quantity: 1}}, PickupPointId: "nowhere"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("TestPickupPoints: got error %v for an unknown pickup point, expected code %s", err, codes.InvalidArgument)
+	}
+	_, err = s.GetPickupPoint(ctx, &pb.GetPickupPointRequest{Id: "nowhere"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("TestPickupPoints: got error %v for an unknown pickup point, expected code %s", err, codes.NotFound)
+	}
+}
+
+// fakeRefunder records the returns it refunds.
+type fakeRefunder struct {
+	refunded []string
+	err      error
+}
+
+func (f *fakeRefunder) RefundReturn(ctx context.Context, r *store.Return) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	f.refunded = append(f.refunded, r.TrackingID)
+	return "refund-" + r.TrackingID, nil
+}
+
+// TestReturns checks that delivered items can be returned once, and that
+// returns are refunded when received.
+func TestReturns(t *testing.T) {
+	s := newTestServer(t)
+	refunds := &fakeRefunder{}
+	s.refunds = refunds
+	ctx := context.Background()
+
+	_, err := s.ShipOrder(ctx, &pb.ShipOrderRequest{
+		Address: &pb.Address{StreetAddress: "1 Main St", City: "Boston", State: "MA", Country: "US", ZipCode: 2110},
+		Items:   []*pb.CartItem{{ProductId: "typewriter", Quantity: 4}, {ProductId: "23", Quantity: 1}},
+		OrderId: "order-1",
+	})
+	if err != nil {
+		t.Fatalf("TestReturns: ShipOrder failed: %v", err)
+	}
+	req := &pb.CreateReturnRequest{
+		OrderId: "order-1",
+		Items:   []*pb.CartItem{{ProductId: "typewriter", Quantity: 3}},
+		Reason:  "too loud",
+	}
+	if _, err := s.CreateReturn(ctx, req); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("TestReturns: got error %v before delivery, expected code %s", err, codes.FailedPrecondition)
+	}
+
+	delivered := testNow.Add(48 * time.Hour)
+	s.now = func() time.Time { return delivered }
+	ret, err := s.CreateReturn(ctx, req)
+	if err != nil {
+		t.Fatalf("TestReturns: CreateReturn failed: %v", err)
+	}
+	if ret.OrderId != "order-1" || ret.Status != pb.ReturnStatus_RETURN_LABEL_CREATED || ret.CarrierName != "Hipster Post" {
+		t.Errorf("TestReturns: got return of %q with status %s by %q, expected a label created by Hipster Post", ret.OrderId, ret.Status, ret.CarrierName)
+	}
+	if len(ret.ShipmentTrackingIds) == 0 {
+		t.Errorf("TestReturns: return has no shipments")
+	}
+
+	errTests := []struct {
+		name string
+		req  *pb.CreateReturnRequest
+		want codes.Code
+	}{
+		{"more than left", &pb.CreateReturnRequest{OrderId: "order-1", Items: []*pb.CartItem{{ProductId: "typewriter", Quantity: 2}}}, codes.InvalidArgument},
+		{"not shipped", &pb.CreateReturnRequest{OrderId: "order-1", Items: []*pb.CartItem{{ProductId: "air-plant", Quantity: 1}}}, codes.InvalidArgument},
+		{"no items", &pb.CreateReturnRequest{OrderId: "order-1"}, codes.InvalidArgument},
+		{"no order", &pb.CreateReturnRequest{Items: []*pb.CartItem{{ProductId: "23", Quantity: 1}}}, codes.InvalidArgument},
+		{"unknown order", &pb.CreateReturnRequest{OrderId: "order-2", Items: []*pb.CartItem{{ProductId: "23", Quantity: 1}}}, codes.NotFound},
+	}
+	for _, tt := range errTests {
+		if _, err := s.CreateReturn(ctx, tt.req); status.Code(err) != tt.want {
+			t.Errorf("TestReturns %s: got error %v, expected code %s", tt.name, err, tt.want)
+		}
+	}
+
+	// The return is refunded once, after it is received.
+	tests := []struct {
+		elapsed time.Duration
+		want    pb.ReturnStatus
+	}{
+		{90 * time.Minute, pb.ReturnStatus_RETURN_IN_TRANSIT},
+		{2 * time.Hour, pb.ReturnStatus_RETURN_REFUNDED},
+		{3 * time.Hour, pb.ReturnStatus_RETURN_REFUNDED},
+	}
+	for _, tt := range tests {
+		elapsed := tt.elapsed
+		s.now = func() time.Time { return delivered.Add(elapsed) }
+		if err := s.refundReturns(ctx); err != nil {
+			t.Fatalf("TestReturns: refundReturns failed: %v", err)
+		}
+		res, err := s.GetReturn(ctx, &pb.GetReturnRequest{TrackingId: ret.TrackingId})
+		if err != nil {
+			t.Fatalf("TestReturns: GetReturn failed: %v", err)
+		}
+		if res.Status != tt.want {
+			t.Errorf("TestReturns after %v: got status %s, expected %s", tt.elapsed, res.Status, tt.want)
+		}
+	}
+	if !reflect.DeepEqual(refunds.refunded, []string{ret.TrackingId}) {
+		t.Errorf("TestReturns: refunded %v, expected %v once", refunds.refunded, ret.TrackingId)
+	}
+	res, _ := s.GetReturn(ctx, &pb.GetReturnRequest{TrackingId: ret.TrackingId})
+	if res.RefundId != "refund-"+ret.TrackingId || len(res.Events) != 4 {
+		t.Errorf("TestReturns: got refund %q after %d events, expected %q after 4", res.RefundId, len(res.Events), "refund-"+ret.TrackingId)
+	}
+
+	_, err = s.GetReturn(ctx, &pb.GetReturnRequest{TrackingId: tracking.NewIDGenerator().New()})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("TestReturns: got error %v for an unknown return, expected code %s", err, codes.NotFound)
+	}
+}
+
+// TestCancelShipment checks that the shipments of an order are canceled
+// until they are picked up, and that canceling again does nothing.
+func TestCancelShipment(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	address := &pb.Address{StreetAddress: "1 Main St", City: "Boston", State: "MA", Country: "US", ZipCode: 2110}
+
+	shipped, err := s.ShipOrder(ctx, &pb.ShipOrderRequest{
+		Address: address,
+		Items:   []*pb.CartItem{{ProductId: "23", Quantity: 1}},
+		OrderId: "order-1",
+	})
+	if err != nil {
+		t.Fatalf("TestCancelShipment: ShipOrder failed: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		res, err := s.CancelShipment(ctx, &pb.CancelShipmentRequest{OrderId: "order-1"})
+		if err != nil {
+			t.Fatalf("TestCancelShipment: CancelShipment failed: %v", err)
+		}
+		if !reflect.DeepEqual(res.TrackingIds, []string{shipped.TrackingId}) {
+			t.Errorf("TestCancelShipment: canceled %v, expected %v", res.TrackingIds, shipped.TrackingId)
+		}
+	}
+	sh, err := s.GetShipment(ctx, &pb.GetShipmentRequest{TrackingId: shipped.TrackingId})
+	if err != nil {
+		t.Fatalf("TestCancelShipment: GetShipment failed: %v", err)
+	}
+	if sh.Status != pb.ShipmentStatus_CANCELED || len(sh.Events) != 2 {
+		t.Errorf("TestCancelShipment: got status %s after %d events, expected %s after 2", sh.Status, len(sh.Events), pb.ShipmentStatus_CANCELED)
+	}
+
+	picked, err := s.ShipOrder(ctx, &pb.ShipOrderRequest{
+		Address: address,
+		Items:   []*pb.CartItem{{ProductId: "23", Quantity: 1}},
+		OrderId: "order-2",
+	})
+	if err != nil {
+		t.Fatalf("TestCancelShipment: ShipOrder failed: %v", err)
+	}
+	s.now = func() time.Time { return testNow.Add(90 * time.Minute) }
+	if _, err := s.CancelShipment(ctx, &pb.CancelShipmentRequest{TrackingId: picked.TrackingId}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("TestCancelShipment: got error %v after pickup, expected code %s", err, codes.FailedPrecondition)
+	}
+	if _, err := s.CancelShipment(ctx, &pb.CancelShipmentRequest{OrderId: "order-3"}); status.Code(err) != codes.NotFound {
+		t.Errorf("TestCancelShipment: got error %v for an unknown order, expected code %s", err, codes.NotFound)
+	}
+}
+
+// fakeWatchStream passes the events sent by WatchShipment to a channel.
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *pb.ShipmentEvent
+}
+
+func (f *fakeWatchStream) Context() context.Context { return f.ctx }
+
+func (f *fakeWatchStream) Send(e *pb.ShipmentEvent) error {
+	f.events <- e
+	return nil
+}
+
+// TestWatchShipment checks that status changes are streamed as the shipment
+// moves through its lifecycle, until it is delivered.
+func TestWatchShipment(t *testing.T) {
+	s := newTestServer(t)
+	var elapsed int64
+	s.now = func() time.Time { return testNow.Add(time.Duration(atomic.LoadInt64(&elapsed))) }
+	shipped, err := s.ShipOrder(context.Background(), &pb.ShipOrderRequest{
+		Address: &pb.Address{StreetAddress: "1 Main St", City: "Boston", State: "MA", Country: "US", ZipCode: 2110},
+		Items:   []*pb.CartItem{{ProductId: "23", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("TestWatchShipment: ShipOrder failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &fakeWatchStream{ctx: ctx, events: make(chan *pb.ShipmentEvent)}
+	done := make(chan error, 1)
+	go func() {
+		done <- s.WatchShipment(&pb.WatchShipmentRequest{TrackingId: shipped.TrackingId}, stream)
+	}()
+	for i, want := range shipmentStages {
+		atomic.StoreInt64(&elapsed, int64(time.Duration(i)*time.Hour))
+		select {
+		case e := <-stream.events:
+			if e.Status != want {
+				t.Errorf("TestWatchShipment: got status %s, expected %s", e.Status, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("TestWatchShipment: no %s event", want)
+		}
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("TestWatchShipment: got error %v after delivery, expected none", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("TestWatchShipment: the stream did not end after delivery")
+	}
+
+	// Watching stops when the client goes away.
+	atomic.StoreInt64(&elapsed, 0)
+	shipped, err = s.ShipOrder(context.Background(), &pb.ShipOrderRequest{
+		Address: &pb.Address{StreetAddress: "1 Main St", City: "Boston", State: "MA", Country: "US", ZipCode: 2110},
+		Items:   []*pb.CartItem{{ProductId: "23", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("TestWatchShipment: ShipOrder failed: %v", err)
+	}
+	go func() {
+		done <- s.WatchShipment(&pb.WatchShipmentRequest{TrackingId: shipped.TrackingId}, stream)
+	}()
+	<-stream.events
+	cancel()
+	if err := <-done; status.Code(err) != codes.Canceled {
+		t.Errorf("TestWatchShipment: got error %v after the client left, expected code %s", err, codes.Canceled)
+	}
+
+	err = s.WatchShipment(&pb.WatchShipmentRequest{TrackingId: tracking.NewIDGenerator().New()}, stream)
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("TestWatchShipment: got error %v for an unknown tracking ID, expected code %s", err, codes.NotFound)
+	}
+}
+
+// TestMonitorShipments checks that scans publish the status changes since
+// the previous scan, but not those from before the first one.
+func TestMonitorShipments(t *testing.T) {
+	s := newTestServer(t)
+	ship := func() string {
+		res, err := s.ShipOrder(context.Background(), &pb.ShipOrderRequest{
+			Address: &pb.Address{StreetAddress: "1 Main St", City: "Boston", State: "MA", Country: "US", ZipCode: 2110},
+			Items:   []*pb.CartItem{{ProductId: "23", Quantity: 1}},
+			OrderId: "order-1",
+		})
+		if err != nil {
+			t.Fatalf("TestMonitorShipments: ShipOrder failed: %v", err)
+		}
+		return res.TrackingId
+	}
+	var published []string
+	publish := func(u shipmentUpdate) {
+		published = append(published, u.TrackingID+" "+u.Event.Status.String())
+	}
+	m := &shipmentMonitor{}
+
+	before := ship()
+	if err := s.scan(context.Background(), m, publish); err != nil {
+		t.Fatalf("TestMonitorShipments: scan failed: %v", err)
+	}
+	after := ship()
+	s.now = func() time.Time { return testNow.Add(time.Hour) }
+	if err := s.scan(context.Background(), m, publish); err != nil {
+		t.Fatalf("TestMonitorShipments: scan failed: %v", err)
+	}
+	want := map[string]bool{
+		before + " IN_TRANSIT":   true,
+		after + " LABEL_CREATED": true,
+		after + " IN_TRANSIT":    true,
+	}
+	if len(published) != len(want) {
+		t.Fatalf("TestMonitorShipments: published %v, expected %d events", published, len(want))
+	}
+	for _, p := range published {
+		if !want[p] {
+			t.Errorf("TestMonitorShipments: unexpected event %s", p)
+		}
+	}
+}
+
+// TestWebhookDispatcher checks that webhook requests are signed, retried on
+// server errors and dead-lettered when they keep failing.
+func TestWebhookDispatcher(t *testing.T) {
+	update := shipmentUpdate{
+		TrackingID: "AB-1234567890-K",
+		OrderID:    "order-1",
+		CarrierID:  "hipster-post",
+		Event:      &pb.ShipmentEvent{Status: pb.ShipmentStatus_DELIVERED, Time: "2026-11-23T10:00:00Z"},
+	}
+	tests := []struct {
+		name      string
+		responses []int
+		delivered bool
+		attempts  int
+	}{
+		{"accepted", []int{http.StatusOK}, true, 1},
+		{"retried", []int{http.StatusInternalServerError, http.StatusTooManyRequests, http.StatusNoContent}, true, 3},
+		{"failing", []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway}, false, 3},
+		{"rejected", []int{http.StatusBadRequest}, false, 1},
+	}
+	for _, tt := range tests {
+		var attempts int32
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			i := atomic.AddInt32(&attempts, 1) - 1
+			body, _ := ioutil.ReadAll(r.Body)
+			sig := "sha256=" + signWebhook([]byte("secret"), r.Header.Get("X-Hipstershop-Timestamp"), body)
+			if r.Header.Get("X-Hipstershop-Signature") != sig {
+				t.Errorf("TestWebhookDispatcher %s: got signature %q, expected %q", tt.name, r.Header.Get("X-Hipstershop-Signature"), sig)
+			}
+			var payload webhookPayload
+			if err := json.Unmarshal(body, &payload); err != nil || payload.Status != "DELIVERED" || payload.TrackingID != update.TrackingID {
+				t.Errorf("TestWebhookDispatcher %s: got payload %s", tt.name, body)
+			}
+			w.WriteHeader(tt.responses[i])
+		}))
+
+		var deadLetters bytes.Buffer
+		d := newWebhookDispatcher(receiver.URL, "secret", 3, &deadLetters)
+		d.backoff = time.Millisecond
+		delivered := d.deliver(context.Background(), update)
+		receiver.Close()
+
+		if delivered != tt.delivered || int(attempts) != tt.attempts {
+			t.Errorf("TestWebhookDispatcher %s: got delivered %v after %d attempts, expected %v after %d", tt.name, delivered, attempts, tt.delivered, tt.attempts)
+		}
+		var letter deadLetter
+		if tt.delivered {
+			if deadLetters.Len() > 0 {
+				t.Errorf("TestWebhookDispatcher %s: got dead letter %s, expected none", tt.name, deadLetters.String())
+			}
+		} else if err := json.Unmarshal(deadLetters.Bytes(), &letter); err != nil || letter.Attempts != tt.attempts || letter.DeliveryID != "AB-1234567890-K/DELIVERED" {
+			t.Errorf("TestWebhookDispatcher %s: got dead letter %s, expected one after %d attempts", tt.name, deadLetters.String(), tt.attempts)
+		}
+	}
+}
+
+// TestValidateAddress checks required fields, postal code formats and state
+// normalization.
+func TestValidateAddress(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name    string
+		address *pb.Address
+		want    *pb.Address
+		errors  []string
+	}{
+		{
+			name:    "US zip code",
+			address: &pb.Address{StreetAddress: "1600 Amphitheatre Parkway", City: "Mountain View", State: "california", Country: "USA", ZipCode: 94043},
+			want:    &pb.Address{StreetAddress: "1600 Amphitheatre Parkway", City: "Mountain View", State: "CA", Country: "US", ZipCode: 94043, PostalCode: "94043"},
+		},
+		{
+			name:    "US zip+4",
+			address: &pb.Address{StreetAddress: "1 Main St", City: "Boston", State: "ma", Country: "US", PostalCode: "021101234"},
+			want:    &pb.Address{StreetAddress: "1 Main St", City: "Boston", State: "MA", Country: "US", PostalCode: "02110-1234"},
+		},
+		{
+			name:    "Canadian postal code",
+			address: &pb.Address{StreetAddress: "111 Wellington St", City: "Ottawa", State: "Ontario", Country: "canada", PostalCode: "k1a0a9"},
+			want:    &pb.Address{StreetAddress: "111 Wellington St", City: "Ottawa", State: "ON", Country: "CA", PostalCode: "K1A 0A9"},
+		},
+		{
+			name:    "UK postcode",
+			address: &pb.Address{StreetAddress: "10  Downing Street", City: "London", Country: "United Kingdom", PostalCode: "sw1a2aa"},
+			want:    &pb.Address{StreetAddress: "10 Downing Street", City: "London", Country: "GB", PostalCode: "SW1A 2AA"},
+		},
+		{
+			name:    "Japanese postal code",
+			address: &pb.Address{StreetAddress: "1-1 Chiyoda", City: "Tokyo", Country: "Japan", PostalCode: "1000001"},
+			want:    &pb.Address{StreetAddress: "1-1 Chiyoda", City: "Tokyo", Country: "JP", PostalCode: "100-0001"},
+		},
+		{
+			name:    "country without rules",
+			address: &pb.Address{StreetAddress: "1 Queen St", City: "Auckland", Country: "New Zealand"},
+			want:    &pb.Address{StreetAddress: "1 Queen St", City: "Auckland", Country: "New Zealand"},
+		},
+		{
+			name:    "empty address",
+			address: &pb.Address{},
+			errors:  []string{fieldStreetAddress, fieldCity, fieldCountry},
+		},
+		{
+			name:    "US address without state or zip code",
+			address: &pb.Address{StreetAddress: "1 Main St", City: "Boston", Country: "US"},
+			errors:  []string{fieldState, fieldPostalCode},
+		},
+		{
+			name:    "bad US zip code and state",
+			address: &pb.Address{StreetAddress: "1 Main St", City: "Boston", State: "Narnia", Country: "US", PostalCode: "1234"},
+			errors:  []string{fieldState, fieldPostalCode},
+		},
+		{
+			name:    "bad UK postcode",
+			address: &pb.Address{StreetAddress: "1 High St", City: "London", Country: "GB", PostalCode: "12345"},
+			errors:  []string{fieldPostalCode},
+		},
+	}
+	for _, tt := range tests {
+		res, err := s.ValidateAddress(context.Background(), &pb.ValidateAddressRequest{Address: tt.address})
+		if err != nil {
+			t.Fatalf("TestValidateAddress(%s): unexpected error %v", tt.name, err)
+		}
+		var fields []string
+		for _, e := range res.Errors {
+			fields = append(fields, e.Field)
+		}
+		if !reflect.DeepEqual(fields, tt.errors) {
+			t.Errorf("TestValidateAddress(%s): got errors in %v, expected %v", tt.name, fields, tt.errors)
+		}
+		if res.Valid != (len(tt.errors) == 0) {
+			t.Errorf("TestValidateAddress(%s): got valid %t with errors %v", tt.name, res.Valid, res.Errors)
+		}
+		if tt.want != nil && !proto.Equal(res.NormalizedAddress, tt.want) {
+			t.Errorf("TestValidateAddress(%s): got %v, expected %v", tt.name, res.NormalizedAddress, tt.want)
+		}
+	}
+
+	_, err := s.ShipOrder(context.Background(), &pb.ShipOrderRequest{
+		Address: &pb.Address{Country: "United States"},
+		Items:   []*pb.CartItem{{ProductId: "23", Quantity: 1}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("TestValidateAddress: ShipOrder got error %v for an empty address, expected code %s", err, codes.InvalidArgument)
 	}
 }
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+	"github.com/abruneau/hipstershop/src/shippingservice/money"
+	"github.com/abruneau/hipstershop/src/shippingservice/store"
+)
+
+// enabledCarrier is a carrier the service ships with, and how long each of
+// its calls may take.
+type enabledCarrier struct {
+	Carrier
+	timeout time.Duration
+}
+
+// carrierQuote is the rate of a carrier for all the parcels of an order.
+type carrierQuote struct {
+	carrier enabledCarrier
+	rate    CarrierRate
+}
+
+// offer is a carrier quote priced for a shipping option.
+type offer struct {
+	carrierQuote
+	cost             money.Micros
+	earliest, latest time.Time
+}
+
+// carrier looks up an enabled carrier by ID.
+func (s *server) carrier(id string) (enabledCarrier, bool) {
+	for _, c := range s.carriers {
+		if c.ID() == id {
+			return c, true
+		}
+	}
+	return enabledCarrier{}, false
+}
+
+// collectRates asks every enabled carrier for a rate in parallel, waiting at
+// most for the carrier's timeout. Carriers that fail or time out are left out,
+// so the quotes are missing only if no carrier serves the zone or none of
+// those serving it answered in time. Quotes are in the order of s.carriers.
+func (s *server) collectRates(ctx context.Context, zone *Zone, parcels []*parcel) ([]carrierQuote, error) {
+	type result struct {
+		i    int
+		rate CarrierRate
+		err  error
+	}
+	// The channel is buffered so that late carriers do not block forever.
+	results := make(chan result, len(s.carriers))
+	var longest time.Duration
+	for i, c := range s.carriers {
+		if c.timeout > longest {
+			longest = c.timeout
+		}
+		go func(i int, c enabledCarrier) {
+			ctx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+			rate, err := quoteParcels(ctx, c, zone, parcels)
+			results <- result{i, rate, err}
+		}(i, c)
+	}
+
+	// Carriers ignoring their context deadline are not waited for.
+	deadline := time.NewTimer(longest)
+	defer deadline.Stop()
+	rates := make([]*CarrierRate, len(s.carriers))
+	answered, served := 0, 0
+	for answered < len(s.carriers) {
+		select {
+		case r := <-results:
+			answered++
+			c := s.carriers[r.i]
+			switch {
+			case r.err == errNotServed:
+			case r.err != nil:
+				served++
+				log.Warnf("carrier %s failed to quote: %v", c.ID(), r.err)
+			default:
+				served++
+				rate := r.rate
+				rates[r.i] = &rate
+			}
+		case <-deadline.C:
+			log.Warnf("%d carriers did not quote in time", len(s.carriers)-answered)
+			served += len(s.carriers) - answered
+			answered = len(s.carriers)
+		}
+	}
+
+	var quotes []carrierQuote
+	for i, r := range rates {
+		if r != nil {
+			quotes = append(quotes, carrierQuote{carrier: s.carriers[i], rate: *r})
+		}
+	}
+	if served == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no carrier ships to zone %q", zone.Name)
+	}
+	if len(quotes) == 0 {
+		return nil, status.Errorf(codes.Unavailable, "no carrier could quote the shipment to zone %q", zone.Name)
+	}
+	return quotes, nil
+}
+
+// quoteParcels asks a carrier for the rate of every parcel. The costs add up,
+// and the parcels arrive with the slowest one.
+func quoteParcels(ctx context.Context, c Carrier, zone *Zone, parcels []*parcel) (CarrierRate, error) {
+	var total CarrierRate
+	for _, p := range parcels {
+		rate, err := c.Quote(ctx, zone, p.shipment)
+		if err != nil {
+			return CarrierRate{}, err
+		}
+		total.Cost += rate.Cost
+		if rate.TransitDays > total.TransitDays {
+			total.TransitDays = rate.TransitDays
+		}
+	}
+	return total, nil
+}
+
+// quoteCarrier asks a single carrier for a rate.
+func (s *server) quoteCarrier(ctx context.Context, c enabledCarrier, zone *Zone, parcels []*parcel) (carrierQuote, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	rate, err := quoteParcels(ctx, c, zone, parcels)
+	if err == errNotServed {
+		return carrierQuote{}, status.Errorf(codes.InvalidArgument, "carrier %s does not ship to zone %q", c.ID(), zone.Name)
+	}
+	if err != nil {
+		return carrierQuote{}, status.Errorf(codes.Unavailable, "carrier %s failed to quote: %v", c.ID(), err)
+	}
+	return carrierQuote{carrier: c, rate: rate}, nil
+}
+
+// bestOffer prices every quote for the shipping option and picks one with the
+// rate shopping strategy. Ties go to the first carrier.
+func (s *server) bestOffer(quotes []carrierQuote, option *ShippingOption, strategy pb.RateShopping, now time.Time) offer {
+	var best offer
+	for i, q := range quotes {
+		o := offer{carrierQuote: q, cost: option.Price(q.rate.Cost)}
+		o.earliest, o.latest = option.DeliveryWindow(s.calendar, q.rate.TransitDays, now)
+		if i == 0 || o.better(best, strategy) {
+			best = o
+		}
+	}
+	return best
+}
+
+// better reports whether o beats other under the rate shopping strategy.
+func (o offer) better(other offer, strategy pb.RateShopping) bool {
+	if strategy == pb.RateShopping_FASTEST && !o.latest.Equal(other.latest) {
+		return o.latest.Before(other.latest)
+	}
+	if o.cost != other.cost {
+		return o.cost < other.cost
+	}
+	return o.latest.Before(other.latest)
+}
+
+// createLabel buys a shipping label from a carrier.
+func (s *server) createLabel(ctx context.Context, c enabledCarrier, zone *Zone, parcel shipment) (Label, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	label, err := c.CreateLabel(ctx, zone, parcel)
+	if err != nil {
+		return Label{}, status.Errorf(codes.Unavailable, "carrier %s failed to create a label: %v", c.ID(), err)
+	}
+	return label, nil
+}
+
+// cancelLabel voids a label bought from a carrier, logging failures.
+func (s *server) cancelLabel(ctx context.Context, c enabledCarrier, label Label) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	if err := c.Cancel(ctx, label); err != nil {
+		log.Warnf("carrier %s failed to cancel label %s: %v", c.ID(), label.TrackingNumber, err)
+	}
+}
+
+// track lists the status changes of a shipment with its carrier.
+func (s *server) track(ctx context.Context, c enabledCarrier, shipment *store.Shipment) ([]*pb.ShipmentEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	events, err := c.Track(ctx, Label{
+		CarrierID:      shipment.CarrierID,
+		TrackingNumber: shipment.CarrierTrackingNumber,
+		CreatedAt:      shipment.CreatedAt,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "carrier %s failed to track shipment: %v", c.ID(), err)
+	}
+	return events, nil
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+)
+
+var (
+	// errCarrierFailure is returned by the simulated failures of a carrier.
+	errCarrierFailure = errors.New("simulated carrier failure")
+	// errPickedUp is returned when cancelling a shipment already picked up.
+	errPickedUp = errors.New("shipment already picked up")
+)
+
+// CarrierZone is how a simulated carrier serves a zone: the rate table it
+// charges and its transit time in business days.
+type CarrierZone struct {
+	RateTable   string `json:"rate_table"`
+	TransitDays int    `json:"transit_days"`
+}
+
+// SimulatedCarrier is a carrier simulated from the rate configuration. Every
+// call takes Latency plus a random part of Jitter, and fails with probability
+// FailureRate. Its shipments go through the shipment lifecycle.
+type SimulatedCarrier struct {
+	CarrierID      string                  `json:"id"`
+	DisplayName    string                  `json:"name"`
+	TrackingPrefix string                  `json:"tracking_prefix"`
+	Zones          map[string]*CarrierZone `json:"zones"`
+	Timeout        Duration                `json:"timeout"`
+	Latency        Duration                `json:"latency"`
+	Jitter         Duration                `json:"jitter"`
+	FailureRate    float64                 `json:"failure_rate"`
+
+	tables    map[string]*RateTable
+	lifecycle lifecycle
+	now       func() time.Time
+	// random returns a number in [0, 1) to draw latencies and failures.
+	random func() float64
+}
+
+func (c *SimulatedCarrier) validate(e *RateEngine) error {
+	if c.CarrierID == "" || c.DisplayName == "" {
+		return fmt.Errorf("carrier %q must have an id and a name", c.CarrierID)
+	}
+	if c.FailureRate < 0 || c.FailureRate >= 1 {
+		return fmt.Errorf("carrier %q must have a failure rate in [0, 1)", c.CarrierID)
+	}
+	for zone, z := range c.Zones {
+		if !e.hasZone(zone) {
+			return fmt.Errorf("carrier %q serves unknown zone %q", c.CarrierID, zone)
+		}
+		if _, ok := e.RateTables[z.RateTable]; !ok {
+			return fmt.Errorf("carrier %q references unknown rate table %q", c.CarrierID, z.RateTable)
+		}
+		if z.TransitDays < 0 {
+			return fmt.Errorf("carrier %q has negative transit days to %q", c.CarrierID, zone)
+		}
+	}
+	return nil
+}
+
+// startCarriers starts the simulated carriers whose IDs are listed in
+// enabled, or all of them when enabled is empty.
+func (e *RateEngine) startCarriers(enabled []string, l lifecycle, now func() time.Time) ([]enabledCarrier, error) {
+	var carriers []enabledCarrier
+	for _, c := range e.Carriers {
+		if len(enabled) > 0 && !contains(enabled, c.CarrierID) {
+			continue
+		}
+		c.start(e, l, now)
+		timeout := time.Duration(e.CarrierTimeout)
+		if c.Timeout > 0 {
+			timeout = time.Duration(c.Timeout)
+		}
+		carriers = append(carriers, enabledCarrier{Carrier: c, timeout: timeout})
+	}
+	for _, id := range enabled {
+		if !e.hasCarrier(id) {
+			return nil, fmt.Errorf("unknown carrier %q", id)
+		}
+	}
+	if len(carriers) == 0 {
+		return nil, errors.New("no carrier is enabled")
+	}
+	return carriers, nil
+}
+
+func (e *RateEngine) hasCarrier(id string) bool {
+	for _, c := range e.Carriers {
+		if c.CarrierID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// start prepares the carrier to handle calls.
+func (c *SimulatedCarrier) start(e *RateEngine, l lifecycle, now func() time.Time) {
+	c.tables = e.RateTables
+	c.lifecycle = l
+	c.now = now
+	var mu sync.Mutex
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	c.random = func() float64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return r.Float64()
+	}
+}
+
+func (c *SimulatedCarrier) ID() string   { return c.CarrierID }
+func (c *SimulatedCarrier) Name() string { return c.DisplayName }
+
+func (c *SimulatedCarrier) Quote(ctx context.Context, zone *Zone, parcel shipment) (CarrierRate, error) {
+	z, ok := c.Zones[zone.Name]
+	if !ok {
+		return CarrierRate{}, errNotServed
+	}
+	if err := c.simulate(ctx); err != nil {
+		return CarrierRate{}, err
+	}
+	return CarrierRate{
+		Cost:        c.tables[z.RateTable].Price(parcel),
+		TransitDays: z.TransitDays,
+	}, nil
+}
+
+func (c *SimulatedCarrier) CreateLabel(ctx context.Context, zone *Zone, parcel shipment) (Label, error) {
+	if _, ok := c.Zones[zone.Name]; !ok {
+		return Label{}, errNotServed
+	}
+	if err := c.simulate(ctx); err != nil {
+		return Label{}, err
+	}
+	return Label{
+		CarrierID:      c.CarrierID,
+		TrackingNumber: fmt.Sprintf("%s%012d", c.TrackingPrefix, int64(c.random()*1e12)),
+		CreatedAt:      c.now(),
+	}, nil
+}
+
+func (c *SimulatedCarrier) Track(ctx context.Context, label Label) ([]*pb.ShipmentEvent, error) {
+	if err := c.simulate(ctx); err != nil {
+		return nil, err
+	}
+	return c.lifecycle.eventsAt(label.CreatedAt, c.now()), nil
+}
+
+func (c *SimulatedCarrier) Cancel(ctx context.Context, label Label) error {
+	if err := c.simulate(ctx); err != nil {
+		return err
+	}
+	if c.lifecycle.stageAt(label.CreatedAt, c.now()) > 0 {
+		return errPickedUp
+	}
+	return nil
+}
+
+// simulate waits for the latency of a call and draws its failure.
+func (c *SimulatedCarrier) simulate(ctx context.Context) error {
+	delay := time.Duration(c.Latency) + time.Duration(c.random()*float64(c.Jitter))
+	if delay > 0 {
+		t := time.NewTimer(delay)
+		defer t.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+	if c.random() < c.FailureRate {
+		return errCarrierFailure
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package store
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+type memory struct {
+	mu        sync.RWMutex
+	shipments map[string]*Shipment
+	returns   map[string]*Return
+}
+
+// NewMemoryStore creates a store that keeps records in memory. They are lost
+// when the service restarts.
+func NewMemoryStore() Store {
+	return &memory{
+		shipments: make(map[string]*Shipment),
+		returns:   make(map[string]*Return),
+	}
+}
+
+// Disconnect does nothing for the in-memory store
+func (m *memory) Disconnect() {}
+
+// PutShipment inserts a new shipment
+func (m *memory) PutShipment(s *Shipment) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.shipments[s.TrackingID]; ok {
+		return fmt.Errorf("shipment %q already exists", s.TrackingID)
+	}
+	c := *s
+	m.shipments[s.TrackingID] = &c
+	return nil
+}
+
+// GetShipment gets a shipment from its tracking ID
+func (m *memory) GetShipment(trackingID string) (*Shipment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	s, ok := m.shipments[trackingID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	c := *s
+	return &c, nil
+}
+
+// GetShipmentsByOrder gets the shipments of an order, oldest first
+func (m *memory) GetShipmentsByOrder(orderID string) ([]*Shipment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var out []*Shipment
+	for _, s := range m.shipments {
+		if s.OrderID == orderID {
+			c := *s
+			out = append(out, &c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].CreatedAt.Before(out[j].CreatedAt)
+		}
+		return out[i].TrackingID < out[j].TrackingID
+	})
+	return out, nil
+}
+
+// GetShipmentsSince gets the shipments created at or after t
+func (m *memory) GetShipmentsSince(t time.Time) ([]*Shipment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var out []*Shipment
+	for _, s := range m.shipments {
+		if !s.CreatedAt.Before(t) {
+			c := *s
+			out = append(out, &c)
+		}
+	}
+	return out, nil
+}
+
+// CancelShipment records the cancellation of a shipment
+func (m *memory) CancelShipment(trackingID string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.shipments[trackingID]
+	if !ok {
+		return ErrNotFound
+	}
+	s.CanceledAt = at
+	return nil
+}
+
+// PutReturn inserts a new return
+func (m *memory) PutReturn(r *Return) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.returns[r.TrackingID]; ok {
+		return fmt.Errorf("return %q already exists", r.TrackingID)
+	}
+	c := *r
+	m.returns[r.TrackingID] = &c
+	return nil
+}
+
+// GetReturn gets a return from the tracking ID of its label
+func (m *memory) GetReturn(trackingID string) (*Return, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	r, ok := m.returns[trackingID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	c := *r
+	return &c, nil
+}
+
+// GetReturnsByShipments gets the returns of items from any of the shipments
+func (m *memory) GetReturnsByShipments(trackingIDs []string) ([]*Return, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var out []*Return
+	for _, r := range m.returns {
+		if overlaps(r.ShipmentTrackingIDs, trackingIDs) {
+			c := *r
+			out = append(out, &c)
+		}
+	}
+	return out, nil
+}
+
+// GetUnrefundedReturns gets the returns of orders not refunded yet
+func (m *memory) GetUnrefundedReturns() ([]*Return, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var out []*Return
+	for _, r := range m.returns {
+		if r.OrderID != "" && r.RefundID == "" {
+			c := *r
+			out = append(out, &c)
+		}
+	}
+	return out, nil
+}
+
+// SetReturnRefund records the refund of a return
+func (m *memory) SetReturnRefund(trackingID, refundID string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.returns[trackingID]
+	if !ok {
+		return ErrNotFound
+	}
+	r.RefundID, r.RefundedAt = refundID, at
+	return nil
+}
+
+func overlaps(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
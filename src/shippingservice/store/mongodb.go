@@ -0,0 +1,142 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongodb struct {
+	client    *mongo.Client
+	shipments *mongo.Collection
+	returns   *mongo.Collection
+	log       *logrus.Logger
+}
+
+// Disconnect disconnect us from the database
+func (m *mongodb) Disconnect() {
+	ctx := context.Background()
+	m.client.Disconnect(ctx)
+}
+
+// PutShipment inserts a new shipment
+func (m *mongodb) PutShipment(s *Shipment) error {
+	_, err := m.shipments.InsertOne(context.Background(), s)
+	return err
+}
+
+// GetShipment gets a shipment from its tracking ID
+func (m *mongodb) GetShipment(trackingID string) (*Shipment, error) {
+	var s Shipment
+	err := m.shipments.FindOne(context.Background(), bson.M{"tracking_id": trackingID}).Decode(&s)
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// GetShipmentsByOrder gets the shipments of an order, oldest first
+func (m *mongodb) GetShipmentsByOrder(orderID string) ([]*Shipment, error) {
+	ctx := context.Background()
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "tracking_id", Value: 1}})
+	cur, err := m.shipments.Find(ctx, bson.M{"order_id": orderID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var out []*Shipment
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetShipmentsSince gets the shipments created at or after t
+func (m *mongodb) GetShipmentsSince(t time.Time) ([]*Shipment, error) {
+	ctx := context.Background()
+	cur, err := m.shipments.Find(ctx, bson.M{"created_at": bson.M{"$gte": t}})
+	if err != nil {
+		return nil, err
+	}
+	var out []*Shipment
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CancelShipment records the cancellation of a shipment
+func (m *mongodb) CancelShipment(trackingID string, at time.Time) error {
+	res, err := m.shipments.UpdateOne(context.Background(),
+		bson.M{"tracking_id": trackingID},
+		bson.M{"$set": bson.M{"canceled_at": at}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// PutReturn inserts a new return
+func (m *mongodb) PutReturn(r *Return) error {
+	_, err := m.returns.InsertOne(context.Background(), r)
+	return err
+}
+
+// GetReturn gets a return from the tracking ID of its label
+func (m *mongodb) GetReturn(trackingID string) (*Return, error) {
+	var r Return
+	err := m.returns.FindOne(context.Background(), bson.M{"tracking_id": trackingID}).Decode(&r)
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// GetReturnsByShipments gets the returns of items from any of the shipments
+func (m *mongodb) GetReturnsByShipments(trackingIDs []string) ([]*Return, error) {
+	return m.findReturns(bson.M{"shipment_tracking_ids": bson.M{"$in": trackingIDs}})
+}
+
+// GetUnrefundedReturns gets the returns of orders not refunded yet
+func (m *mongodb) GetUnrefundedReturns() ([]*Return, error) {
+	return m.findReturns(bson.M{"order_id": bson.M{"$ne": ""}, "refund_id": ""})
+}
+
+func (m *mongodb) findReturns(filter bson.M) ([]*Return, error) {
+	ctx := context.Background()
+	cur, err := m.returns.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var out []*Return
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetReturnRefund records the refund of a return
+func (m *mongodb) SetReturnRefund(trackingID, refundID string, at time.Time) error {
+	res, err := m.returns.UpdateOne(context.Background(),
+		bson.M{"tracking_id": trackingID},
+		bson.M{"$set": bson.M{"refund_id": refundID, "refunded_at": at}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
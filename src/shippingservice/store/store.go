@@ -0,0 +1,120 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx"
+)
+
+// ErrNotFound is returned when no record matches the requested ID.
+var ErrNotFound = errors.New("not found")
+
+// Shipment is a persisted parcel of an order. Its status is not stored: it is tracked
+// with its carrier, from the carrier's label.
+type Shipment struct {
+	TrackingID            string         `bson:"tracking_id"`
+	OrderID               string         `bson:"order_id"`
+	Address               *pb.Address    `bson:"address"`
+	PickupPointID         string         `bson:"pickup_point_id"`
+	Items                 []*pb.CartItem `bson:"items"`
+	ShippingOptionID      string         `bson:"shipping_option_id"`
+	CarrierID             string         `bson:"carrier_id"`
+	CarrierTrackingNumber string         `bson:"carrier_tracking_number"`
+	CreatedAt             time.Time      `bson:"created_at"`
+	EstimatedArrival      time.Time      `bson:"estimated_arrival"`
+	CanceledAt            time.Time      `bson:"canceled_at"`
+}
+
+// Return is a persisted return. Like shipments, its status is simulated from
+// its creation time; only its refund is stored.
+type Return struct {
+	TrackingID            string         `bson:"tracking_id"`
+	OrderID               string         `bson:"order_id"`
+	ShipmentTrackingIDs   []string       `bson:"shipment_tracking_ids"`
+	Items                 []*pb.CartItem `bson:"items"`
+	Reason                string         `bson:"reason"`
+	CarrierID             string         `bson:"carrier_id"`
+	CarrierTrackingNumber string         `bson:"carrier_tracking_number"`
+	CreatedAt             time.Time      `bson:"created_at"`
+	RefundID              string         `bson:"refund_id"`
+	RefundedAt            time.Time      `bson:"refunded_at"`
+}
+
+// Store interface
+type Store interface {
+	PutShipment(*Shipment) error
+	GetShipment(trackingID string) (*Shipment, error)
+	// GetShipmentsByOrder returns the shipments of an order, oldest first.
+	GetShipmentsByOrder(orderID string) ([]*Shipment, error)
+	// GetShipmentsSince returns the shipments created at or after t.
+	GetShipmentsSince(t time.Time) ([]*Shipment, error)
+	CancelShipment(trackingID string, at time.Time) error
+
+	PutReturn(*Return) error
+	GetReturn(trackingID string) (*Return, error)
+	// GetReturnsByShipments returns the returns of items from any of the
+	// shipments.
+	GetReturnsByShipments(trackingIDs []string) ([]*Return, error)
+	// GetUnrefundedReturns returns the returns of orders not refunded yet.
+	GetUnrefundedReturns() ([]*Return, error)
+	SetReturnRefund(trackingID, refundID string, at time.Time) error
+
+	Disconnect()
+}
+
+// New returns a Mongodb store when MONGO_URL is set, and an in-memory store
+// otherwise.
+func New(log *logrus.Logger) (Store, error) {
+	if os.Getenv("MONGO_URL") == "" {
+		log.Warn("MONGO_URL not set, shipments are kept in memory")
+		return NewMemoryStore(), nil
+	}
+	return NewMongoStore(log)
+}
+
+// NewMongoStore initialize a new Mongodb connexion
+func NewMongoStore(log *logrus.Logger) (Store, error) {
+	ctx := context.Background()
+	mongoURL := os.Getenv("MONGO_URL")
+	if mongoURL == "" {
+		return nil, errors.New("failed to get MONGO_URL from env")
+	}
+
+	client, err := mongo.NewClient(options.Client().ApplyURI(mongoURL))
+	if err != nil {
+		return nil, err
+	}
+	if err = client.Connect(ctx); err != nil {
+		return nil, err
+	}
+	m := &mongodb{
+		client:    client,
+		shipments: client.Database("shipping").Collection("shipments"),
+		returns:   client.Database("shipping").Collection("returns"),
+		log:       log,
+	}
+	log.Info("Connected to the shipment store")
+
+	for _, c := range []*mongo.Collection{m.shipments, m.returns} {
+		index := mongo.IndexModel{
+			Keys:    bsonx.Doc{{Key: "tracking_id", Value: bsonx.Int32(1)}},
+			Options: options.Index().SetUnique(true),
+		}
+		if _, err = c.Indexes().CreateOne(ctx, index); err != nil {
+			return nil, fmt.Errorf("Indexes().CreateOne() ERROR: %v", err)
+		}
+	}
+	index := mongo.IndexModel{Keys: bsonx.Doc{{Key: "order_id", Value: bsonx.Int32(1)}}}
+	if _, err = m.shipments.Indexes().CreateOne(ctx, index); err != nil {
+		return nil, fmt.Errorf("Indexes().CreateOne() ERROR: %v", err)
+	}
+	return m, nil
+}
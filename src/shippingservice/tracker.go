@@ -1,42 +0,0 @@
-package main
-
-import (
-	"fmt"
-	"math/rand"
-	"time"
-)
-
-// seeded determines if the random number generator is ready.
-var seeded bool = false
-
-// CreateTrackingID generates a tracking ID.
-func CreateTrackingID(salt string) string {
-	if !seeded {
-		rand.Seed(time.Now().UnixNano())
-		seeded = true
-	}
-
-	return fmt.Sprintf("%c%c-%d%s-%d%s",
-		getRandomLetterCode(),
-		getRandomLetterCode(),
-		len(salt),
-		getRandomNumber(3),
-		len(salt)/2,
-		getRandomNumber(7),
-	)
-}
-
-// getRandomLetterCode generates a code point value for a capital letter.
-func getRandomLetterCode() uint32 {
-	return 65 + uint32(rand.Intn(25))
-}
-
-// getRandomNumber generates a string representation of a number with the requested number of digits.
-func getRandomNumber(digits int) string {
-	str := ""
-	for i := 0; i < digits; i++ {
-		str = fmt.Sprintf("%s%d", str, rand.Intn(10))
-	}
-
-	return str
-}
@@ -0,0 +1,129 @@
+package tracking
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"math/rand"
+	"sync"
+)
+
+// Tracking IDs look like "AB-1234567890-K": two letters, ten digits and a
+// check character computed over the letters and digits with the Luhn mod 36
+// algorithm, which catches every single-character typo and most transposed
+// characters.
+const (
+	idLength    = 15
+	digitsCount = 10
+	digitsSpace = 10000000000 // 10^digitsCount
+
+	// permutation is coprime with 10^digitsCount, so multiplying by it
+	// modulo digitsSpace maps distinct counters to distinct digits.
+	permutation = 6364136223
+)
+
+const checkAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+var (
+	// ErrMalformed means the ID does not follow the tracking ID format.
+	ErrMalformed = errors.New("malformed tracking ID")
+	// ErrChecksum means the ID has the right format but a wrong check
+	// character, as happens with most typos.
+	ErrChecksum = errors.New("tracking ID check character mismatch")
+)
+
+// IDGenerator creates tracking IDs.
+type IDGenerator interface {
+	New() string
+}
+
+// generator creates tracking IDs from a counter, so the IDs it creates never
+// repeat until 10^10 of them have been issued. It is safe for concurrent use.
+type generator struct {
+	mu      sync.Mutex
+	rand    *rand.Rand
+	counter uint64
+	offset  uint64
+}
+
+// NewIDGenerator creates a generator seeded from a secure random source, so
+// that separate instances start from unrelated points.
+func NewIDGenerator() IDGenerator {
+	var seed [16]byte
+	if _, err := crand.Read(seed[:]); err != nil {
+		panic(fmt.Sprintf("failed to seed tracking ID generator: %v", err))
+	}
+	return &generator{
+		rand:   rand.New(rand.NewSource(int64(binary.LittleEndian.Uint64(seed[:8])))),
+		offset: binary.LittleEndian.Uint64(seed[8:]) % digitsSpace,
+	}
+}
+
+// New creates a tracking ID.
+func (g *generator) New() string {
+	g.mu.Lock()
+	n := g.counter
+	g.counter++
+	a, b := 'A'+rune(g.rand.Intn(26)), 'A'+rune(g.rand.Intn(26))
+	g.mu.Unlock()
+
+	hi, lo := bits.Mul64(n%digitsSpace, permutation)
+	digits := (bits.Rem64(hi, lo, digitsSpace) + g.offset) % digitsSpace
+	body := fmt.Sprintf("%c%c%0*d", a, b, digitsCount, digits)
+	check, _ := checkCharacter(body)
+	return fmt.Sprintf("%s-%s-%c", body[:2], body[2:], check)
+}
+
+// ValidateTrackingID checks the format and check character of a tracking ID.
+func ValidateTrackingID(id string) error {
+	if len(id) != idLength || id[2] != '-' || id[idLength-2] != '-' {
+		return ErrMalformed
+	}
+	for i := 0; i < 2; i++ {
+		if id[i] < 'A' || id[i] > 'Z' {
+			return ErrMalformed
+		}
+	}
+	for i := 3; i < 3+digitsCount; i++ {
+		if id[i] < '0' || id[i] > '9' {
+			return ErrMalformed
+		}
+	}
+	check, err := checkCharacter(id[:2] + id[3:3+digitsCount])
+	if err != nil {
+		return ErrMalformed
+	}
+	if id[idLength-1] != check {
+		return ErrChecksum
+	}
+	return nil
+}
+
+// checkCharacter computes the Luhn mod 36 check character of s.
+func checkCharacter(s string) (byte, error) {
+	n := len(checkAlphabet)
+	sum, factor := 0, 2
+	for i := len(s) - 1; i >= 0; i-- {
+		cp := codePoint(s[i])
+		if cp < 0 {
+			return 0, ErrMalformed
+		}
+		addend := factor * cp
+		sum += addend/n + addend%n
+		factor = 3 - factor
+	}
+	return checkAlphabet[(n-sum%n)%n], nil
+}
+
+func codePoint(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10
+	default:
+		return -1
+	}
+}
@@ -0,0 +1,96 @@
+package tracking
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestNewIsValid(t *testing.T) {
+	g := NewIDGenerator()
+	for i := 0; i < 1000; i++ {
+		id := g.New()
+		if err := ValidateTrackingID(id); err != nil {
+			t.Fatalf("New() = %q, which fails validation: %v", id, err)
+		}
+	}
+}
+
+func TestNewIsUniqueUnderConcurrency(t *testing.T) {
+	const workers, perWorker = 8, 2000
+
+	g := NewIDGenerator()
+	ids := make(chan string, workers*perWorker)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				ids <- g.New()
+			}
+		}()
+	}
+	wg.Wait()
+	close(ids)
+
+	seen := make(map[string]bool, workers*perWorker)
+	for id := range ids {
+		digits := id[3:13]
+		if seen[digits] {
+			t.Fatalf("New() repeated digits %s", digits)
+		}
+		seen[digits] = true
+	}
+}
+
+func TestValidateTrackingID(t *testing.T) {
+	valid := NewIDGenerator().New()
+	tests := []struct {
+		name string
+		id   string
+		want error
+	}{
+		{"valid", valid, nil},
+		{"empty", "", ErrMalformed},
+		{"lowercase", "ab" + valid[2:], ErrMalformed},
+		{"missing dash", valid[:2] + "0" + valid[3:], ErrMalformed},
+		{"letter in digits", valid[:5] + "X" + valid[6:], ErrMalformed},
+		{"too long", valid + "0", ErrMalformed},
+	}
+	for _, tt := range tests {
+		if got := ValidateTrackingID(tt.id); got != tt.want {
+			t.Errorf("%s: ValidateTrackingID(%q) = %v, want %v", tt.name, tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestValidateTrackingIDCatchesTypos(t *testing.T) {
+	id := NewIDGenerator().New()
+
+	// Every single-character substitution is caught.
+	for i := 0; i < len(id); i++ {
+		if id[i] == '-' {
+			continue
+		}
+		for _, c := range []byte(checkAlphabet) {
+			if c == id[i] {
+				continue
+			}
+			typo := id[:i] + string(c) + id[i+1:]
+			if ValidateTrackingID(typo) == nil {
+				t.Errorf("ValidateTrackingID(%q) accepted a typo of %q", typo, id)
+			}
+		}
+	}
+
+	// Swapping two different adjacent digits is caught.
+	for i := 3; i < 12; i++ {
+		if id[i] == id[i+1] {
+			continue
+		}
+		swapped := id[:i] + string(id[i+1]) + string(id[i]) + id[i+2:]
+		if ValidateTrackingID(swapped) == nil {
+			t.Errorf("ValidateTrackingID(%q) accepted a transposition of %q", swapped, id)
+		}
+	}
+}
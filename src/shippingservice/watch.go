@@ -0,0 +1,123 @@
+package main
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abruneau/hipstershop/src/shippingservice/genproto"
+	"github.com/abruneau/hipstershop/src/shippingservice/store"
+	"github.com/abruneau/hipstershop/src/shippingservice/tracking"
+)
+
+// shipmentUpdate is a status change of a shipment.
+type shipmentUpdate struct {
+	TrackingID string
+	OrderID    string
+	CarrierID  string
+	Event      *pb.ShipmentEvent
+}
+
+// WatchShipment sends the status changes of a shipment so far, then every
+// new one as it is tracked, until the shipment is delivered or canceled, or
+// the client goes away.
+func (s *server) WatchShipment(in *pb.WatchShipmentRequest, stream pb.ShippingService_WatchShipmentServer) error {
+	log.Info("[WatchShipment] received request")
+	defer log.Info("[WatchShipment] completed request")
+
+	if err := tracking.ValidateTrackingID(in.TrackingId); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v: %q", err, in.TrackingId)
+	}
+	shipment, err := s.shipments.GetShipment(in.TrackingId)
+	if err == store.ErrNotFound {
+		return status.Errorf(codes.NotFound, "no shipment with tracking ID %s", in.TrackingId)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get shipment: %v", err)
+	}
+
+	ctx := stream.Context()
+	t := time.NewTicker(s.watchInterval)
+	defer t.Stop()
+	sent := 0
+	for {
+		events, err := s.shipmentEvents(ctx, shipment)
+		if status.Code(err) == codes.Unavailable {
+			// The carrier may answer on the next try.
+			log.Warnf("[WatchShipment] failed to track %s: %v", in.TrackingId, err)
+		} else if err != nil {
+			return err
+		}
+		for ; sent < len(events); sent++ {
+			if err := stream.Send(events[sent]); err != nil {
+				return err
+			}
+		}
+		if sent > 0 && final(events[sent-1].Status) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-t.C:
+		}
+	}
+}
+
+// shipmentMonitor finds the status changes of recent shipments by tracking
+// them again and again.
+type shipmentMonitor struct {
+	// seen counts the events already published per tracking ID.
+	seen map[string]int
+	// primed is set after the first scan, whose events are not published
+	// since they may have happened before the service started.
+	primed bool
+}
+
+// scan tracks the shipments that may not be delivered yet and publishes
+// their new events. Shipments that cannot be tracked are tried again on the
+// next scan.
+func (s *server) scan(ctx context.Context, m *shipmentMonitor, publish func(shipmentUpdate)) error {
+	since := s.now().Add(-time.Duration(len(shipmentStages)) * s.lifecycle.stage)
+	shipments, err := s.shipments.GetShipmentsSince(since)
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]int, len(shipments))
+	for _, sh := range shipments {
+		n := m.seen[sh.TrackingID]
+		seen[sh.TrackingID] = n
+		events, err := s.shipmentEvents(ctx, sh)
+		if err != nil {
+			log.Warnf("failed to track shipment %s: %v", sh.TrackingID, err)
+			continue
+		}
+		if m.primed {
+			for _, e := range events[n:] {
+				publish(shipmentUpdate{TrackingID: sh.TrackingID, OrderID: sh.OrderID, CarrierID: sh.CarrierID, Event: e})
+			}
+		}
+		seen[sh.TrackingID] = len(events)
+	}
+	m.seen, m.primed = seen, true
+	return nil
+}
+
+// monitorShipments publishes the status changes of shipments every interval.
+func (s *server) monitorShipments(ctx context.Context, interval time.Duration, publish func(shipmentUpdate)) {
+	m := &shipmentMonitor{}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		if err := s.scan(ctx, m, publish); err != nil {
+			log.Warnf("failed to scan shipments: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	// webhookEvent names the events sent to webhooks.
+	webhookEvent = "shipment.status_changed"
+	// webhookQueueSize is how many events may wait to be sent before new
+	// ones are dead-lettered.
+	webhookQueueSize = 1000
+)
+
+// webhookPayload is the JSON body posted to webhooks.
+type webhookPayload struct {
+	Event      string `json:"event"`
+	TrackingID string `json:"tracking_id"`
+	OrderID    string `json:"order_id,omitempty"`
+	CarrierID  string `json:"carrier_id,omitempty"`
+	Status     string `json:"status"`
+	Time       string `json:"time"`
+}
+
+// deadLetter is an event that could not be sent, logged as a JSON line.
+type deadLetter struct {
+	DeliveryID string          `json:"delivery_id"`
+	URL        string          `json:"url"`
+	Attempts   int             `json:"attempts"`
+	Error      string          `json:"error"`
+	Payload    json.RawMessage `json:"payload"`
+	FailedAt   string          `json:"failed_at"`
+}
+
+// webhookDispatcher posts shipment status changes to a webhook, one at a
+// time and in order. Each request carries an HMAC-SHA256 signature of its
+// timestamp and body made with the shared secret. Failed requests are tried
+// again up to maxAttempts times, waiting backoff and then twice as long after
+// each failure; events still not sent are written to deadLetters.
+type webhookDispatcher struct {
+	url         string
+	secret      []byte
+	client      *http.Client
+	maxAttempts int
+	backoff     time.Duration
+	deadLetters io.Writer
+	queue       chan shipmentUpdate
+	now         func() time.Time
+}
+
+func newWebhookDispatcher(url, secret string, maxAttempts int, deadLetters io.Writer) *webhookDispatcher {
+	return &webhookDispatcher{
+		url:         url,
+		secret:      []byte(secret),
+		client:      &http.Client{Timeout: 10 * time.Second},
+		maxAttempts: maxAttempts,
+		backoff:     time.Second,
+		deadLetters: deadLetters,
+		queue:       make(chan shipmentUpdate, webhookQueueSize),
+		now:         time.Now,
+	}
+}
+
+// Publish queues a status change to be sent. It never blocks: when the
+// queue is full, the change is dead-lettered.
+func (d *webhookDispatcher) Publish(u shipmentUpdate) {
+	select {
+	case d.queue <- u:
+	default:
+		d.deadLetter(u, 0, fmt.Errorf("webhook queue is full"))
+	}
+}
+
+// Run sends the queued status changes until ctx is done.
+func (d *webhookDispatcher) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case u := <-d.queue:
+			d.deliver(ctx, u)
+		}
+	}
+}
+
+// deliver posts a status change, trying again after retriable failures. It
+// reports whether the webhook accepted it.
+func (d *webhookDispatcher) deliver(ctx context.Context, u shipmentUpdate) bool {
+	wait := d.backoff
+	var err error
+	for attempt := 1; attempt <= d.maxAttempts; attempt++ {
+		var retry bool
+		if retry, err = d.post(ctx, u); err == nil {
+			log.Debugf("webhook accepted %s of %s after %d attempts", u.Event.Status, u.TrackingID, attempt)
+			return true
+		}
+		if !retry || attempt == d.maxAttempts {
+			d.deadLetter(u, attempt, err)
+			return false
+		}
+		log.Warnf("webhook attempt %d for %s of %s failed, retrying in %v: %v", attempt, u.Event.Status, u.TrackingID, wait, err)
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			d.deadLetter(u, attempt, ctx.Err())
+			return false
+		case <-t.C:
+		}
+		wait *= 2
+	}
+	return false
+}
+
+// post sends a status change once. It reports whether a failure may succeed
+// if tried again: network errors, timeouts, rate limits and server errors.
+func (d *webhookDispatcher) post(ctx context.Context, u shipmentUpdate) (retry bool, err error) {
+	body := d.payload(u)
+	req, err := http.NewRequest(http.MethodPost, d.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	timestamp := strconv.FormatInt(d.now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Hipstershop-Event", webhookEvent)
+	req.Header.Set("X-Hipstershop-Delivery", deliveryID(u))
+	req.Header.Set("X-Hipstershop-Timestamp", timestamp)
+	req.Header.Set("X-Hipstershop-Signature", "sha256="+signWebhook(d.secret, timestamp, body))
+
+	res, err := d.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return true, err
+	}
+	defer res.Body.Close()
+	io.Copy(ioutil.Discard, res.Body)
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return false, nil
+	}
+	err = fmt.Errorf("webhook answered %s", res.Status)
+	retry = res.StatusCode >= 500 || res.StatusCode == http.StatusRequestTimeout || res.StatusCode == http.StatusTooManyRequests
+	return retry, err
+}
+
+func (d *webhookDispatcher) payload(u shipmentUpdate) []byte {
+	body, _ := json.Marshal(webhookPayload{
+		Event:      webhookEvent,
+		TrackingID: u.TrackingID,
+		OrderID:    u.OrderID,
+		CarrierID:  u.CarrierID,
+		Status:     u.Event.Status.String(),
+		Time:       u.Event.Time,
+	})
+	return body
+}
+
+// deadLetter logs a status change that could not be sent.
+func (d *webhookDispatcher) deadLetter(u shipmentUpdate, attempts int, err error) {
+	log.Errorf("webhook gave up on %s of %s after %d attempts: %v", u.Event.Status, u.TrackingID, attempts, err)
+	if d.deadLetters == nil {
+		return
+	}
+	line, _ := json.Marshal(deadLetter{
+		DeliveryID: deliveryID(u),
+		URL:        d.url,
+		Attempts:   attempts,
+		Error:      err.Error(),
+		Payload:    d.payload(u),
+		FailedAt:   d.now().UTC().Format(time.RFC3339),
+	})
+	if _, err := d.deadLetters.Write(append(line, '\n')); err != nil {
+		log.Errorf("failed to write webhook dead letter: %v", err)
+	}
+}
+
+// deliveryID identifies a status change, so that webhooks can ignore events
+// received twice.
+func deliveryID(u shipmentUpdate) string {
+	return u.TrackingID + "/" + u.Event.Status.String()
+}
+
+// signWebhook signs a request: the hex HMAC-SHA256 of its timestamp, a dot
+// and its body.
+func signWebhook(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}